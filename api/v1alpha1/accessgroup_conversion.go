@@ -0,0 +1,7 @@
+package v1alpha1
+
+// Hub marks AccessGroup as the conversion hub version, per
+// sigs.k8s.io/controller-runtime/pkg/conversion. Other versions (e.g.
+// v1beta1) implement Convertible against this version instead of converting
+// directly between every pair of versions.
+func (*AccessGroup) Hub() {}
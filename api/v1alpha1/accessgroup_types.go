@@ -0,0 +1,102 @@
+// Package v1alpha1 contains API Schema definitions for the cfgate v1alpha1 API group.
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AccessGroupSpec defines the desired state of AccessGroup. It models a
+// reusable identity rule set (e.g. "employees", "on-call") that many
+// CloudflareAccessPolicy resources can reference via AccessGroupRef.Name
+// instead of duplicating the same Include/Exclude/Require rules.
+// +kubebuilder:validation:XValidation:rule="has(self.include) || has(self.require)",message="at least one of include or require must be specified"
+type AccessGroupSpec struct {
+	// CloudflareRef references Cloudflare credentials (inherits from the
+	// first referencing CloudflareAccessPolicy if omitted).
+	// +optional
+	CloudflareRef *CloudflareSecretRef `json:"cloudflareRef,omitempty"`
+
+	// Name is the group's display name in the Cloudflare dashboard.
+	// Defaults to the CR name if omitted.
+	// +optional
+	// +kubebuilder:validation:MaxLength=255
+	Name string `json:"name,omitempty"`
+
+	// Include rules (ANY must match for the group to apply).
+	// +optional
+	Include []AccessRule `json:"include,omitempty"`
+
+	// Exclude rules (if ANY match, the group does not apply).
+	// +optional
+	Exclude []AccessRule `json:"exclude,omitempty"`
+
+	// Require rules (ALL must match for the group to apply).
+	// +optional
+	Require []AccessRule `json:"require,omitempty"`
+
+	// IsDefault auto-attaches this group to new Applications that don't
+	// otherwise reference any AccessGroupRef.
+	// +optional
+	// +kubebuilder:default=false
+	IsDefault bool `json:"isDefault,omitempty"`
+}
+
+// AccessGroupStatus defines the observed state of AccessGroup.
+type AccessGroupStatus struct {
+	// CloudflareGroupID is the Cloudflare-assigned ID for this group.
+	CloudflareGroupID string `json:"cloudflareGroupId,omitempty"`
+
+	// UsedByPolicies lists the CloudflareAccessPolicy resources, as
+	// "namespace/name", currently referencing this group via AccessGroupRef.
+	// +optional
+	UsedByPolicies []string `json:"usedByPolicies,omitempty"`
+
+	// ObservedGeneration is the last generation processed.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions describe current state.
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=cfgroup;cfaccessgroup
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="GroupID",type="string",JSONPath=".status.cloudflareGroupId"
+// +kubebuilder:printcolumn:name="Default",type="boolean",JSONPath=".spec.isDefault"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// AccessGroup is the Schema for the accessgroups API. It describes a
+// reusable Cloudflare Access group that many CloudflareAccessPolicy
+// resources can reference via AccessGroupRef, avoiding identity-rule
+// duplication across apps.
+//
+// EXPERIMENTAL: no controller reconciles this type yet. Applying an
+// AccessGroup creates only the Kubernetes object, and
+// CloudflareGroupID/UsedByPolicies are never populated. A reconciler that
+// creates/updates the group in Cloudflare and resolves AccessGroupRef at
+// reconcile time is still to be written.
+type AccessGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccessGroupSpec   `json:"spec,omitempty"`
+	Status AccessGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccessGroupList contains a list of AccessGroup.
+type AccessGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccessGroup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AccessGroup{}, &AccessGroupList{})
+}
@@ -2,6 +2,7 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -112,6 +113,140 @@ type AccessApplication struct {
 	// CustomDenyURL redirects to this URL when denied (instead of message).
 	// +optional
 	CustomDenyURL string `json:"customDenyUrl,omitempty"`
+
+	// ScimConfig enables SCIM 2.0 user/group provisioning for this Application.
+	// +optional
+	ScimConfig *ScimConfig `json:"scimConfig,omitempty"`
+}
+
+// ScimConfig defines SCIM 2.0 provisioning settings for an Access
+// Application.
+//
+// No controller reconciles this type yet: nothing writes ScimConfig into a
+// Cloudflare Application's scim_config, and CloudflareAccessPolicyStatus's
+// ScimEndpoint/ScimProvisioningState are never populated.
+type ScimConfig struct {
+	// Enabled activates SCIM provisioning.
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// RemoteUriEndpoint is the base URL of the application's SCIM API.
+	// +kubebuilder:validation:MinLength=1
+	RemoteUriEndpoint string `json:"remoteUriEndpoint"`
+
+	// IdpUID is the Cloudflare identity provider ID whose users/groups get provisioned.
+	// +kubebuilder:validation:MinLength=1
+	IdpUID string `json:"idpUid"`
+
+	// DeactivateOnDelete deactivates (rather than deletes) users removed from the IdP.
+	// +optional
+	// +kubebuilder:default=true
+	DeactivateOnDelete bool `json:"deactivateOnDelete,omitempty"`
+
+	// Authentication configures how Cloudflare authenticates to RemoteUriEndpoint.
+	// +kubebuilder:validation:Required
+	Authentication ScimAuthentication `json:"authentication"`
+
+	// Mappings configure per-resource-type (e.g. "Users", "Groups") provisioning behavior.
+	// +optional
+	Mappings []ScimMapping `json:"mappings,omitempty"`
+}
+
+// ScimAuthentication configures SCIM authentication. Exactly one of
+// HTTPBasic, OAuthBearerToken, or OAuth2 must be specified.
+// +kubebuilder:validation:XValidation:rule="[has(self.httpBasic), has(self.oauthBearerToken), has(self.oauth2)].exists_one(x, x)",message="exactly one of httpBasic, oauthBearerToken, or oauth2 must be specified"
+type ScimAuthentication struct {
+	// HTTPBasic authenticates with a username and password.
+	// +optional
+	HTTPBasic *ScimHTTPBasicAuth `json:"httpBasic,omitempty"`
+
+	// OAuthBearerToken authenticates with a static bearer token.
+	// +optional
+	OAuthBearerToken *ScimOAuthBearerTokenAuth `json:"oauthBearerToken,omitempty"`
+
+	// OAuth2 authenticates via an OAuth 2.0 client credentials flow.
+	// +optional
+	OAuth2 *ScimOAuth2Auth `json:"oauth2,omitempty"`
+}
+
+// ScimSecretRef references a Kubernetes Secret holding SCIM credential material.
+type ScimSecretRef struct {
+	// Name of the Secret.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// ScimHTTPBasicAuth authenticates with a username and password.
+type ScimHTTPBasicAuth struct {
+	// SecretRef references a Secret containing "username" and "password" keys.
+	SecretRef ScimSecretRef `json:"secretRef"`
+}
+
+// ScimOAuthBearerTokenAuth authenticates with a static bearer token.
+type ScimOAuthBearerTokenAuth struct {
+	// SecretRef references a Secret containing a "token" key.
+	SecretRef ScimSecretRef `json:"secretRef"`
+}
+
+// ScimOAuth2Auth authenticates via an OAuth 2.0 client credentials flow.
+type ScimOAuth2Auth struct {
+	// ClientID is the OAuth2 client ID.
+	// +kubebuilder:validation:MinLength=1
+	ClientID string `json:"clientId"`
+
+	// AuthorizationURL is the OAuth2 authorization endpoint.
+	// +kubebuilder:validation:MinLength=1
+	AuthorizationURL string `json:"authorizationUrl"`
+
+	// TokenURL is the OAuth2 token endpoint.
+	// +kubebuilder:validation:MinLength=1
+	TokenURL string `json:"tokenUrl"`
+
+	// Scopes requested from the authorization server.
+	// +optional
+	Scopes []string `json:"scopes,omitempty"`
+
+	// SecretRef references a Secret containing a "clientSecret" key.
+	SecretRef ScimSecretRef `json:"secretRef"`
+}
+
+// ScimMapping configures provisioning behavior for one SCIM resource schema.
+type ScimMapping struct {
+	// Schema is the SCIM resource schema URN, e.g.
+	// "urn:ietf:params:scim:schemas:core:2.0:User".
+	// +kubebuilder:validation:MinLength=1
+	Schema string `json:"schema"`
+
+	// Enabled activates provisioning for this schema.
+	// +kubebuilder:default=true
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Filter is a SCIM filter expression restricting which resources provision.
+	// +optional
+	Filter string `json:"filter,omitempty"`
+
+	// TransformJsonata is a JSONata expression transforming resources before provisioning.
+	// +optional
+	TransformJsonata string `json:"transformJsonata,omitempty"`
+
+	// Operations toggles which SCIM operations are applied for this schema.
+	// +optional
+	Operations ScimMappingOperations `json:"operations,omitempty"`
+}
+
+// ScimMappingOperations toggles which SCIM operations a ScimMapping applies.
+type ScimMappingOperations struct {
+	// Create provisions new resources.
+	// +kubebuilder:default=true
+	Create bool `json:"create,omitempty"`
+
+	// Update propagates resource changes.
+	// +kubebuilder:default=true
+	Update bool `json:"update,omitempty"`
+
+	// Delete deprovisions removed resources.
+	// +kubebuilder:default=true
+	Delete bool `json:"delete,omitempty"`
 }
 
 // AccessPolicyRule defines an access allow/deny rule.
@@ -168,7 +303,14 @@ type AccessPolicyRule struct {
 }
 
 // AccessRule defines identity matching criteria.
-// +kubebuilder:validation:XValidation:rule="[has(self.email), has(self.emailDomain), has(self.emailListRef), has(self.ipRange), has(self.country), has(self.everyone), has(self.certificate), has(self.commonName), has(self.serviceToken), has(self.groupRef), has(self.gsuite), has(self.github), has(self.azure), has(self.okta), has(self.saml)].exists(x, x)",message="at least one rule type must be specified"
+//
+// EXPERIMENTAL, see CloudflareAccessPolicy: no controller reconciles this
+// type yet, so there is no client translation layer that turns any
+// AccessRule variant - old or new - into a Cloudflare Access rule payload.
+// DevicePosture, LoginMethod, AuthMethod, ExternalEvaluation, GeoDistance,
+// and AnyValidServiceToken are schema-only until that reconciler and its
+// translation layer exist.
+// +kubebuilder:validation:XValidation:rule="[has(self.email), has(self.emailDomain), has(self.emailListRef), has(self.ipRange), has(self.country), has(self.everyone), has(self.certificate), has(self.commonName), has(self.serviceToken), has(self.groupRef), has(self.gsuite), has(self.github), has(self.azure), has(self.okta), has(self.saml), has(self.devicePosture), has(self.loginMethod), has(self.authMethod), has(self.externalEvaluation), has(self.geoDistance), has(self.anyValidServiceToken)].exists(x, x)",message="at least one rule type must be specified"
 type AccessRule struct {
 	// Email matches specific email addresses.
 	// +optional
@@ -229,6 +371,76 @@ type AccessRule struct {
 	// SAML matches SAML assertion attributes.
 	// +optional
 	SAML *SAMLRule `json:"saml,omitempty"`
+
+	// DevicePosture matches a configured device posture check (e.g. WARP,
+	// Crowdstrike, SentinelOne, Tanium, or Kolide integrations).
+	// +optional
+	DevicePosture *DevicePostureRule `json:"devicePosture,omitempty"`
+
+	// LoginMethod matches users who authenticated via one of the given identity providers.
+	// +optional
+	LoginMethod *LoginMethodRule `json:"loginMethod,omitempty"`
+
+	// AuthMethod matches the SAML authn-context of the current session (e.g. "mfa", "hwk", "otp").
+	// +optional
+	AuthMethod *AuthMethodRule `json:"authMethod,omitempty"`
+
+	// ExternalEvaluation delegates the match decision to an external HTTPS endpoint.
+	// +optional
+	ExternalEvaluation *ExternalEvalRule `json:"externalEvaluation,omitempty"`
+
+	// GeoDistance matches requests within a radius of a reference location.
+	// +optional
+	GeoDistance *GeoDistanceRule `json:"geoDistance,omitempty"`
+
+	// AnyValidServiceToken matches any currently valid service token, regardless of which.
+	// +optional
+	AnyValidServiceToken *bool `json:"anyValidServiceToken,omitempty"`
+}
+
+// DevicePostureRule matches a configured Cloudflare device posture check.
+type DevicePostureRule struct {
+	// IntegrationUID is the Cloudflare device posture rule ID.
+	// +kubebuilder:validation:MinLength=1
+	IntegrationUID string `json:"integrationUid"`
+}
+
+// LoginMethodRule matches users who authenticated via one of IdentityProviderIDs.
+type LoginMethodRule struct {
+	// IdentityProviderIDs are the Cloudflare identity providers accepted as login methods.
+	// +kubebuilder:validation:MinItems=1
+	IdentityProviderIDs []string `json:"identityProviderIds"`
+}
+
+// AuthMethodRule matches the SAML authn-context of the current session.
+type AuthMethodRule struct {
+	// AuthMethod is the required authn-context value, e.g. "mfa", "hwk", or "otp".
+	// +kubebuilder:validation:MinLength=1
+	AuthMethod string `json:"authMethod"`
+}
+
+// ExternalEvalRule delegates the match decision to an external HTTPS endpoint.
+type ExternalEvalRule struct {
+	// EvaluateURL is called with the request context to obtain a decision.
+	// +kubebuilder:validation:MinLength=1
+	EvaluateURL string `json:"evaluateUrl"`
+
+	// KeysURL serves the JWKS used to verify the endpoint's signed decision.
+	// +kubebuilder:validation:MinLength=1
+	KeysURL string `json:"keysUrl"`
+}
+
+// GeoDistanceRule matches requests within DistanceKm kilometers of a reference point.
+type GeoDistanceRule struct {
+	// Latitude of the reference point.
+	Latitude float64 `json:"latitude"`
+
+	// Longitude of the reference point.
+	Longitude float64 `json:"longitude"`
+
+	// DistanceKm is the maximum allowed distance, in kilometers, from the reference point.
+	// +kubebuilder:validation:Minimum=0
+	DistanceKm float64 `json:"distanceKm"`
 }
 
 // EmailRule matches specific email addresses.
@@ -277,7 +489,9 @@ type CommonNameRule struct {
 	Value string `json:"value"`
 }
 
-// AccessGroupRef references an AccessGroup CR or Cloudflare group.
+// AccessGroupRef references an AccessGroup CR or Cloudflare group. Exactly
+// one of Name or CloudflareID must be specified.
+// +kubebuilder:validation:XValidation:rule="[has(self.name), has(self.cloudflareId)].exists_one(x, x)",message="exactly one of name or cloudflareId must be specified"
 type AccessGroupRef struct {
 	// Name of AccessGroup CR in same namespace.
 	// +optional
@@ -289,10 +503,16 @@ type AccessGroupRef struct {
 }
 
 // GSuiteRule matches Google Workspace groups.
+// +kubebuilder:validation:XValidation:rule="[has(self.identityProviderId), has(self.identityProviderRef)].exists_one(x, x)",message="exactly one of identityProviderId or identityProviderRef must be specified"
 type GSuiteRule struct {
 	// IdentityProviderID in Cloudflare.
-	// +kubebuilder:validation:MinLength=1
-	IdentityProviderID string `json:"identityProviderId"`
+	// +optional
+	IdentityProviderID string `json:"identityProviderId,omitempty"`
+
+	// IdentityProviderRef references a CloudflareIdentityProvider CR in the
+	// same namespace, resolved to an ID at reconcile time.
+	// +optional
+	IdentityProviderRef *corev1.LocalObjectReference `json:"identityProviderRef,omitempty"`
 
 	// Groups to match.
 	// +optional
@@ -300,10 +520,16 @@ type GSuiteRule struct {
 }
 
 // GitHubRule matches GitHub organization membership.
+// +kubebuilder:validation:XValidation:rule="[has(self.identityProviderId), has(self.identityProviderRef)].exists_one(x, x)",message="exactly one of identityProviderId or identityProviderRef must be specified"
 type GitHubRule struct {
 	// IdentityProviderID in Cloudflare.
-	// +kubebuilder:validation:MinLength=1
-	IdentityProviderID string `json:"identityProviderId"`
+	// +optional
+	IdentityProviderID string `json:"identityProviderId,omitempty"`
+
+	// IdentityProviderRef references a CloudflareIdentityProvider CR in the
+	// same namespace, resolved to an ID at reconcile time.
+	// +optional
+	IdentityProviderRef *corev1.LocalObjectReference `json:"identityProviderRef,omitempty"`
 
 	// Organization name.
 	// +optional
@@ -315,10 +541,16 @@ type GitHubRule struct {
 }
 
 // AzureRule matches Azure AD groups.
+// +kubebuilder:validation:XValidation:rule="[has(self.identityProviderId), has(self.identityProviderRef)].exists_one(x, x)",message="exactly one of identityProviderId or identityProviderRef must be specified"
 type AzureRule struct {
 	// IdentityProviderID in Cloudflare.
-	// +kubebuilder:validation:MinLength=1
-	IdentityProviderID string `json:"identityProviderId"`
+	// +optional
+	IdentityProviderID string `json:"identityProviderId,omitempty"`
+
+	// IdentityProviderRef references a CloudflareIdentityProvider CR in the
+	// same namespace, resolved to an ID at reconcile time.
+	// +optional
+	IdentityProviderRef *corev1.LocalObjectReference `json:"identityProviderRef,omitempty"`
 
 	// Groups are Azure AD group IDs.
 	// +optional
@@ -326,10 +558,16 @@ type AzureRule struct {
 }
 
 // OktaRule matches Okta groups.
+// +kubebuilder:validation:XValidation:rule="[has(self.identityProviderId), has(self.identityProviderRef)].exists_one(x, x)",message="exactly one of identityProviderId or identityProviderRef must be specified"
 type OktaRule struct {
 	// IdentityProviderID in Cloudflare.
-	// +kubebuilder:validation:MinLength=1
-	IdentityProviderID string `json:"identityProviderId"`
+	// +optional
+	IdentityProviderID string `json:"identityProviderId,omitempty"`
+
+	// IdentityProviderRef references a CloudflareIdentityProvider CR in the
+	// same namespace, resolved to an ID at reconcile time.
+	// +optional
+	IdentityProviderRef *corev1.LocalObjectReference `json:"identityProviderRef,omitempty"`
 
 	// Groups to match.
 	// +optional
@@ -337,10 +575,16 @@ type OktaRule struct {
 }
 
 // SAMLRule matches SAML assertion attributes.
+// +kubebuilder:validation:XValidation:rule="[has(self.identityProviderId), has(self.identityProviderRef)].exists_one(x, x)",message="exactly one of identityProviderId or identityProviderRef must be specified"
 type SAMLRule struct {
 	// IdentityProviderID in Cloudflare.
-	// +kubebuilder:validation:MinLength=1
-	IdentityProviderID string `json:"identityProviderId"`
+	// +optional
+	IdentityProviderID string `json:"identityProviderId,omitempty"`
+
+	// IdentityProviderRef references a CloudflareIdentityProvider CR in the
+	// same namespace, resolved to an ID at reconcile time.
+	// +optional
+	IdentityProviderRef *corev1.LocalObjectReference `json:"identityProviderRef,omitempty"`
 
 	// AttributeName to match.
 	// +kubebuilder:validation:MinLength=1
@@ -446,6 +690,13 @@ type CloudflareAccessPolicySpec struct {
 	// +kubebuilder:validation:MaxItems=50
 	Policies []AccessPolicyRule `json:"policies,omitempty"`
 
+	// PolicyRefs reference CloudflareAccessReusablePolicy resources to attach
+	// to this Application's policies array, in addition to (or instead of)
+	// inline Policies. Evaluated in the order listed, after inline Policies.
+	// +optional
+	// +kubebuilder:validation:MaxItems=50
+	PolicyRefs []PolicyRef `json:"policyRefs,omitempty"`
+
 	// GroupRefs reference reusable identity rules.
 	// +optional
 	GroupRefs []AccessGroupRef `json:"groupRefs,omitempty"`
@@ -489,6 +740,15 @@ type CloudflareAccessPolicyStatus struct {
 	// MTLSRuleID is the Cloudflare mTLS rule ID.
 	MTLSRuleID string `json:"mtlsRuleId,omitempty"`
 
+	// ScimEndpoint is the Cloudflare-facing SCIM endpoint URL Cloudflare calls
+	// back into, reported once ScimConfig is reconciled. Not yet populated by
+	// any controller (see ScimConfig).
+	ScimEndpoint string `json:"scimEndpoint,omitempty"`
+
+	// ScimProvisioningState reports SCIM's last known sync state (e.g.
+	// "enabled", "disabled", "failed"), mirroring Cloudflare's scim_config status.
+	ScimProvisioningState string `json:"scimProvisioningState,omitempty"`
+
 	// ObservedGeneration is the last generation processed.
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 
@@ -513,7 +773,13 @@ type CloudflareAccessPolicyStatus struct {
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // CloudflareAccessPolicy is the Schema for the cloudflareaccespolicies API.
-// It manages Cloudflare Access Applications and Policies for zero-trust access control.
+// It describes Cloudflare Access Applications and Policies for zero-trust
+// access control.
+//
+// EXPERIMENTAL: no controller reconciles this type yet. Applying a
+// CloudflareAccessPolicy only persists the Kubernetes object - Status,
+// AccessApplication.ScimConfig (see ScimConfig), and every AccessRule
+// variant (see AccessRule) are schema-only until that reconciler exists.
 type CloudflareAccessPolicy struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
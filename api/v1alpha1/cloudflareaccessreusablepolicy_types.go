@@ -0,0 +1,145 @@
+// Package v1alpha1 contains API Schema definitions for the cfgate v1alpha1 API group.
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PolicyRef references a CloudflareAccessReusablePolicy by name, attaching it
+// to a CloudflareAccessPolicy's Application in addition to (or instead of)
+// inline Policies.
+type PolicyRef struct {
+	// Name of the CloudflareAccessReusablePolicy.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Namespace of the CloudflareAccessReusablePolicy.
+	// Cross-namespace targeting requires ReferenceGrant.
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+}
+
+// CloudflareAccessReusablePolicySpec defines the desired state of a
+// CloudflareAccessReusablePolicy. It mirrors AccessPolicyRule's fields but
+// exists independently of any Application, so multiple CloudflareAccessPolicy
+// resources can reference (and share) the same underlying Cloudflare policy
+// via PolicyRefs instead of each defining their own copy.
+// +kubebuilder:validation:XValidation:rule="has(self.include) || has(self.require)",message="at least one of include or require must be specified"
+type CloudflareAccessReusablePolicySpec struct {
+	// CloudflareRef references Cloudflare credentials (inherits from the
+	// first referencing CloudflareAccessPolicy if omitted).
+	// +optional
+	CloudflareRef *CloudflareSecretRef `json:"cloudflareRef,omitempty"`
+
+	// Decision is the policy action.
+	// +kubebuilder:validation:Enum=allow;deny;bypass;non_identity
+	// +kubebuilder:default=allow
+	Decision string `json:"decision"`
+
+	// Precedence determines rule evaluation order (lower = first) among the
+	// policies attached to a referencing Application.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=9999
+	// +optional
+	Precedence *int `json:"precedence,omitempty"`
+
+	// Include rules (ANY must match for rule to apply).
+	// +optional
+	Include []AccessRule `json:"include,omitempty"`
+
+	// Exclude rules (if ANY match, rule does not apply).
+	// +optional
+	Exclude []AccessRule `json:"exclude,omitempty"`
+
+	// Require rules (ALL must match for rule to apply).
+	// +optional
+	Require []AccessRule `json:"require,omitempty"`
+
+	// SessionDuration overrides the referencing Application's session
+	// duration wherever this policy is attached.
+	// +optional
+	SessionDuration string `json:"sessionDuration,omitempty"`
+
+	// PurposeJustificationRequired requires user to provide justification.
+	// +optional
+	// +kubebuilder:default=false
+	PurposeJustificationRequired bool `json:"purposeJustificationRequired,omitempty"`
+
+	// PurposeJustificationPrompt is the prompt shown to user.
+	// +optional
+	PurposeJustificationPrompt string `json:"purposeJustificationPrompt,omitempty"`
+
+	// ApprovalRequired requires approval from specific users.
+	// +optional
+	// +kubebuilder:default=false
+	ApprovalRequired bool `json:"approvalRequired,omitempty"`
+
+	// ApprovalGroups defines who can approve access requests.
+	// +optional
+	ApprovalGroups []ApprovalGroup `json:"approvalGroups,omitempty"`
+}
+
+// CloudflareAccessReusablePolicyStatus defines the observed state of
+// CloudflareAccessReusablePolicy.
+type CloudflareAccessReusablePolicyStatus struct {
+	// ReusablePolicyID is the Cloudflare-assigned ID for this policy, created
+	// once and attached to every referencing Application's policies array.
+	ReusablePolicyID string `json:"reusablePolicyId,omitempty"`
+
+	// ApplicationsUsing lists the referencing CloudflareAccessPolicy
+	// resources, as "namespace/name", currently attaching this policy via
+	// PolicyRefs. A reconciler should refuse to delete the underlying
+	// Cloudflare policy, and hold its finalizer, while this list is
+	// non-empty, preventing reference churn while Applications still depend
+	// on it.
+	// +optional
+	ApplicationsUsing []string `json:"applicationsUsing,omitempty"`
+
+	// ObservedGeneration is the last generation processed.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions describe current state.
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=cfreusable;cfaccessreusable
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="PolicyID",type="string",JSONPath=".status.reusablePolicyId"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// CloudflareAccessReusablePolicy is the Schema for the
+// cloudflareaccessreusablepolicies API. It describes a Cloudflare Access
+// reusable policy that can be attached to multiple Access Applications.
+//
+// EXPERIMENTAL: no controller reconciles this type yet. Applying one only
+// creates the Kubernetes object. ReusablePolicyID and ApplicationsUsing are
+// never populated, and PolicyRefs on CloudflareAccessPolicy are never
+// resolved or attached to a Cloudflare Application until that reconciler is
+// written.
+type CloudflareAccessReusablePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudflareAccessReusablePolicySpec   `json:"spec,omitempty"`
+	Status CloudflareAccessReusablePolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudflareAccessReusablePolicyList contains a list of
+// CloudflareAccessReusablePolicy.
+type CloudflareAccessReusablePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudflareAccessReusablePolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CloudflareAccessReusablePolicy{}, &CloudflareAccessReusablePolicyList{})
+}
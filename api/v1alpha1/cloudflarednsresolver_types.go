@@ -0,0 +1,117 @@
+// Package v1alpha1 contains API Schema definitions for the cfgate v1alpha1 API group.
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DNSSyncRef references a CloudflareDNSSync resource.
+type DNSSyncRef struct {
+	// Name is the name of the CloudflareDNSSync.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the CloudflareDNSSync.
+	// Defaults to the CloudflareDNSResolver's namespace.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// NameserverServiceConfig customizes the Service fronting the nameserver Deployment.
+type NameserverServiceConfig struct {
+	// Type is the Kubernetes Service type.
+	// +kubebuilder:validation:Enum=ClusterIP;NodePort;LoadBalancer
+	// +kubebuilder:default=ClusterIP
+	Type string `json:"type,omitempty"`
+
+	// ClusterIP pins the Service's ClusterIP, useful when cluster DNS (e.g.
+	// CoreDNS stub zones) needs a stable upstream address across reinstalls.
+	// +optional
+	ClusterIP string `json:"clusterIP,omitempty"`
+}
+
+// CloudflareDNSResolverSpec defines the desired state of CloudflareDNSResolver.
+type CloudflareDNSResolverSpec struct {
+	// DNSSyncRef references the CloudflareDNSSync whose hostnames this
+	// resolver answers authoritatively for. Only zones with
+	// InClusterResolution.Enabled set on that CloudflareDNSSync contribute
+	// records.
+	// +kubebuilder:validation:Required
+	DNSSyncRef DNSSyncRef `json:"dnsSyncRef"`
+
+	// Replicas is the nameserver Deployment's replica count.
+	// +kubebuilder:default=2
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// RecordTTL is the TTL advertised on answers this resolver serves.
+	// +kubebuilder:default="30s"
+	// +optional
+	RecordTTL metav1.Duration `json:"recordTTL,omitempty"`
+
+	// Service customizes the Service fronting the nameserver Deployment.
+	// +optional
+	Service NameserverServiceConfig `json:"service,omitempty"`
+
+	// EmitCorefile additionally renders a sample CoreDNS Corefile snippet,
+	// stub-zoning each managed zone to this resolver's Service, into a
+	// ConfigMap for cluster admins to merge into their CoreDNS config.
+	// +kubebuilder:default=true
+	EmitCorefile bool `json:"emitCorefile,omitempty"`
+}
+
+// CloudflareDNSResolverStatus defines the observed state of CloudflareDNSResolver.
+type CloudflareDNSResolverStatus struct {
+	// Zones lists the zones currently served authoritatively.
+	// +optional
+	Zones []string `json:"zones,omitempty"`
+
+	// RecordCount is the number of hostnames currently published to the
+	// nameserver's records ConfigMap.
+	RecordCount int32 `json:"recordCount,omitempty"`
+
+	// ObservedGeneration is the generation observed by the controller.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the
+	// resolver's state.
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=cfdnsres;dnsresolver
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Records",type="integer",JSONPath=".status.recordCount"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// CloudflareDNSResolver is the Schema for the cloudflarednsresolvers API.
+// It runs an in-cluster authoritative nameserver that answers A/AAAA/CNAME for the
+// same hostnames a CloudflareDNSSync publishes to Cloudflare, resolving them
+// to the backend Service's ClusterIP instead of the tunnel, so in-cluster
+// callers bypass the tunnel egress hop while external traffic keeps
+// resolving through Cloudflare.
+type CloudflareDNSResolver struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudflareDNSResolverSpec   `json:"spec,omitempty"`
+	Status CloudflareDNSResolverStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudflareDNSResolverList contains a list of CloudflareDNSResolver.
+type CloudflareDNSResolverList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudflareDNSResolver `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CloudflareDNSResolver{}, &CloudflareDNSResolverList{})
+}
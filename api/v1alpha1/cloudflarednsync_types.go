@@ -2,6 +2,7 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -28,6 +29,59 @@ type ZoneConfig struct {
 	ID string `json:"id,omitempty"`
 }
 
+// ApexDNSProvider selects and configures a non-Cloudflare DNS backend (see
+// internal/dns.Provider) that each synced hostname's tunnel CNAME is
+// additionally written into.
+type ApexDNSProvider struct {
+	// Type selects the provider implementation.
+	// +kubebuilder:validation:Enum=Route53;RFC2136
+	// +kubebuilder:validation:Required
+	Type string `json:"type"`
+
+	// ZoneName is the zone name to manage at this provider (e.g. example.com).
+	// +kubebuilder:validation:Required
+	ZoneName string `json:"zoneName"`
+
+	// Route53 configures the Route53 provider. Required when Type is Route53.
+	// +optional
+	Route53 *Route53ProviderConfig `json:"route53,omitempty"`
+
+	// RFC2136 configures the RFC2136 provider. Required when Type is RFC2136.
+	// +optional
+	RFC2136 *RFC2136ProviderConfig `json:"rfc2136,omitempty"`
+}
+
+// Route53ProviderConfig configures the Route53 ApexDNSProvider.
+type Route53ProviderConfig struct {
+	// Region is the AWS region Route 53 API calls are made against.
+	// +kubebuilder:default="us-east-1"
+	Region string `json:"region,omitempty"`
+
+	// CredentialsRef references a secret holding AWS credentials
+	// (AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY keys). If unset, the
+	// controller's own IAM identity (e.g. IRSA) is used.
+	// +optional
+	CredentialsRef *SecretReference `json:"credentialsRef,omitempty"`
+}
+
+// RFC2136ProviderConfig configures the RFC2136 ApexDNSProvider.
+type RFC2136ProviderConfig struct {
+	// Nameserver is the authoritative nameserver's "host:port" address
+	// Dynamic Updates and zone transfers are sent to.
+	// +kubebuilder:validation:Required
+	Nameserver string `json:"nameserver"`
+
+	// TSIGKeyName is the TSIG key name used to authenticate updates.
+	// +optional
+	TSIGKeyName string `json:"tsigKeyName,omitempty"`
+
+	// TSIGSecretRef references a secret whose "secret" key holds the
+	// base64-encoded TSIG secret, and whose "algorithm" key (optional,
+	// defaults to hmac-sha256) names the TSIG algorithm.
+	// +optional
+	TSIGSecretRef *SecretReference `json:"tsigSecretRef,omitempty"`
+}
+
 // GatewayRoutesSource configures watching Gateway API routes for hostnames.
 type GatewayRoutesSource struct {
 	// Enabled enables watching Gateway API routes.
@@ -37,6 +91,50 @@ type GatewayRoutesSource struct {
 	// AnnotationFilter only syncs routes with this annotation.
 	// +optional
 	AnnotationFilter string `json:"annotationFilter,omitempty"`
+
+	// RouteKinds restricts which Gateway API route kinds are watched and
+	// enumerated for hostnames. Supported values: HTTPRoute, GRPCRoute,
+	// TLSRoute, TCPRoute, UDPRoute. TCPRoute and UDPRoute carry no Hostnames
+	// field and can't be proxied by a Cloudflare Tunnel, so enabling either
+	// only surfaces visibility (events/logs), never a synced DNS record.
+	// +kubebuilder:default={HTTPRoute}
+	// +optional
+	RouteKinds []string `json:"routeKinds,omitempty"`
+}
+
+// IngressSource configures watching networking.k8s.io Ingress resources for
+// hostnames, for clusters fronting services with plain Ingress instead of
+// Gateway API routes.
+type IngressSource struct {
+	// Enabled enables watching Ingress resources. An Ingress only
+	// contributes hostnames once it carries the same tunnel-reference
+	// annotation (cfgate.io/tunnel-ref) Gateways use to opt into this
+	// CloudflareDNSSync's tunnel.
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// IngressClassName restricts watched Ingresses to this
+	// spec.ingressClassName. Empty matches Ingresses of any class.
+	// +optional
+	IngressClassName string `json:"ingressClassName,omitempty"`
+
+	// AnnotationFilter only syncs Ingresses with this annotation.
+	// +optional
+	AnnotationFilter string `json:"annotationFilter,omitempty"`
+}
+
+// ServiceSource configures watching Service resources for hostnames,
+// opted into directly via the cfgate.io/hostname annotation rather than a
+// Gateway-style tunnel reference, for backends with no Ingress or Gateway
+// route of their own.
+type ServiceSource struct {
+	// Enabled enables watching Services for the cfgate.io/hostname annotation.
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// AnnotationFilter only syncs Services that also carry this annotation.
+	// +optional
+	AnnotationFilter string `json:"annotationFilter,omitempty"`
 }
 
 // ExplicitHostname defines an explicit hostname to sync.
@@ -56,6 +154,72 @@ type ExplicitHostname struct {
 	// TTL is the DNS record TTL. Use "auto" for Cloudflare automatic TTL.
 	// +kubebuilder:default="auto"
 	TTL string `json:"ttl,omitempty"`
+
+	// HealthCheck overrides Spec.HealthCheck for this hostname. Nil inherits
+	// the CloudflareDNSSync-wide policy.
+	// +optional
+	HealthCheck *DNSHealthCheckPolicy `json:"healthCheck,omitempty"`
+
+	// RoutingPolicy selects the traffic-steering strategy applied across
+	// Routing's targets. Defaults to Weighted when Routing has more than
+	// one entry and RoutingPolicy is unset.
+	// +optional
+	RoutingPolicy RoutingPolicy `json:"routingPolicy,omitempty"`
+
+	// Routing lists multiple targets for this hostname. When non-empty,
+	// the controller provisions a Cloudflare Load Balancer pool and load
+	// balancer for Hostname instead of a plain CNAME to Target, and
+	// Target is ignored.
+	// +optional
+	Routing []TargetEntry `json:"routing,omitempty"`
+}
+
+// RoutingPolicy selects the traffic-steering strategy a Cloudflare Load
+// Balancer applies across a hostname's Routing targets.
+// +kubebuilder:validation:Enum=Weighted;Geo;Failover
+type RoutingPolicy string
+
+const (
+	// RoutingPolicyWeighted distributes traffic across targets in
+	// proportion to their Weight.
+	RoutingPolicyWeighted RoutingPolicy = "Weighted"
+
+	// RoutingPolicyGeo steers traffic to the target(s) whose GeoRegions
+	// includes the requester's Cloudflare region.
+	RoutingPolicyGeo RoutingPolicy = "Geo"
+
+	// RoutingPolicyFailover sends all traffic to the lowest-Priority
+	// healthy target, falling over to the next on failure.
+	RoutingPolicyFailover RoutingPolicy = "Failover"
+)
+
+// TargetEntry is one target within a hostname's Routing set, provisioned
+// as a Cloudflare Load Balancer pool origin.
+type TargetEntry struct {
+	// Target is the origin address (CNAME target or IP) for this entry.
+	// +kubebuilder:validation:Required
+	Target string `json:"target"`
+
+	// Weight sets this target's share of traffic under the Weighted
+	// routing policy, normalized against the other targets' weights.
+	// Ignored for Geo and Failover.
+	// +kubebuilder:default=1
+	Weight int `json:"weight,omitempty"`
+
+	// GeoRegions lists the Cloudflare region codes (e.g. WNAM, EEU) this
+	// target serves under the Geo routing policy. Ignored otherwise.
+	// +optional
+	GeoRegions []string `json:"geoRegions,omitempty"`
+
+	// Priority orders this target within a failover pool; lower values
+	// are preferred. Used only under the Failover routing policy.
+	// +kubebuilder:default=0
+	Priority int `json:"priority,omitempty"`
+
+	// Enabled allows temporarily removing a target from rotation without
+	// deleting it from the spec.
+	// +kubebuilder:default=true
+	Enabled bool `json:"enabled,omitempty"`
 }
 
 // HostnameSource defines sources for hostnames to sync.
@@ -64,6 +228,14 @@ type HostnameSource struct {
 	// +optional
 	GatewayRoutes GatewayRoutesSource `json:"gatewayRoutes,omitempty"`
 
+	// Ingress configures watching networking.k8s.io Ingress resources.
+	// +optional
+	Ingress IngressSource `json:"ingress,omitempty"`
+
+	// Service configures watching Service resources annotated with a hostname.
+	// +optional
+	Service ServiceSource `json:"service,omitempty"`
+
 	// Explicit defines explicit hostnames to sync.
 	// +optional
 	Explicit []ExplicitHostname `json:"explicit,omitempty"`
@@ -89,6 +261,29 @@ type TXTRecordOwnership struct {
 	// Prefix is the prefix for TXT record names.
 	// +kubebuilder:default="_cfgate"
 	Prefix string `json:"prefix,omitempty"`
+
+	// OwnerID overrides the owner identity written into ownership TXT records
+	// and compared against on update/delete. Defaults to the DNSSync's
+	// "<namespace>/<name>". Set this when the same tunnel is managed from
+	// multiple clusters so each needs a distinct, stable owner string
+	// (namespace/name alone wouldn't be unique across clusters).
+	// +optional
+	OwnerID string `json:"ownerID,omitempty"`
+
+	// SigningKeySecretRef references a Secret whose "key" data entry is an
+	// HMAC key used to sign ownership TXT records, so another party writing
+	// the same plaintext payload into a shared zone can't spoof ownership of
+	// a hostname. When unset, ownership records are written and trusted
+	// unsigned, as before.
+	// +optional
+	SigningKeySecretRef *SecretReference `json:"signingKeySecretRef,omitempty"`
+
+	// AcceptLegacyMarkers allows unsigned ownership records to still be
+	// trusted while SigningKeySecretRef is set, so enabling signing doesn't
+	// make every hostname this instance already owns look conflicting on the
+	// next reconcile. Disable once all records have been re-signed.
+	// +kubebuilder:default=false
+	AcceptLegacyMarkers bool `json:"acceptLegacyMarkers,omitempty"`
 }
 
 // CommentOwnership configures comment-based ownership tracking.
@@ -102,6 +297,20 @@ type CommentOwnership struct {
 	Template string `json:"template,omitempty"`
 }
 
+// OwnerReferenceOwnership configures owner-reference-based ownership
+// tracking: each record this instance creates or updates is recorded by ID
+// in Status.ManagedRecords, and cleanup deletes exactly those IDs instead of
+// listing the zone and filtering by TXT/comment ownership markers. This
+// avoids the extra TXT-record write per hostname that TXTRecord ownership
+// requires. Can be enabled alongside TXTRecord: owner-reference deletes are
+// then preferred, with the TXT record kept as a secondary,
+// human-inspectable ownership marker.
+type OwnerReferenceOwnership struct {
+	// Enabled enables owner-reference-based ownership tracking.
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+}
+
 // OwnershipConfig defines how to track record ownership.
 type OwnershipConfig struct {
 	// TXTRecord configures TXT record-based ownership.
@@ -111,6 +320,11 @@ type OwnershipConfig struct {
 	// Comment configures comment-based ownership.
 	// +optional
 	Comment CommentOwnership `json:"comment,omitempty"`
+
+	// OwnerReference configures owner-reference-based ownership, tracking
+	// created record IDs in Status.ManagedRecords instead of writing TXT records.
+	// +optional
+	OwnerReference OwnerReferenceOwnership `json:"ownerReference,omitempty"`
 }
 
 // CleanupPolicy defines what to do when records are no longer needed.
@@ -126,6 +340,152 @@ type CleanupPolicy struct {
 	// OnlyManaged only deletes records that were created by cfgate.
 	// +kubebuilder:default=true
 	OnlyManaged bool `json:"onlyManaged,omitempty"`
+
+	// DeletionFailurePolicy selects what happens when a Cloudflare API error
+	// (other than 404 not-found, which is treated as already-deleted) is hit
+	// while deleting a record. Ignore proceeds with finalizer removal
+	// regardless, accepting the record may be orphaned. Retry requeues the
+	// deletion up to MaxDeletionRetries with exponential backoff before
+	// falling back to Ignore. Fail blocks finalizer removal entirely,
+	// leaving the resource Deleting/Degraded with the error surfaced on
+	// Status.DeletionErrors, until an operator intervenes or the failing
+	// record is deleted out-of-band.
+	// +kubebuilder:validation:Enum=Ignore;Retry;Fail
+	// +kubebuilder:default=Ignore
+	DeletionFailurePolicy string `json:"deletionFailurePolicy,omitempty"`
+
+	// DeletionGracePeriod bounds how long DeletionFailurePolicy=Retry (or
+	// Fail, while still under MaxDeletionRetries) keeps retrying a failed
+	// deletion before giving up.
+	// +kubebuilder:default="5m"
+	DeletionGracePeriod metav1.Duration `json:"deletionGracePeriod,omitempty"`
+
+	// MaxDeletionRetries bounds the number of deletion attempts made against
+	// a single record under DeletionFailurePolicy=Retry or Fail.
+	// +kubebuilder:default=5
+	MaxDeletionRetries int32 `json:"maxDeletionRetries,omitempty"`
+}
+
+// TunnelConfigSync configures whether the tunnel's ingress configuration
+// is kept in sync alongside DNS records.
+type TunnelConfigSync struct {
+	// ManageIngress enables writing the tunnel's ingress configuration
+	// (via the Cloudflare cfd_tunnel/{id}/configurations API) whenever
+	// hostnames are synced, so each hostname actually routes to its backend
+	// and not just resolves in DNS.
+	// +kubebuilder:default=false
+	ManageIngress bool `json:"manageIngress,omitempty"`
+}
+
+// IngressOriginRequest configures cloudflared's connection behavior to the
+// origin for a hostname's ingress rule.
+type IngressOriginRequest struct {
+	// NoTLSVerify disables TLS certificate verification when connecting to
+	// the origin.
+	// +optional
+	NoTLSVerify bool `json:"noTLSVerify,omitempty"`
+
+	// ConnectTimeoutSeconds overrides cloudflared's origin connection timeout.
+	// +optional
+	ConnectTimeoutSeconds int32 `json:"connectTimeoutSeconds,omitempty"`
+
+	// HTTPHostHeader overrides the Host header cloudflared sends to the origin.
+	// +optional
+	HTTPHostHeader string `json:"httpHostHeader,omitempty"`
+}
+
+// IngressOverride customizes the tunnel ingress rule generated for Hostname,
+// layering path matching and origin-request behavior on top of the backend
+// the hostname was discovered from (currently Source.GatewayRoutes HTTPRoutes).
+type IngressOverride struct {
+	// Hostname must match a hostname discovered from Source; overrides for a
+	// hostname with no corresponding ingress rule are ignored.
+	// +kubebuilder:validation:Required
+	Hostname string `json:"hostname"`
+
+	// Path restricts this rule to requests under Path, e.g. "/api". Empty
+	// matches all paths for Hostname.
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// OriginRequest configures cloudflared's connection behavior to the
+	// origin for Hostname.
+	// +optional
+	OriginRequest IngressOriginRequest `json:"originRequest,omitempty"`
+}
+
+// InClusterResolution configures whether hostnames synced by this
+// CloudflareDNSSync are also published to a CloudflareDNSResolver nameserver,
+// so in-cluster callers resolve straight to the backend Service's ClusterIP
+// instead of round-tripping through the Cloudflare tunnel.
+type InClusterResolution struct {
+	// Enabled opts this CloudflareDNSSync's hostnames into in-cluster
+	// resolution. A CloudflareDNSResolver must also reference this
+	// CloudflareDNSSync for the toggle to take effect.
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+}
+
+// DNSHealthCheckPolicy configures a Cloudflare Standalone Health Check for a
+// managed hostname, modeled after Kuadrant's DNSHealthCheckProbe. Probe
+// results are reflected in CloudflareDNSSyncStatus.HealthCheckProbes and
+// drive UnhealthyAction once FailureThreshold consecutive probes fail.
+type DNSHealthCheckPolicy struct {
+	// Enabled creates and monitors a Cloudflare Health Check for the
+	// hostname(s) this policy applies to.
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Path is the HTTP(S) request path probed. Ignored for Protocol=TCP.
+	// +kubebuilder:default="/"
+	Path string `json:"path,omitempty"`
+
+	// Port is the port probed.
+	// +kubebuilder:default=80
+	Port int32 `json:"port,omitempty"`
+
+	// Protocol is the probe protocol.
+	// +kubebuilder:validation:Enum=HTTP;HTTPS;TCP
+	// +kubebuilder:default=HTTP
+	Protocol string `json:"protocol,omitempty"`
+
+	// ExpectedResponseCodes lists acceptable HTTP response code ranges
+	// (e.g. "2xx"). Ignored for Protocol=TCP.
+	// +kubebuilder:default={"2xx"}
+	ExpectedResponseCodes []string `json:"expectedResponseCodes,omitempty"`
+
+	// Interval is the time between probes.
+	// +kubebuilder:default="60s"
+	// +optional
+	Interval metav1.Duration `json:"interval,omitempty"`
+
+	// FailureThreshold is the number of consecutive failed probes before
+	// the hostname is considered unhealthy and UnhealthyAction applies.
+	// +kubebuilder:default=3
+	FailureThreshold int32 `json:"failureThreshold,omitempty"`
+
+	// AllowInsecureCertificate skips TLS certificate validation for
+	// Protocol=HTTPS probes.
+	// +kubebuilder:default=false
+	AllowInsecureCertificate bool `json:"allowInsecureCertificate,omitempty"`
+
+	// UnhealthyAction selects what happens once a hostname has been
+	// unhealthy for FailureThreshold consecutive probes: Remove deletes
+	// that target from the record (or, for a single-target CNAME, the
+	// record itself) until it recovers; MarkOnly leaves the record in
+	// place and only reflects the state in status and an emitted Event.
+	// +kubebuilder:validation:Enum=Remove;MarkOnly
+	// +kubebuilder:default=MarkOnly
+	UnhealthyAction string `json:"unhealthyAction,omitempty"`
+}
+
+// ConcurrencyConfig controls how many hostnames are synced in parallel.
+type ConcurrencyConfig struct {
+	// MaxParallel is the maximum number of hostnames synced concurrently in
+	// a single reconcile. Hostnames are still subject to per-zone and
+	// account-wide Cloudflare API rate limiting on top of this bound.
+	// +kubebuilder:default=8
+	MaxParallel int32 `json:"maxParallel,omitempty"`
 }
 
 // CloudflareDNSSyncSpec defines the desired state of CloudflareDNSSync.
@@ -134,9 +494,23 @@ type CloudflareDNSSyncSpec struct {
 	// +kubebuilder:validation:Required
 	TunnelRef TunnelRef `json:"tunnelRef"`
 
-	// Zones defines the DNS zones to manage.
+	// TunnelConfig controls whether the tunnel's ingress configuration is
+	// synced alongside DNS records.
+	// +optional
+	TunnelConfig TunnelConfigSync `json:"tunnelConfig,omitempty"`
+
+	// Zones references the CloudflareManagedZone resources, in this
+	// namespace, whose zones this CloudflareDNSSync manages records in.
+	// +optional
+	Zones []corev1.LocalObjectReference `json:"zones,omitempty"`
+
+	// ApexProvider additionally writes each synced hostname's tunnel CNAME
+	// into a non-Cloudflare authoritative DNS provider, for the common split
+	// where the tunnel lives on Cloudflare but apex DNS is hosted elsewhere.
+	// Zones remains how Cloudflare-hosted zones (including the tunnel's own
+	// *.cfargotunnel.com delegation) are managed; this is additive.
 	// +optional
-	Zones []ZoneConfig `json:"zones,omitempty"`
+	ApexProvider *ApexDNSProvider `json:"apexProvider,omitempty"`
 
 	// Source defines where to get hostnames to sync.
 	// +optional
@@ -160,6 +534,79 @@ type CloudflareDNSSyncSpec struct {
 	// The secret must contain CLOUDFLARE_API_TOKEN key.
 	// +optional
 	FallbackCredentialsRef *SecretReference `json:"fallbackCredentialsRef,omitempty"`
+
+	// DryRun computes the change plan and publishes it to Status.PendingChanges
+	// and as events, without applying any mutation to Cloudflare.
+	// +kubebuilder:default=false
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// PruneOrphans controls whether cfgate-managed records with no corresponding
+	// desired hostname are planned for deletion. When false, such records are
+	// left in place even though they're no longer wanted.
+	// +kubebuilder:default=true
+	PruneOrphans bool `json:"pruneOrphans,omitempty"`
+
+	// Concurrency controls how many hostnames are synced to Cloudflare in
+	// parallel per reconcile.
+	// +optional
+	Concurrency ConcurrencyConfig `json:"concurrency,omitempty"`
+
+	// Ingress customizes the tunnel ingress rule generated for specific
+	// hostnames (path matching, origin-request behavior). Only takes effect
+	// when TunnelConfig.ManageIngress is enabled.
+	// +optional
+	Ingress []IngressOverride `json:"ingress,omitempty"`
+
+	// InClusterResolution opts this CloudflareDNSSync's hostnames into
+	// split-horizon resolution by an in-cluster CloudflareDNSResolver.
+	// +optional
+	InClusterResolution InClusterResolution `json:"inClusterResolution,omitempty"`
+
+	// HealthCheck configures a Cloudflare Health Check monitoring every
+	// managed hostname, overridable per-hostname via
+	// HostnameSource.Explicit[].HealthCheck.
+	// +optional
+	HealthCheck DNSHealthCheckPolicy `json:"healthCheck,omitempty"`
+
+	// RequeueInterval is the base interval between successful reconciles.
+	// The actual requeue is jittered around this value so many
+	// CloudflareDNSSync resources sharing a Cloudflare API token don't all
+	// requeue in lockstep.
+	// +kubebuilder:default="5m"
+	// +optional
+	RequeueInterval metav1.Duration `json:"requeueInterval,omitempty"`
+
+	// MaxBackoff caps the exponentially increasing, jittered requeue interval
+	// applied after repeated reconcile failures (e.g. Cloudflare rate
+	// limiting or an API outage).
+	// +kubebuilder:default="5m"
+	// +optional
+	MaxBackoff metav1.Duration `json:"maxBackoff,omitempty"`
+}
+
+// PendingChange previews a single planned-but-not-yet-applied DNS mutation.
+// Populated only when Spec.DryRun is true.
+type PendingChange struct {
+	// Action is one of Create, Update, Delete.
+	Action string `json:"action"`
+
+	// Hostname is the DNS record name affected.
+	Hostname string `json:"hostname"`
+
+	// Type is the DNS record type (e.g., CNAME).
+	Type string `json:"type"`
+
+	// From is the existing record content this change would replace (empty
+	// for Create, since there's no existing record).
+	From string `json:"from,omitempty"`
+
+	// Target is the desired record content (empty for Delete).
+	Target string `json:"target,omitempty"`
+
+	// Reason is a short, human-readable explanation of why this change is
+	// planned, e.g. "no existing record", "content differs", or "orphaned,
+	// no longer desired".
+	Reason string `json:"reason,omitempty"`
 }
 
 // DNSRecordStatus represents the status of a single DNS record.
@@ -176,7 +623,7 @@ type DNSRecordStatus struct {
 	// Proxied indicates if Cloudflare proxy is enabled.
 	Proxied bool `json:"proxied"`
 
-	// Status is the sync status: Synced, Pending, Failed.
+	// Status is the sync status: Synced, Pending, Failed, Unhealthy.
 	Status string `json:"status"`
 
 	// RecordID is the Cloudflare record ID.
@@ -186,6 +633,108 @@ type DNSRecordStatus struct {
 	// Error contains the error message if status is Failed.
 	// +optional
 	Error string `json:"error,omitempty"`
+
+	// PoolID is the Cloudflare Load Balancer pool ID backing this
+	// hostname. Set only when the hostname's ExplicitHostname.Routing
+	// provisioned a Load Balancer instead of a plain CNAME.
+	// +optional
+	PoolID string `json:"poolId,omitempty"`
+
+	// LoadBalancerID is the Cloudflare Load Balancer ID for this
+	// hostname. Set only alongside PoolID.
+	// +optional
+	LoadBalancerID string `json:"loadBalancerId,omitempty"`
+
+	// Targets reports per-target health and weight when this hostname is
+	// backed by a Load Balancer pool.
+	// +optional
+	Targets []TargetStatus `json:"targets,omitempty"`
+}
+
+// TargetStatus reports one Routing target's observed health and traffic
+// share within its Load Balancer pool.
+type TargetStatus struct {
+	// Target is the origin address, matching the TargetEntry it was
+	// provisioned from.
+	Target string `json:"target"`
+
+	// Healthy is the target's current monitor status.
+	Healthy bool `json:"healthy"`
+
+	// CurrentWeight is the target's currently applied traffic share,
+	// normalized across the pool (e.g. 0.5 for an even two-target split).
+	CurrentWeight float64 `json:"currentWeight,omitempty"`
+}
+
+// ManagedRecordRef identifies a single Cloudflare DNS record this
+// CloudflareDNSSync created, used by Ownership.OwnerReference-mode cleanup to
+// delete exactly the records this instance owns without listing and
+// filtering the whole zone.
+type ManagedRecordRef struct {
+	// ZoneID is the Cloudflare zone the record belongs to.
+	ZoneID string `json:"zoneID"`
+
+	// Hostname is the DNS hostname.
+	Hostname string `json:"hostname"`
+
+	// RecordType is the DNS record type (e.g., CNAME, TXT).
+	RecordType string `json:"recordType"`
+
+	// RecordID is the Cloudflare record ID.
+	RecordID string `json:"recordID"`
+
+	// OwnerUID is this CloudflareDNSSync's UID at the time the record was
+	// created, guarding against acting on stale entries left over from a
+	// deleted-and-recreated resource that happens to reuse the name.
+	OwnerUID string `json:"ownerUID"`
+}
+
+// DeletionError records a Cloudflare API error hit while deleting a record
+// under CleanupPolicy.DeletionFailurePolicy=Retry or Fail.
+type DeletionError struct {
+	// Hostname is the DNS hostname the record belonged to.
+	Hostname string `json:"hostname"`
+
+	// ZoneID is the Cloudflare zone the record belongs to.
+	ZoneID string `json:"zoneID"`
+
+	// RecordID is the Cloudflare record ID that failed to delete.
+	RecordID string `json:"recordID"`
+
+	// Code is the Cloudflare API error code, when the error exposes one.
+	// +optional
+	Code string `json:"code,omitempty"`
+
+	// Message is the error returned by the Cloudflare API.
+	Message string `json:"message"`
+
+	// RetryCount is the number of deletion attempts made so far.
+	RetryCount int32 `json:"retryCount"`
+
+	// LastAttemptTime is when the most recent deletion attempt was made.
+	LastAttemptTime metav1.Time `json:"lastAttemptTime"`
+}
+
+// CloudflareHealthCheckProbe records the Cloudflare Health Check state for
+// one hostname managed under Spec.HealthCheck (or a per-hostname override).
+type CloudflareHealthCheckProbe struct {
+	// Hostname is the monitored hostname.
+	Hostname string `json:"hostname"`
+
+	// HealthCheckID is the Cloudflare Health Check ID.
+	HealthCheckID string `json:"healthCheckID,omitempty"`
+
+	// Healthy is the health check's current status.
+	Healthy bool `json:"healthy"`
+
+	// ConsecutiveFailures is the number of consecutive failed probes
+	// observed so far. Reset to 0 on the first successful probe after a
+	// failure.
+	ConsecutiveFailures int32 `json:"consecutiveFailures,omitempty"`
+
+	// LastProbeTime is when this hostname's health was last checked.
+	// +optional
+	LastProbeTime *metav1.Time `json:"lastProbeTime,omitempty"`
 }
 
 // CloudflareDNSSyncStatus defines the observed state of CloudflareDNSSync.
@@ -199,13 +748,54 @@ type CloudflareDNSSyncStatus struct {
 	// FailedRecords is the number of records that failed to sync.
 	FailedRecords int32 `json:"failedRecords,omitempty"`
 
+	// HealthyRecords is the number of health-checked hostnames currently
+	// considered healthy. Populated only when Spec.HealthCheck (or a
+	// per-hostname override) is enabled.
+	// +optional
+	HealthyRecords int32 `json:"healthyRecords,omitempty"`
+
+	// UnhealthyRecords is the number of health-checked hostnames that have
+	// failed FailureThreshold consecutive probes.
+	// +optional
+	UnhealthyRecords int32 `json:"unhealthyRecords,omitempty"`
+
+	// HealthCheckProbes records per-hostname health check state.
+	// +optional
+	HealthCheckProbes []CloudflareHealthCheckProbe `json:"healthCheckProbes,omitempty"`
+
 	// Records contains the status of individual DNS records.
 	// +optional
 	Records []DNSRecordStatus `json:"records,omitempty"`
 
+	// ManagedRecords lists the Cloudflare records this instance created,
+	// populated when Ownership.OwnerReference.Enabled is set, so cleanup can
+	// delete them by ID instead of rediscovering them via TXT/comment markers.
+	// +optional
+	ManagedRecords []ManagedRecordRef `json:"managedRecords,omitempty"`
+
+	// PendingChanges previews the plan that would be applied on the next
+	// reconcile. Only populated when Spec.DryRun is true.
+	// +optional
+	PendingChanges []PendingChange `json:"pendingChanges,omitempty"`
+
+	// DeletionErrors records Cloudflare API errors hit while deleting
+	// records during resource teardown, under
+	// CleanupPolicy.DeletionFailurePolicy=Retry or Fail. Cleared once every
+	// recorded record is deleted successfully.
+	// +optional
+	DeletionErrors []DeletionError `json:"deletionErrors,omitempty"`
+
 	// ObservedGeneration is the generation observed by the controller.
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 
+	// ObservedIngressConfigHash is a hex-encoded fingerprint of the tunnel's
+	// DNS-relevant configuration and the ingress rules computed for it on
+	// the reconcile that last ran the tunnel ingress sync. The next
+	// reconcile recomputes the same fingerprint and skips the Cloudflare
+	// API round trip when it's unchanged.
+	// +optional
+	ObservedIngressConfigHash string `json:"observedIngressConfigHash,omitempty"`
+
 	// LastSyncTime is the last time records were synced.
 	// +optional
 	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
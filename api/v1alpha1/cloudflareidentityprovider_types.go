@@ -0,0 +1,152 @@
+// Package v1alpha1 contains API Schema definitions for the cfgate v1alpha1 API group.
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// IdentityProviderSecretRef references a Kubernetes Secret holding the
+// upstream identity provider's client credentials.
+type IdentityProviderSecretRef struct {
+	// Name of the Secret, expected to contain "clientSecret" (and, for SAML,
+	// "signingCertificate" if not supplied inline via Config).
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// IdentityProviderConfig holds the provider-specific settings for a
+// CloudflareIdentityProvider. Which fields apply depends on Type, mirroring
+// how Cloudflare's own access identity provider API accepts a single config
+// object shaped differently per type.
+type IdentityProviderConfig struct {
+	// ClientID is the OAuth client ID issued by the upstream provider.
+	// Required for google, azureAD, okta, github, and oidc.
+	// +optional
+	ClientID string `json:"clientId,omitempty"`
+
+	// Domain is the provider's hosted domain, e.g. an Okta org domain or
+	// Azure AD tenant domain. Required for okta and azureAD.
+	// +optional
+	Domain string `json:"domain,omitempty"`
+
+	// DirectoryID is the Azure AD tenant (directory) ID. Required for azureAD.
+	// +optional
+	DirectoryID string `json:"directoryId,omitempty"`
+
+	// SupportGroups enables group-claim based Access rules (GSuiteRule,
+	// AzureRule, OktaRule) for this provider.
+	// +optional
+	// +kubebuilder:default=false
+	SupportGroups bool `json:"supportGroups,omitempty"`
+
+	// IssuerURL is the SAML or OIDC issuer URL. Required for saml and oidc.
+	// +optional
+	IssuerURL string `json:"issuerUrl,omitempty"`
+
+	// SSOTargetURL is the SAML IdP single sign-on URL. Required for saml.
+	// +optional
+	SSOTargetURL string `json:"ssoTargetUrl,omitempty"`
+
+	// SigningCertificate is the PEM-encoded SAML IdP signing certificate,
+	// used if not supplied via SecretRef. Required for saml unless SecretRef
+	// carries it.
+	// +optional
+	SigningCertificate string `json:"signingCertificate,omitempty"`
+
+	// AuthURL is the OIDC authorization endpoint. Required for oidc.
+	// +optional
+	AuthURL string `json:"authUrl,omitempty"`
+
+	// TokenURL is the OIDC token endpoint. Required for oidc.
+	// +optional
+	TokenURL string `json:"tokenUrl,omitempty"`
+
+	// CertsURL is the OIDC JWKS endpoint. Required for oidc.
+	// +optional
+	CertsURL string `json:"certsUrl,omitempty"`
+}
+
+// CloudflareIdentityProviderSpec defines the desired state of a
+// CloudflareIdentityProvider.
+type CloudflareIdentityProviderSpec struct {
+	// CloudflareRef references Cloudflare API credentials.
+	// +optional
+	CloudflareRef *CloudflareSecretRef `json:"cloudflareRef,omitempty"`
+
+	// Name is the display name shown for this provider in Cloudflare Access.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=100
+	Name string `json:"name"`
+
+	// Type is the upstream identity provider type.
+	// +kubebuilder:validation:Enum=google;azureAD;okta;github;saml;oidc;onetimepin
+	Type string `json:"type"`
+
+	// Config holds the provider-specific settings for Type.
+	// +optional
+	Config *IdentityProviderConfig `json:"config,omitempty"`
+
+	// SecretRef references a Secret holding the provider's client secret
+	// (and, for saml, optionally its signing certificate). Not required for
+	// onetimepin, which has no upstream credentials.
+	// +optional
+	SecretRef *IdentityProviderSecretRef `json:"secretRef,omitempty"`
+}
+
+// CloudflareIdentityProviderStatus defines the observed state of
+// CloudflareIdentityProvider.
+type CloudflareIdentityProviderStatus struct {
+	// IdentityProviderID is the Cloudflare-assigned ID for this provider, to
+	// be resolved by AccessRule fields (GSuiteRule, GitHubRule, AzureRule,
+	// OktaRule, SAMLRule, LoginMethodRule) via IdentityProviderRef. Not yet
+	// populated by any controller (see CloudflareIdentityProvider).
+	IdentityProviderID string `json:"identityProviderId,omitempty"`
+
+	// ObservedGeneration is the last generation processed.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions describe current state.
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=cfidp;cfidentityprovider
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="ProviderID",type="string",JSONPath=".status.identityProviderId"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// CloudflareIdentityProvider is the Schema for the cloudflareidentityproviders
+// API. It describes a Cloudflare Access identity provider, letting AccessRule
+// fields reference it by name (IdentityProviderRef) instead of hard-coding
+// the Cloudflare-assigned provider ID.
+//
+// EXPERIMENTAL: no controller reconciles this type yet. Applying one only
+// creates the Kubernetes object. IdentityProviderID is never populated, and
+// IdentityProviderRef is never resolved to a Cloudflare provider ID at
+// reconcile time until that reconciler is written.
+type CloudflareIdentityProvider struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudflareIdentityProviderSpec   `json:"spec,omitempty"`
+	Status CloudflareIdentityProviderStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudflareIdentityProviderList contains a list of CloudflareIdentityProvider.
+type CloudflareIdentityProviderList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudflareIdentityProvider `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CloudflareIdentityProvider{}, &CloudflareIdentityProviderList{})
+}
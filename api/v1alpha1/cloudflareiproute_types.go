@@ -0,0 +1,91 @@
+// Package v1alpha1 contains API Schema definitions for the cfgate v1alpha1 API group.
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CloudflareIPRouteSpec defines the desired state of CloudflareIPRoute.
+type CloudflareIPRouteSpec struct {
+	// Network is the private CIDR advertised to WARP clients through the
+	// tunnel (e.g. "10.0.0.0/24").
+	// +kubebuilder:validation:Required
+	Network string `json:"network"`
+
+	// TunnelRef references the CloudflareTunnel this route is advertised
+	// through.
+	// +kubebuilder:validation:Required
+	TunnelRef TunnelRef `json:"tunnelRef"`
+
+	// VirtualNetworkRef optionally scopes the route to a
+	// CloudflareVirtualNetwork, in this namespace, so overlapping CIDRs can
+	// be advertised to different WARP client populations. Defaults to the
+	// account's default virtual network when unset.
+	// +optional
+	VirtualNetworkRef *corev1.LocalObjectReference `json:"virtualNetworkRef,omitempty"`
+
+	// Comment is a human-readable description of the route.
+	// +optional
+	Comment string `json:"comment,omitempty"`
+}
+
+// CloudflareIPRouteStatus defines the observed state of CloudflareIPRoute.
+type CloudflareIPRouteStatus struct {
+	// RouteID is the resolved Cloudflare teamnet route ID.
+	// +optional
+	RouteID string `json:"routeID,omitempty"`
+
+	// TunnelID is the resolved CloudflareTunnel's Cloudflare tunnel ID this
+	// route was created for.
+	// +optional
+	TunnelID string `json:"tunnelID,omitempty"`
+
+	// VnetID is the resolved Cloudflare virtual network ID this route is
+	// scoped to, when VirtualNetworkRef is set.
+	// +optional
+	VnetID string `json:"vnetID,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the
+	// route's state, including a "Ready" condition.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=cfiproute;iproute
+// +kubebuilder:printcolumn:name="Network",type=string,JSONPath=".spec.network"
+// +kubebuilder:printcolumn:name="Tunnel",type=string,JSONPath=".spec.tunnelRef.name"
+// +kubebuilder:printcolumn:name="Route ID",type=string,JSONPath=".status.routeID"
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=".metadata.creationTimestamp"
+
+// CloudflareIPRoute is the Schema for the cloudflareiproutes API. It
+// advertises a private CIDR to WARP clients through a CloudflareTunnel
+// (Cloudflare's Teamnet "tunnel route ip"), optionally scoped to a
+// CloudflareVirtualNetwork.
+type CloudflareIPRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudflareIPRouteSpec   `json:"spec,omitempty"`
+	Status CloudflareIPRouteStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudflareIPRouteList contains a list of CloudflareIPRoute.
+type CloudflareIPRouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudflareIPRoute `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CloudflareIPRoute{}, &CloudflareIPRouteList{})
+}
@@ -0,0 +1,94 @@
+// Package v1alpha1 contains API Schema definitions for the cfgate v1alpha1 API group.
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CloudflareManagedZoneSpec defines the desired state of CloudflareManagedZone.
+type CloudflareManagedZoneSpec struct {
+	// ZoneName is the Cloudflare zone name (e.g. example.com).
+	// +kubebuilder:validation:Required
+	ZoneName string `json:"zoneName"`
+
+	// ID is the optional explicit zone ID. Skips the name lookup; either
+	// way the resolved ID is cached into Status.ZoneID.
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// CredentialsRef references a secret holding a Cloudflare API token
+	// scoped to this zone, decoupling zone auth from the credentials any
+	// CloudflareDNSSync's CloudflareTunnel uses. Required to resolve
+	// ZoneName and NameServers via the Cloudflare API unless ID is
+	// already set.
+	// +optional
+	CredentialsRef *SecretReference `json:"credentialsRef,omitempty"`
+
+	// ParentZoneRef references a CloudflareManagedZone, in this namespace,
+	// owning the parent domain this zone delegates from (possibly in a
+	// different Cloudflare account). When set, the controller keeps an NS
+	// record in the parent zone pointing at this zone's nameservers,
+	// creating or updating it whenever they differ.
+	// +optional
+	ParentZoneRef *corev1.LocalObjectReference `json:"parentZoneRef,omitempty"`
+}
+
+// CloudflareManagedZoneStatus defines the observed state of CloudflareManagedZone.
+type CloudflareManagedZoneStatus struct {
+	// ZoneID is the resolved Cloudflare zone ID.
+	// +optional
+	ZoneID string `json:"zoneId,omitempty"`
+
+	// NameServers lists the Cloudflare-assigned nameservers for this zone.
+	// +optional
+	NameServers []string `json:"nameServers,omitempty"`
+
+	// DelegationSynced is true once the NS delegation record in
+	// ParentZoneRef's zone matches NameServers. Always false when
+	// ParentZoneRef is unset.
+	// +optional
+	DelegationSynced bool `json:"delegationSynced,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the
+	// zone's state, including a "Ready" condition.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=cfmzone;managedzone
+// +kubebuilder:printcolumn:name="Zone",type=string,JSONPath=".spec.zoneName"
+// +kubebuilder:printcolumn:name="Zone ID",type=string,JSONPath=".status.zoneId"
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=".metadata.creationTimestamp"
+
+// CloudflareManagedZone is the Schema for the cloudflaremanagedzones API. It
+// owns the Cloudflare zone lookup and caches the zone ID so
+// CloudflareDNSSync resources can reference a zone by name instead of
+// repeating the lookup and, optionally, zone-scoped credentials.
+type CloudflareManagedZone struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudflareManagedZoneSpec   `json:"spec,omitempty"`
+	Status CloudflareManagedZoneStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudflareManagedZoneList contains a list of CloudflareManagedZone.
+type CloudflareManagedZoneList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudflareManagedZone `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CloudflareManagedZone{}, &CloudflareManagedZoneList{})
+}
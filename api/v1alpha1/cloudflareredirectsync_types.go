@@ -0,0 +1,135 @@
+// Package v1alpha1 contains API Schema definitions for the cfgate v1alpha1 API group.
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RedirectMatcher defines which requests a RedirectRuleSpec applies to.
+// Set either Hostname (with optional Path) for a simple host+path-prefix
+// match, or Expression for a raw wirefilter expression covering more complex
+// matches. Expression takes precedence if both are set.
+type RedirectMatcher struct {
+	// Hostname matches requests to this host.
+	// +optional
+	Hostname string `json:"hostname,omitempty"`
+
+	// Path restricts the match to requests under this path prefix. Empty matches all paths.
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Expression is a raw wirefilter expression (Cloudflare Rules language),
+	// used instead of Hostname/Path for matches they can't express.
+	// +optional
+	Expression string `json:"expression,omitempty"`
+}
+
+// RedirectRuleSpec defines a single URL forwarding rule.
+type RedirectRuleSpec struct {
+	// Name uniquely identifies this rule within the CloudflareRedirectSync.
+	// Used as the stable key for ownership tracking and diffing.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Matcher selects which requests this rule applies to.
+	// +kubebuilder:validation:Required
+	Matcher RedirectMatcher `json:"matcher"`
+
+	// TargetURL is the forwarding destination. Supports "${1}", "${2}", ...
+	// capture-group references into Matcher's expression.
+	// +kubebuilder:validation:Required
+	TargetURL string `json:"targetUrl"`
+
+	// StatusCode is the HTTP redirect status code.
+	// +kubebuilder:validation:Enum=301;302;307;308
+	// +kubebuilder:default=301
+	StatusCode int32 `json:"statusCode,omitempty"`
+
+	// PreserveQueryString appends the original request's query string to TargetURL.
+	// +kubebuilder:default=false
+	PreserveQueryString bool `json:"preserveQueryString,omitempty"`
+
+	// PreservePath appends the original request's path to TargetURL.
+	// +kubebuilder:default=false
+	PreservePath bool `json:"preservePath,omitempty"`
+}
+
+// CloudflareRedirectSyncSpec defines the desired state of CloudflareRedirectSync.
+type CloudflareRedirectSyncSpec struct {
+	// ZoneRef identifies the zone the redirect rules apply to.
+	// +kubebuilder:validation:Required
+	ZoneRef ZoneConfig `json:"zoneRef"`
+
+	// CloudflareRef references the Cloudflare credentials for ZoneRef's account.
+	// +kubebuilder:validation:Required
+	CloudflareRef CloudflareSecretRef `json:"cloudflareRef"`
+
+	// Redirects defines the URL forwarding rules to manage.
+	// +optional
+	Redirects []RedirectRuleSpec `json:"redirects,omitempty"`
+
+	// OwnershipPrefix marks rules this operator manages, embedded in each
+	// rule's Cloudflare description, mirroring the TXT ownership prefix
+	// convention used by CloudflareDNSSync. Rules without this marker (added
+	// outside the operator) are left untouched on sync.
+	// +kubebuilder:default="_cfgate"
+	OwnershipPrefix string `json:"ownershipPrefix,omitempty"`
+
+	// CleanupPolicy defines cleanup behavior for redirect rules on deletion.
+	// +optional
+	CleanupPolicy CleanupPolicy `json:"cleanupPolicy,omitempty"`
+}
+
+// CloudflareRedirectSyncStatus defines the observed state of CloudflareRedirectSync.
+type CloudflareRedirectSyncStatus struct {
+	// RulesetID is the Cloudflare dynamic-redirect ruleset ID for ZoneRef.
+	RulesetID string `json:"rulesetId,omitempty"`
+
+	// SyncedRedirects is the number of redirect rules successfully synced.
+	SyncedRedirects int32 `json:"syncedRedirects,omitempty"`
+
+	// FailedRedirects is the number of redirect rules that failed to sync.
+	FailedRedirects int32 `json:"failedRedirects,omitempty"`
+
+	// ObservedGeneration is the last generation processed.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the sync's state.
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=cfredirect;redirectsync
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Ruleset",type="string",JSONPath=".status.rulesetId"
+// +kubebuilder:printcolumn:name="Synced",type="integer",JSONPath=".status.syncedRedirects"
+// +kubebuilder:printcolumn:name="Failed",type="integer",JSONPath=".status.failedRedirects"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// CloudflareRedirectSync is the Schema for the cloudflareredirectsyncs API.
+// It manages Cloudflare Single Redirects (dynamic URL forwarding rules) for a zone.
+type CloudflareRedirectSync struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudflareRedirectSyncSpec   `json:"spec,omitempty"`
+	Status CloudflareRedirectSyncStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudflareRedirectSyncList contains a list of CloudflareRedirectSync.
+type CloudflareRedirectSyncList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudflareRedirectSync `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CloudflareRedirectSync{}, &CloudflareRedirectSyncList{})
+}
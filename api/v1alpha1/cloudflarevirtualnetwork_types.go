@@ -0,0 +1,93 @@
+// Package v1alpha1 contains API Schema definitions for the cfgate v1alpha1 API group.
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CloudflareVirtualNetworkSpec defines the desired state of CloudflareVirtualNetwork.
+type CloudflareVirtualNetworkSpec struct {
+	// Name is the virtual network's name in Cloudflare. Used to adopt an
+	// existing vnet (list-by-name, same pattern as tunnel adoption) instead
+	// of creating a duplicate.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Comment is a human-readable description of the virtual network.
+	// +optional
+	Comment string `json:"comment,omitempty"`
+
+	// IsDefault marks this as the account's default virtual network, used
+	// to scope a tunnel route when no vnet is explicitly requested. Only
+	// one virtual network per account may be default; flipping this from
+	// false to true atomically clears the flag on whichever vnet currently
+	// holds it.
+	// +kubebuilder:default=false
+	IsDefault bool `json:"isDefault,omitempty"`
+
+	// AccountID is the Cloudflare account the virtual network belongs to.
+	// +kubebuilder:validation:Required
+	AccountID string `json:"accountID"`
+
+	// SecretRef references a secret holding a Cloudflare API token scoped
+	// to AccountID.
+	// +kubebuilder:validation:Required
+	SecretRef *SecretReference `json:"secretRef"`
+}
+
+// CloudflareVirtualNetworkStatus defines the observed state of CloudflareVirtualNetwork.
+type CloudflareVirtualNetworkStatus struct {
+	// VnetID is the resolved Cloudflare virtual network ID.
+	// +optional
+	VnetID string `json:"vnetID,omitempty"`
+
+	// IsDefault mirrors the virtual network's current default status as
+	// last observed from the Cloudflare API, which may differ from
+	// Spec.IsDefault for one reconcile after another vnet takes over the
+	// default flag out-of-band.
+	// +optional
+	IsDefault bool `json:"isDefault,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the
+	// virtual network's state, including "Ready" and "CredentialsValid".
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=cfvnet;vnet
+// +kubebuilder:printcolumn:name="Name",type=string,JSONPath=".spec.name"
+// +kubebuilder:printcolumn:name="Vnet ID",type=string,JSONPath=".status.vnetID"
+// +kubebuilder:printcolumn:name="Default",type=boolean,JSONPath=".status.isDefault"
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=".metadata.creationTimestamp"
+
+// CloudflareVirtualNetwork is the Schema for the cloudflarevirtualnetworks
+// API. It manages a Cloudflare WARP virtual network, letting tunnel routes
+// be scoped to a vnet so overlapping RFC1918 CIDRs can be served to
+// different WARP client populations without colliding.
+type CloudflareVirtualNetwork struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudflareVirtualNetworkSpec   `json:"spec,omitempty"`
+	Status CloudflareVirtualNetworkStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudflareVirtualNetworkList contains a list of CloudflareVirtualNetwork.
+type CloudflareVirtualNetworkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudflareVirtualNetwork `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CloudflareVirtualNetwork{}, &CloudflareVirtualNetworkList{})
+}
@@ -0,0 +1,166 @@
+// Package v1alpha1 contains API Schema definitions for the cfgate v1alpha1 API group.
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConfigMapItemsRef sources WAF list items from a ConfigMap's data.
+type ConfigMapItemsRef struct {
+	// Name of the ConfigMap.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap. Defaults to the CloudflareWAFListSync's namespace.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Key restricts items to a single data key, newline-delimited. If empty,
+	// every key's value is used (also newline-delimited).
+	// +optional
+	Key string `json:"key,omitempty"`
+}
+
+// SecretItemsRef sources WAF list items from a Secret's data.
+type SecretItemsRef struct {
+	// Name of the Secret.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Namespace of the Secret. Defaults to the CloudflareWAFListSync's namespace.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Key restricts items to a single data key, newline-delimited. If empty,
+	// every key's value is used (also newline-delimited).
+	// +optional
+	Key string `json:"key,omitempty"`
+}
+
+// NodeIPsSource automatically maintains list items from cluster Node
+// addresses, for use cases like allow-listing cluster egress IPs.
+type NodeIPsSource struct {
+	// Enabled includes Node addresses as list items.
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// AddressType selects which Node address to use.
+	// +kubebuilder:validation:Enum=InternalIP;ExternalIP
+	// +kubebuilder:default=ExternalIP
+	AddressType string `json:"addressType,omitempty"`
+
+	// NodeSelector restricts which Nodes contribute addresses. Empty selects all Nodes.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+}
+
+// WAFListItemSource defines where a CloudflareWAFListSync's items come from.
+// At least one source should be set; items from all configured sources are unioned.
+type WAFListItemSource struct {
+	// Inline lists items literally: IPs/CIDRs, ASNs, or hostnames depending on Kind.
+	// +optional
+	Inline []string `json:"inline,omitempty"`
+
+	// ConfigMapRef sources items from a ConfigMap.
+	// +optional
+	ConfigMapRef *ConfigMapItemsRef `json:"configMapRef,omitempty"`
+
+	// SecretRef sources items from a Secret.
+	// +optional
+	SecretRef *SecretItemsRef `json:"secretRef,omitempty"`
+
+	// NodeIPs sources items from cluster Node addresses.
+	// +optional
+	NodeIPs *NodeIPsSource `json:"nodeIPs,omitempty"`
+}
+
+// CloudflareWAFListSyncSpec defines the desired state of CloudflareWAFListSync.
+type CloudflareWAFListSyncSpec struct {
+	// AccountRef references the Cloudflare credentials and account that owns the list.
+	// +kubebuilder:validation:Required
+	AccountRef CloudflareSecretRef `json:"accountRef"`
+
+	// ListName is the Cloudflare WAF list name. Created if it doesn't already exist.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_]+$`
+	ListName string `json:"listName"`
+
+	// Kind is the WAF list item type.
+	// +kubebuilder:validation:Enum=ip;asn;hostname
+	// +kubebuilder:validation:Required
+	Kind string `json:"kind"`
+
+	// Description is the Cloudflare list description. Defaults to a generated
+	// description noting the list is managed by cfgate.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Items defines where list items are sourced from.
+	// +optional
+	Items WAFListItemSource `json:"items,omitempty"`
+
+	// OwnershipPrefix marks items this operator manages, applied as a prefix
+	// on each item's Cloudflare comment field, mirroring the TXT ownership
+	// prefix convention used by CloudflareDNSSync. Items without this prefix
+	// (added outside the operator) are left untouched on sync.
+	// +kubebuilder:default="_cfgate"
+	OwnershipPrefix string `json:"ownershipPrefix,omitempty"`
+
+	// CleanupPolicy defines cleanup behavior for list items on deletion.
+	// +optional
+	CleanupPolicy CleanupPolicy `json:"cleanupPolicy,omitempty"`
+}
+
+// CloudflareWAFListSyncStatus defines the observed state of CloudflareWAFListSync.
+type CloudflareWAFListSyncStatus struct {
+	// ListID is the Cloudflare WAF list ID.
+	ListID string `json:"listId,omitempty"`
+
+	// SyncedItems is the number of items successfully synced.
+	SyncedItems int32 `json:"syncedItems,omitempty"`
+
+	// FailedItems is the number of items that failed to sync.
+	FailedItems int32 `json:"failedItems,omitempty"`
+
+	// ObservedGeneration is the last generation processed.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the sync's state.
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=cfwaf;waflistsync
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="List",type="string",JSONPath=".status.listId"
+// +kubebuilder:printcolumn:name="Synced",type="integer",JSONPath=".status.syncedItems"
+// +kubebuilder:printcolumn:name="Failed",type="integer",JSONPath=".status.failedItems"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// CloudflareWAFListSync is the Schema for the cloudflarewaflistsyncs API.
+// It manages account-scoped Cloudflare WAF Lists (IP, ASN, or hostname lists).
+type CloudflareWAFListSync struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudflareWAFListSyncSpec   `json:"spec,omitempty"`
+	Status CloudflareWAFListSyncStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudflareWAFListSyncList contains a list of CloudflareWAFListSync.
+type CloudflareWAFListSyncList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudflareWAFListSync `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CloudflareWAFListSync{}, &CloudflareWAFListSyncList{})
+}
@@ -0,0 +1,43 @@
+package v1beta1
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"cfgate.io/cfgate/api/v1alpha1"
+)
+
+// ConvertTo converts this AccessGroup (v1beta1) to the hub version (v1alpha1).
+func (src *AccessGroup) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha1.AccessGroup)
+
+	dst.ObjectMeta = src.ObjectMeta
+
+	dst.Spec.CloudflareRef = src.Spec.CloudflareRef
+	dst.Spec.Name = src.Spec.Name
+	dst.Spec.Include = convertAccessRulesToV1Alpha1(src.Spec.Include)
+	dst.Spec.Exclude = convertAccessRulesToV1Alpha1(src.Spec.Exclude)
+	dst.Spec.Require = convertAccessRulesToV1Alpha1(src.Spec.Require)
+	dst.Spec.IsDefault = src.Spec.IsDefault
+
+	dst.Status = src.Status
+
+	return nil
+}
+
+// ConvertFrom converts the hub version (v1alpha1) into this AccessGroup (v1beta1).
+func (dst *AccessGroup) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha1.AccessGroup)
+
+	dst.ObjectMeta = src.ObjectMeta
+
+	dst.Spec.CloudflareRef = src.Spec.CloudflareRef
+	dst.Spec.Name = src.Spec.Name
+	dst.Spec.Include = convertAccessRulesFromV1Alpha1(src.Spec.Include)
+	dst.Spec.Exclude = convertAccessRulesFromV1Alpha1(src.Spec.Exclude)
+	dst.Spec.Require = convertAccessRulesFromV1Alpha1(src.Spec.Require)
+	dst.Spec.IsDefault = src.Spec.IsDefault
+
+	dst.Status = src.Status
+
+	return nil
+}
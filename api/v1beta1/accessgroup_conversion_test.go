@@ -0,0 +1,41 @@
+package v1beta1_test
+
+import (
+	"reflect"
+	"testing"
+
+	"cfgate.io/cfgate/api/v1alpha1"
+	"cfgate.io/cfgate/api/v1beta1"
+)
+
+func TestAccessGroup_RoundTrip_V1Beta1ToV1Alpha1ToV1Beta1(t *testing.T) {
+	original := &v1beta1.AccessGroup{
+		Spec: v1beta1.AccessGroupSpec{
+			Name:      "employees",
+			IsDefault: true,
+			Include: []v1beta1.AccessRule{
+				{EmailDomain: &v1beta1.EmailDomainRule{Domain: "example.com"}},
+				{LoginMethod: nil},
+			},
+			Require: []v1beta1.AccessRule{
+				{Everyone: boolPtr(false)},
+			},
+		},
+	}
+
+	var viaHub v1alpha1.AccessGroup
+	if err := original.ConvertTo(&viaHub); err != nil {
+		t.Fatalf("ConvertTo: %v", err)
+	}
+
+	var roundTripped v1beta1.AccessGroup
+	if err := roundTripped.ConvertFrom(&viaHub); err != nil {
+		t.Fatalf("ConvertFrom: %v", err)
+	}
+
+	if !reflect.DeepEqual(original.Spec, roundTripped.Spec) {
+		t.Fatalf("round trip mismatch:\noriginal:     %+v\nroundTripped: %+v", original.Spec, roundTripped.Spec)
+	}
+}
+
+func boolPtr(b bool) *bool { return &b }
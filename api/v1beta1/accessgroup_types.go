@@ -0,0 +1,80 @@
+// Package v1beta1 contains API Schema definitions for the cfgate v1beta1 API group.
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"cfgate.io/cfgate/api/v1alpha1"
+)
+
+// AccessGroupStatus is unchanged from v1alpha1.
+type AccessGroupStatus = v1alpha1.AccessGroupStatus
+
+// AccessGroupSpec defines the desired state of AccessGroup. It references
+// this version's AccessRule (with LoginMethod/AuthMethod/DevicePosture/
+// ExternalEval), so unlike AccessGroupStatus it can't simply alias v1alpha1's.
+// +kubebuilder:validation:XValidation:rule="has(self.include) || has(self.require)",message="at least one of include or require must be specified"
+type AccessGroupSpec struct {
+	// CloudflareRef references Cloudflare credentials (inherits from the
+	// first referencing CloudflareAccessPolicy if omitted).
+	// +optional
+	CloudflareRef *CloudflareSecretRef `json:"cloudflareRef,omitempty"`
+
+	// Name is the group's display name in the Cloudflare dashboard.
+	// Defaults to the CR name if omitted.
+	// +optional
+	// +kubebuilder:validation:MaxLength=255
+	Name string `json:"name,omitempty"`
+
+	// Include rules (ANY must match for the group to apply).
+	// +optional
+	Include []AccessRule `json:"include,omitempty"`
+
+	// Exclude rules (if ANY match, the group does not apply).
+	// +optional
+	Exclude []AccessRule `json:"exclude,omitempty"`
+
+	// Require rules (ALL must match for the group to apply).
+	// +optional
+	Require []AccessRule `json:"require,omitempty"`
+
+	// IsDefault auto-attaches this group to new Applications that don't
+	// otherwise reference any AccessGroupRef.
+	// +optional
+	// +kubebuilder:default=false
+	IsDefault bool `json:"isDefault,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=cfgroup;cfaccessgroup
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="GroupID",type="string",JSONPath=".status.cloudflareGroupId"
+// +kubebuilder:printcolumn:name="Default",type="boolean",JSONPath=".spec.isDefault"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// AccessGroup is the Schema for the accessgroups API. It describes a reusable
+// Cloudflare Access group that many CloudflareAccessPolicy resources can
+// reference via AccessGroupRef, avoiding identity-rule duplication across apps.
+// No controller reconciles this kind: applying one stores the object (and
+// converts it to and from v1alpha1) but creates nothing in Cloudflare.
+type AccessGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccessGroupSpec   `json:"spec,omitempty"`
+	Status AccessGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccessGroupList contains a list of AccessGroup.
+type AccessGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccessGroup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AccessGroup{}, &AccessGroupList{})
+}
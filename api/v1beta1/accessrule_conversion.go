@@ -0,0 +1,131 @@
+package v1beta1
+
+import (
+	"cfgate.io/cfgate/api/v1alpha1"
+)
+
+// convertAccessRulesToV1Alpha1 converts v1beta1 AccessRules to v1alpha1. Both
+// versions' AccessRule now cover the same set of identity rule types, so the
+// conversion is lossless in both directions.
+func convertAccessRulesToV1Alpha1(rules []AccessRule) []v1alpha1.AccessRule {
+	if rules == nil {
+		return nil
+	}
+	out := make([]v1alpha1.AccessRule, 0, len(rules))
+	for _, r := range rules {
+		out = append(out, v1alpha1.AccessRule{
+			Email:                r.Email,
+			EmailDomain:          r.EmailDomain,
+			EmailListRef:         r.EmailListRef,
+			IPRange:              r.IPRange,
+			Country:              r.Country,
+			Everyone:             r.Everyone,
+			Certificate:          r.Certificate,
+			CommonName:           r.CommonName,
+			ServiceToken:         r.ServiceToken,
+			GroupRef:             r.GroupRef,
+			GSuite:               r.GSuite,
+			GitHub:               r.GitHub,
+			Azure:                r.Azure,
+			Okta:                 r.Okta,
+			SAML:                 r.SAML,
+			DevicePosture:        convertDevicePostureToV1Alpha1(r.DevicePosture),
+			LoginMethod:          convertLoginMethodToV1Alpha1(r.LoginMethod),
+			AuthMethod:           convertAuthMethodToV1Alpha1(r.AuthMethod),
+			ExternalEvaluation:   convertExternalEvalToV1Alpha1(r.ExternalEval),
+			GeoDistance:          r.GeoDistance,
+			AnyValidServiceToken: r.AnyValidServiceToken,
+		})
+	}
+	return out
+}
+
+// convertAccessRulesFromV1Alpha1 converts v1alpha1 AccessRules to v1beta1.
+func convertAccessRulesFromV1Alpha1(rules []v1alpha1.AccessRule) []AccessRule {
+	if rules == nil {
+		return nil
+	}
+	out := make([]AccessRule, 0, len(rules))
+	for _, r := range rules {
+		out = append(out, AccessRule{
+			Email:                r.Email,
+			EmailDomain:          r.EmailDomain,
+			EmailListRef:         r.EmailListRef,
+			IPRange:              r.IPRange,
+			Country:              r.Country,
+			Everyone:             r.Everyone,
+			Certificate:          r.Certificate,
+			CommonName:           r.CommonName,
+			ServiceToken:         r.ServiceToken,
+			GroupRef:             r.GroupRef,
+			GSuite:               r.GSuite,
+			GitHub:               r.GitHub,
+			Azure:                r.Azure,
+			Okta:                 r.Okta,
+			SAML:                 r.SAML,
+			DevicePosture:        convertDevicePostureFromV1Alpha1(r.DevicePosture),
+			LoginMethod:          convertLoginMethodFromV1Alpha1(r.LoginMethod),
+			AuthMethod:           convertAuthMethodFromV1Alpha1(r.AuthMethod),
+			ExternalEval:         convertExternalEvalFromV1Alpha1(r.ExternalEvaluation),
+			GeoDistance:          r.GeoDistance,
+			AnyValidServiceToken: r.AnyValidServiceToken,
+		})
+	}
+	return out
+}
+
+func convertDevicePostureToV1Alpha1(r *DevicePostureRule) *v1alpha1.DevicePostureRule {
+	if r == nil {
+		return nil
+	}
+	return &v1alpha1.DevicePostureRule{IntegrationUID: r.IntegrationUID}
+}
+
+func convertDevicePostureFromV1Alpha1(r *v1alpha1.DevicePostureRule) *DevicePostureRule {
+	if r == nil {
+		return nil
+	}
+	return &DevicePostureRule{IntegrationUID: r.IntegrationUID}
+}
+
+func convertLoginMethodToV1Alpha1(r *LoginMethodRule) *v1alpha1.LoginMethodRule {
+	if r == nil {
+		return nil
+	}
+	return &v1alpha1.LoginMethodRule{IdentityProviderIDs: r.IdentityProviderIDs}
+}
+
+func convertLoginMethodFromV1Alpha1(r *v1alpha1.LoginMethodRule) *LoginMethodRule {
+	if r == nil {
+		return nil
+	}
+	return &LoginMethodRule{IdentityProviderIDs: r.IdentityProviderIDs}
+}
+
+func convertAuthMethodToV1Alpha1(r *AuthMethodRule) *v1alpha1.AuthMethodRule {
+	if r == nil {
+		return nil
+	}
+	return &v1alpha1.AuthMethodRule{AuthMethod: r.AuthMethod}
+}
+
+func convertAuthMethodFromV1Alpha1(r *v1alpha1.AuthMethodRule) *AuthMethodRule {
+	if r == nil {
+		return nil
+	}
+	return &AuthMethodRule{AuthMethod: r.AuthMethod}
+}
+
+func convertExternalEvalToV1Alpha1(r *ExternalEvalRule) *v1alpha1.ExternalEvalRule {
+	if r == nil {
+		return nil
+	}
+	return &v1alpha1.ExternalEvalRule{EvaluateURL: r.EvaluateURL, KeysURL: r.KeysURL}
+}
+
+func convertExternalEvalFromV1Alpha1(r *v1alpha1.ExternalEvalRule) *ExternalEvalRule {
+	if r == nil {
+		return nil
+	}
+	return &ExternalEvalRule{EvaluateURL: r.EvaluateURL, KeysURL: r.KeysURL}
+}
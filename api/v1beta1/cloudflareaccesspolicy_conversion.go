@@ -0,0 +1,131 @@
+package v1beta1
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"cfgate.io/cfgate/api/v1alpha1"
+)
+
+// ConvertTo converts this CloudflareAccessPolicy (v1beta1) to the hub version (v1alpha1).
+func (src *CloudflareAccessPolicy) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha1.CloudflareAccessPolicy)
+
+	dst.ObjectMeta = src.ObjectMeta
+
+	dst.Spec.TargetRef = src.Spec.TargetRef
+	dst.Spec.TargetRefs = src.Spec.TargetRefs
+	dst.Spec.CloudflareRef = src.Spec.CloudflareRef
+	dst.Spec.Application = convertApplicationToV1Alpha1(src.Spec.Application)
+	dst.Spec.Policies = convertPolicyRulesToV1Alpha1(src.Spec.Policies)
+	dst.Spec.PolicyRefs = src.Spec.PolicyRefs
+	dst.Spec.GroupRefs = src.Spec.GroupRefs
+	dst.Spec.ServiceTokens = src.Spec.ServiceTokens
+	dst.Spec.MTLS = src.Spec.MTLS
+
+	dst.Status = src.Status
+
+	return nil
+}
+
+// ConvertFrom converts the hub version (v1alpha1) into this CloudflareAccessPolicy (v1beta1).
+func (dst *CloudflareAccessPolicy) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha1.CloudflareAccessPolicy)
+
+	dst.ObjectMeta = src.ObjectMeta
+
+	dst.Spec.TargetRef = src.Spec.TargetRef
+	dst.Spec.TargetRefs = src.Spec.TargetRefs
+	dst.Spec.CloudflareRef = src.Spec.CloudflareRef
+	dst.Spec.Application = convertApplicationFromV1Alpha1(src.Spec.Application)
+	dst.Spec.Policies = convertPolicyRulesFromV1Alpha1(src.Spec.Policies)
+	dst.Spec.PolicyRefs = src.Spec.PolicyRefs
+	dst.Spec.GroupRefs = src.Spec.GroupRefs
+	dst.Spec.ServiceTokens = src.Spec.ServiceTokens
+	dst.Spec.MTLS = src.Spec.MTLS
+
+	dst.Status = src.Status
+
+	return nil
+}
+
+func convertApplicationToV1Alpha1(app AccessApplication) v1alpha1.AccessApplication {
+	return v1alpha1.AccessApplication{
+		Name:                    app.Name,
+		Domain:                  app.Domain,
+		Path:                    app.Path,
+		SessionDuration:         durationToString(app.SessionDuration),
+		Type:                    app.Type,
+		LogoURL:                 app.LogoURL,
+		SkipInterstitial:        app.SkipInterstitial,
+		EnableBindingCookie:     app.EnableBindingCookie,
+		HttpOnlyCookieAttribute: app.HttpOnlyCookieAttribute,
+		SameSiteCookieAttribute: app.SameSiteCookieAttribute,
+		CustomDenyMessage:       app.CustomDenyMessage,
+		CustomDenyURL:           app.CustomDenyURL,
+		ScimConfig:              app.ScimConfig,
+	}
+}
+
+func convertApplicationFromV1Alpha1(app v1alpha1.AccessApplication) AccessApplication {
+	return AccessApplication{
+		Name:                    app.Name,
+		Domain:                  app.Domain,
+		Path:                    app.Path,
+		SessionDuration:         stringToDuration(app.SessionDuration),
+		Type:                    app.Type,
+		LogoURL:                 app.LogoURL,
+		SkipInterstitial:        app.SkipInterstitial,
+		EnableBindingCookie:     app.EnableBindingCookie,
+		HttpOnlyCookieAttribute: app.HttpOnlyCookieAttribute,
+		SameSiteCookieAttribute: app.SameSiteCookieAttribute,
+		CustomDenyMessage:       app.CustomDenyMessage,
+		CustomDenyURL:           app.CustomDenyURL,
+		ScimConfig:              app.ScimConfig,
+	}
+}
+
+func convertPolicyRulesToV1Alpha1(rules []AccessPolicyRule) []v1alpha1.AccessPolicyRule {
+	if rules == nil {
+		return nil
+	}
+	out := make([]v1alpha1.AccessPolicyRule, 0, len(rules))
+	for _, r := range rules {
+		out = append(out, v1alpha1.AccessPolicyRule{
+			Name:                         r.Name,
+			Decision:                     r.Decision,
+			Precedence:                   r.Precedence,
+			Include:                      convertAccessRulesToV1Alpha1(r.Include),
+			Exclude:                      convertAccessRulesToV1Alpha1(r.Exclude),
+			Require:                      convertAccessRulesToV1Alpha1(r.Require),
+			SessionDuration:              durationToString(r.SessionDuration),
+			PurposeJustificationRequired: r.PurposeJustificationRequired,
+			PurposeJustificationPrompt:   r.PurposeJustificationPrompt,
+			ApprovalRequired:             r.ApprovalRequired,
+			ApprovalGroups:               r.ApprovalGroups,
+		})
+	}
+	return out
+}
+
+func convertPolicyRulesFromV1Alpha1(rules []v1alpha1.AccessPolicyRule) []AccessPolicyRule {
+	if rules == nil {
+		return nil
+	}
+	out := make([]AccessPolicyRule, 0, len(rules))
+	for _, r := range rules {
+		out = append(out, AccessPolicyRule{
+			Name:                         r.Name,
+			Decision:                     r.Decision,
+			Precedence:                   r.Precedence,
+			Include:                      convertAccessRulesFromV1Alpha1(r.Include),
+			Exclude:                      convertAccessRulesFromV1Alpha1(r.Exclude),
+			Require:                      convertAccessRulesFromV1Alpha1(r.Require),
+			SessionDuration:              stringToDuration(r.SessionDuration),
+			PurposeJustificationRequired: r.PurposeJustificationRequired,
+			PurposeJustificationPrompt:   r.PurposeJustificationPrompt,
+			ApprovalRequired:             r.ApprovalRequired,
+			ApprovalGroups:               r.ApprovalGroups,
+		})
+	}
+	return out
+}
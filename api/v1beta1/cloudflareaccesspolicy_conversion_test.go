@@ -0,0 +1,98 @@
+package v1beta1_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"cfgate.io/cfgate/api/v1alpha1"
+	"cfgate.io/cfgate/api/v1beta1"
+)
+
+func TestCloudflareAccessPolicy_RoundTrip_V1Beta1ToV1Alpha1ToV1Beta1(t *testing.T) {
+	precedence := 1
+	original := &v1beta1.CloudflareAccessPolicy{
+		Spec: v1beta1.CloudflareAccessPolicySpec{
+			TargetRef: &v1beta1.PolicyTargetReference{Group: "gateway.networking.k8s.io", Kind: "HTTPRoute", Name: "my-route"},
+			Application: v1beta1.AccessApplication{
+				Name:            "my-app",
+				Domain:          "app.example.com",
+				SessionDuration: metav1.Duration{Duration: 2 * time.Hour},
+			},
+			Policies: []v1beta1.AccessPolicyRule{
+				{
+					Name:            "allow-employees",
+					Decision:        "allow",
+					Precedence:      &precedence,
+					SessionDuration: metav1.Duration{Duration: 30 * time.Minute},
+					Include: []v1beta1.AccessRule{
+						{EmailDomain: &v1beta1.EmailDomainRule{Domain: "example.com"}},
+					},
+				},
+			},
+			PolicyRefs: []v1beta1.PolicyRef{{Name: "shared-policy"}},
+			GroupRefs:  []v1beta1.AccessGroupRef{{Name: "employees"}},
+		},
+	}
+
+	var viaHub v1alpha1.CloudflareAccessPolicy
+	if err := original.ConvertTo(&viaHub); err != nil {
+		t.Fatalf("ConvertTo: %v", err)
+	}
+
+	var roundTripped v1beta1.CloudflareAccessPolicy
+	if err := roundTripped.ConvertFrom(&viaHub); err != nil {
+		t.Fatalf("ConvertFrom: %v", err)
+	}
+
+	if !reflect.DeepEqual(original.Spec, roundTripped.Spec) {
+		t.Fatalf("round trip mismatch:\noriginal:     %+v\nroundTripped: %+v", original.Spec, roundTripped.Spec)
+	}
+}
+
+func TestCloudflareAccessPolicy_ConvertTo_PreservesDevicePostureRule(t *testing.T) {
+	src := &v1beta1.CloudflareAccessPolicy{
+		Spec: v1beta1.CloudflareAccessPolicySpec{
+			Application: v1beta1.AccessApplication{Name: "my-app"},
+			Policies: []v1beta1.AccessPolicyRule{
+				{
+					Name:     "device-posture-gate",
+					Decision: "allow",
+					Include: []v1beta1.AccessRule{
+						{DevicePosture: &v1beta1.DevicePostureRule{IntegrationUID: "posture-1"}},
+					},
+				},
+			},
+		},
+	}
+
+	var dst v1alpha1.CloudflareAccessPolicy
+	if err := src.ConvertTo(&dst); err != nil {
+		t.Fatalf("ConvertTo: %v", err)
+	}
+
+	rule := dst.Spec.Policies[0].Include[0]
+	want := v1alpha1.AccessRule{DevicePosture: &v1alpha1.DevicePostureRule{IntegrationUID: "posture-1"}}
+	if !reflect.DeepEqual(rule, want) {
+		t.Fatalf("expected device posture rule to carry through to v1alpha1, got %+v", rule)
+	}
+}
+
+func TestCloudflareAccessPolicy_SessionDuration_EmptyStringRoundTripsToZero(t *testing.T) {
+	src := &v1alpha1.CloudflareAccessPolicy{
+		Spec: v1alpha1.CloudflareAccessPolicySpec{
+			Application: v1alpha1.AccessApplication{Name: "my-app"},
+		},
+	}
+
+	var dst v1beta1.CloudflareAccessPolicy
+	if err := dst.ConvertFrom(src); err != nil {
+		t.Fatalf("ConvertFrom: %v", err)
+	}
+
+	if dst.Spec.Application.SessionDuration.Duration != 0 {
+		t.Fatalf("expected zero duration, got %v", dst.Spec.Application.SessionDuration.Duration)
+	}
+}
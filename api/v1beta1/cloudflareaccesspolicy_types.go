@@ -0,0 +1,360 @@
+// Package v1beta1 contains API Schema definitions for the cfgate v1beta1 API group.
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"cfgate.io/cfgate/api/v1alpha1"
+)
+
+// These substructures are unchanged from v1alpha1, so rather than
+// duplicating every field across versions they're aliased to the v1alpha1
+// definition. Only types this version's new identity rules or the
+// SessionDuration normalization actually touch get their own v1beta1 definition.
+type (
+	PolicyTargetReference = v1alpha1.PolicyTargetReference
+	CloudflareSecretRef   = v1alpha1.CloudflareSecretRef
+	EmailRule             = v1alpha1.EmailRule
+	EmailDomainRule       = v1alpha1.EmailDomainRule
+	AccessListRef         = v1alpha1.AccessListRef
+	IPRangeRule           = v1alpha1.IPRangeRule
+	CountryRule           = v1alpha1.CountryRule
+	CommonNameRule        = v1alpha1.CommonNameRule
+	AccessGroupRef        = v1alpha1.AccessGroupRef
+	GSuiteRule            = v1alpha1.GSuiteRule
+	GitHubRule            = v1alpha1.GitHubRule
+	AzureRule             = v1alpha1.AzureRule
+	OktaRule              = v1alpha1.OktaRule
+	SAMLRule              = v1alpha1.SAMLRule
+	ApprovalGroup         = v1alpha1.ApprovalGroup
+	ServiceTokenConfig    = v1alpha1.ServiceTokenConfig
+	ServiceTokenSecretRef = v1alpha1.ServiceTokenSecretRef
+	MTLSConfig            = v1alpha1.MTLSConfig
+	CASecretRef           = v1alpha1.CASecretRef
+	ScimConfig            = v1alpha1.ScimConfig
+	PolicyAncestorStatus  = v1alpha1.PolicyAncestorStatus
+	PolicyRef             = v1alpha1.PolicyRef
+	GeoDistanceRule       = v1alpha1.GeoDistanceRule
+
+	CloudflareAccessPolicyStatus = v1alpha1.CloudflareAccessPolicyStatus
+)
+
+// AccessApplication defines Cloudflare Access Application settings.
+type AccessApplication struct {
+	// Name is the display name in Cloudflare dashboard.
+	// Defaults to CR name if omitted.
+	// +optional
+	// +kubebuilder:validation:MaxLength=255
+	Name string `json:"name,omitempty"`
+
+	// Domain is the protected domain (auto-generated from routes if omitted).
+	// +optional
+	Domain string `json:"domain,omitempty"`
+
+	// Path restricts protection to specific path prefix.
+	// +optional
+	// +kubebuilder:default="/"
+	Path string `json:"path,omitempty"`
+
+	// SessionDuration controls session cookie lifetime.
+	// +optional
+	// +kubebuilder:default="24h"
+	SessionDuration metav1.Duration `json:"sessionDuration,omitempty"`
+
+	// Type is the application type.
+	// +kubebuilder:validation:Enum=self_hosted;saas;ssh;vnc;browser_isolation
+	// +kubebuilder:default=self_hosted
+	Type string `json:"type,omitempty"`
+
+	// LogoURL is the application logo in dashboard.
+	// +optional
+	LogoURL string `json:"logoUrl,omitempty"`
+
+	// SkipInterstitial bypasses the Access login page for API requests.
+	// +optional
+	// +kubebuilder:default=false
+	SkipInterstitial bool `json:"skipInterstitial,omitempty"`
+
+	// EnableBindingCookie enables binding cookies for sticky sessions.
+	// +optional
+	// +kubebuilder:default=false
+	EnableBindingCookie bool `json:"enableBindingCookie,omitempty"`
+
+	// HttpOnlyCookieAttribute adds HttpOnly to session cookies.
+	// +optional
+	// +kubebuilder:default=true
+	HttpOnlyCookieAttribute bool `json:"httpOnlyCookieAttribute,omitempty"`
+
+	// SameSiteCookieAttribute controls cross-site cookie behavior.
+	// +kubebuilder:validation:Enum=strict;lax;none
+	// +kubebuilder:default=lax
+	SameSiteCookieAttribute string `json:"sameSiteCookieAttribute,omitempty"`
+
+	// CustomDenyMessage shown when access is denied.
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	CustomDenyMessage string `json:"customDenyMessage,omitempty"`
+
+	// CustomDenyURL redirects to this URL when denied (instead of message).
+	// +optional
+	CustomDenyURL string `json:"customDenyUrl,omitempty"`
+
+	// ScimConfig enables SCIM 2.0 user/group provisioning for this Application.
+	// +optional
+	ScimConfig *ScimConfig `json:"scimConfig,omitempty"`
+}
+
+// AccessPolicyRule defines an access allow/deny rule.
+type AccessPolicyRule struct {
+	// Name is a human-readable identifier.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	Name string `json:"name"`
+
+	// Decision is the policy action.
+	// +kubebuilder:validation:Enum=allow;deny;bypass;non_identity
+	// +kubebuilder:default=allow
+	Decision string `json:"decision"`
+
+	// Precedence determines rule evaluation order (lower = first).
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=9999
+	// +optional
+	Precedence *int `json:"precedence,omitempty"`
+
+	// Include rules (ANY must match for rule to apply).
+	// +optional
+	Include []AccessRule `json:"include,omitempty"`
+
+	// Exclude rules (if ANY match, rule does not apply).
+	// +optional
+	Exclude []AccessRule `json:"exclude,omitempty"`
+
+	// Require rules (ALL must match for rule to apply).
+	// +optional
+	Require []AccessRule `json:"require,omitempty"`
+
+	// SessionDuration overrides application session duration for this rule.
+	// +optional
+	SessionDuration metav1.Duration `json:"sessionDuration,omitempty"`
+
+	// PurposeJustificationRequired requires user to provide justification.
+	// +optional
+	// +kubebuilder:default=false
+	PurposeJustificationRequired bool `json:"purposeJustificationRequired,omitempty"`
+
+	// PurposeJustificationPrompt is the prompt shown to user.
+	// +optional
+	PurposeJustificationPrompt string `json:"purposeJustificationPrompt,omitempty"`
+
+	// ApprovalRequired requires approval from specific users.
+	// +optional
+	// +kubebuilder:default=false
+	ApprovalRequired bool `json:"approvalRequired,omitempty"`
+
+	// ApprovalGroups defines who can approve access.
+	// +optional
+	ApprovalGroups []ApprovalGroup `json:"approvalGroups,omitempty"`
+}
+
+// AccessRule defines identity matching criteria.
+// +kubebuilder:validation:XValidation:rule="[has(self.email), has(self.emailDomain), has(self.emailListRef), has(self.ipRange), has(self.country), has(self.everyone), has(self.certificate), has(self.commonName), has(self.serviceToken), has(self.groupRef), has(self.gsuite), has(self.github), has(self.azure), has(self.okta), has(self.saml), has(self.loginMethod), has(self.authMethod), has(self.devicePosture), has(self.externalEval), has(self.geoDistance), has(self.anyValidServiceToken)].exists(x, x)",message="at least one rule type must be specified"
+type AccessRule struct {
+	// Email matches specific email addresses.
+	// +optional
+	Email *EmailRule `json:"email,omitempty"`
+
+	// EmailDomain matches email domain suffix.
+	// +optional
+	EmailDomain *EmailDomainRule `json:"emailDomain,omitempty"`
+
+	// EmailListRef references a Cloudflare Access list.
+	// +optional
+	EmailListRef *AccessListRef `json:"emailListRef,omitempty"`
+
+	// IPRange matches source IP CIDR ranges.
+	// +optional
+	IPRange *IPRangeRule `json:"ipRange,omitempty"`
+
+	// Country matches source country codes (ISO 3166-1 alpha-2).
+	// +optional
+	Country *CountryRule `json:"country,omitempty"`
+
+	// Everyone matches all users (use with caution).
+	// +optional
+	Everyone *bool `json:"everyone,omitempty"`
+
+	// Certificate requires valid mTLS certificate.
+	// +optional
+	Certificate *bool `json:"certificate,omitempty"`
+
+	// CommonName matches certificate common name.
+	// +optional
+	CommonName *CommonNameRule `json:"commonName,omitempty"`
+
+	// ServiceToken requires valid service token.
+	// +optional
+	ServiceToken *bool `json:"serviceToken,omitempty"`
+
+	// GroupRef references an AccessGroup CR.
+	// +optional
+	GroupRef *AccessGroupRef `json:"groupRef,omitempty"`
+
+	// GSuite matches Google Workspace groups.
+	// +optional
+	GSuite *GSuiteRule `json:"gsuite,omitempty"`
+
+	// GitHub matches GitHub organization membership.
+	// +optional
+	GitHub *GitHubRule `json:"github,omitempty"`
+
+	// Azure matches Azure AD groups.
+	// +optional
+	Azure *AzureRule `json:"azure,omitempty"`
+
+	// Okta matches Okta groups.
+	// +optional
+	Okta *OktaRule `json:"okta,omitempty"`
+
+	// SAML matches SAML assertion attributes.
+	// +optional
+	SAML *SAMLRule `json:"saml,omitempty"`
+
+	// LoginMethod matches users who authenticated via one of the given
+	// identity providers. Converts 1:1 to v1alpha1's LoginMethod.
+	// +optional
+	LoginMethod *LoginMethodRule `json:"loginMethod,omitempty"`
+
+	// AuthMethod matches the authentication method (e.g. "mfa", "hwk") used
+	// for the current session. Converts 1:1 to v1alpha1's AuthMethod.
+	// +optional
+	AuthMethod *AuthMethodRule `json:"authMethod,omitempty"`
+
+	// DevicePosture matches a configured device posture check. Converts 1:1
+	// to v1alpha1's DevicePosture.
+	// +optional
+	DevicePosture *DevicePostureRule `json:"devicePosture,omitempty"`
+
+	// ExternalEval delegates the match decision to an external endpoint.
+	// Converts 1:1 to v1alpha1's ExternalEvaluation.
+	// +optional
+	ExternalEval *ExternalEvalRule `json:"externalEval,omitempty"`
+
+	// GeoDistance matches requests within a radius of a reference location.
+	// +optional
+	GeoDistance *GeoDistanceRule `json:"geoDistance,omitempty"`
+
+	// AnyValidServiceToken matches any currently valid service token, regardless of which.
+	// +optional
+	AnyValidServiceToken *bool `json:"anyValidServiceToken,omitempty"`
+}
+
+// LoginMethodRule matches users who authenticated via one of IdentityProviderIDs.
+type LoginMethodRule struct {
+	// IdentityProviderIDs are the Cloudflare identity providers accepted as login methods.
+	// +kubebuilder:validation:MinItems=1
+	IdentityProviderIDs []string `json:"identityProviderIds"`
+}
+
+// AuthMethodRule matches the authentication method used for the current session.
+type AuthMethodRule struct {
+	// AuthMethod is the required authentication method, e.g. "mfa" or "hwk" (hardware key).
+	// +kubebuilder:validation:MinLength=1
+	AuthMethod string `json:"authMethod"`
+}
+
+// DevicePostureRule matches a configured Cloudflare device posture check.
+type DevicePostureRule struct {
+	// IntegrationUID is the Cloudflare device posture rule ID.
+	// +kubebuilder:validation:MinLength=1
+	IntegrationUID string `json:"integrationUid"`
+}
+
+// ExternalEvalRule delegates the match decision to an external HTTPS endpoint.
+type ExternalEvalRule struct {
+	// EvaluateURL is called with the request context to obtain a decision.
+	// +kubebuilder:validation:MinLength=1
+	EvaluateURL string `json:"evaluateUrl"`
+
+	// KeysURL serves the JWKS used to verify the endpoint's signed decision.
+	// +kubebuilder:validation:MinLength=1
+	KeysURL string `json:"keysUrl"`
+}
+
+// CloudflareAccessPolicySpec defines the desired state of CloudflareAccessPolicy.
+// +kubebuilder:validation:XValidation:rule="has(self.targetRef) || has(self.targetRefs)",message="either targetRef or targetRefs must be specified"
+// +kubebuilder:validation:XValidation:rule="!(has(self.targetRef) && has(self.targetRefs))",message="targetRef and targetRefs are mutually exclusive"
+type CloudflareAccessPolicySpec struct {
+	// TargetRef identifies a single target for policy attachment.
+	// +optional
+	TargetRef *PolicyTargetReference `json:"targetRef,omitempty"`
+
+	// TargetRefs identifies multiple targets for policy attachment.
+	// +optional
+	TargetRefs []PolicyTargetReference `json:"targetRefs,omitempty"`
+
+	// CloudflareRef references Cloudflare credentials (inherits from tunnel if omitted).
+	// +optional
+	CloudflareRef *CloudflareSecretRef `json:"cloudflareRef,omitempty"`
+
+	// Application defines the Access Application settings.
+	Application AccessApplication `json:"application"`
+
+	// Policies define access rules (evaluated in order).
+	// +optional
+	// +kubebuilder:validation:MaxItems=50
+	Policies []AccessPolicyRule `json:"policies,omitempty"`
+
+	// PolicyRefs reference CloudflareAccessReusablePolicy resources to attach
+	// to this Application's policies array, in addition to (or instead of)
+	// inline Policies. First-class in v1beta1: evaluated in the order listed,
+	// after inline Policies.
+	// +optional
+	// +kubebuilder:validation:MaxItems=50
+	PolicyRefs []PolicyRef `json:"policyRefs,omitempty"`
+
+	// GroupRefs reference reusable identity rules.
+	// +optional
+	GroupRefs []AccessGroupRef `json:"groupRefs,omitempty"`
+
+	// ServiceTokens for machine-to-machine authentication.
+	// +optional
+	ServiceTokens []ServiceTokenConfig `json:"serviceTokens,omitempty"`
+
+	// MTLS configures certificate-based authentication.
+	// +optional
+	MTLS *MTLSConfig `json:"mtls,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=cfap;cfaccess
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Application",type="string",JSONPath=".status.applicationId"
+// +kubebuilder:printcolumn:name="Targets",type="integer",JSONPath=".status.attachedTargets"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// CloudflareAccessPolicy is the Schema for the cloudflareaccespolicies API.
+// It describes Cloudflare Access Applications and Policies for zero-trust access control.
+// No controller reconciles this kind: applying one stores the object (and
+// converts it to and from v1alpha1) but creates nothing in Cloudflare.
+type CloudflareAccessPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudflareAccessPolicySpec   `json:"spec,omitempty"`
+	Status CloudflareAccessPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudflareAccessPolicyList contains a list of CloudflareAccessPolicy.
+type CloudflareAccessPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudflareAccessPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CloudflareAccessPolicy{}, &CloudflareAccessPolicyList{})
+}
@@ -0,0 +1,30 @@
+package v1beta1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// durationToString renders d as a Go duration string, or "" if zero,
+// matching v1alpha1's free-form SessionDuration string field.
+func durationToString(d metav1.Duration) string {
+	if d.Duration == 0 {
+		return ""
+	}
+	return d.Duration.String()
+}
+
+// stringToDuration parses v1alpha1's free-form SessionDuration string into a
+// metav1.Duration. An empty or unparseable value converts to zero rather
+// than failing the conversion webhook request over a malformed legacy value.
+func stringToDuration(s string) metav1.Duration {
+	if s == "" {
+		return metav1.Duration{}
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return metav1.Duration{}
+	}
+	return metav1.Duration{Duration: d}
+}
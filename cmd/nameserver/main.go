@@ -0,0 +1,45 @@
+// Command nameserver runs cfgate's in-cluster authoritative DNS server. It
+// loads the JSON record set a CloudflareDNSResolver publishes into a mounted
+// ConfigMap, answers A/AAAA/CNAME queries for the hostnames found there, and
+// reloads the record set whenever the file changes or the process receives
+// SIGHUP.
+package main
+
+import (
+	"flag"
+	"log"
+	"time"
+
+	"cfgate.io/cfgate/internal/nameserver"
+)
+
+func main() {
+	addr := flag.String("addr", "0.0.0.0:53", "address to listen on for UDP and TCP DNS queries")
+	recordsFile := flag.String("records-file", "/etc/cfgate-nameserver/records.json", "path to the JSON record set to serve")
+	ttl := flag.Duration("ttl", 30*time.Second, "TTL advertised on answers")
+	flag.Parse()
+
+	srv := nameserver.NewServer(*ttl)
+
+	set, err := nameserver.LoadRecordSet(*recordsFile)
+	if err != nil {
+		log.Fatalf("loading initial record set: %v", err)
+	}
+	srv.SetRecordSet(set)
+	log.Printf("loaded %d records across %d zones from %s", len(set.Records), len(set.Zones), *recordsFile)
+
+	stop := make(chan struct{})
+	go func() {
+		err := nameserver.WatchRecordsFile(srv, *recordsFile, stop, func(err error) {
+			log.Printf("reload failed: %v", err)
+		})
+		if err != nil {
+			log.Printf("watcher stopped: %v", err)
+		}
+	}()
+
+	log.Printf("listening on %s (udp+tcp)", *addr)
+	if err := srv.ListenAndServe(*addr); err != nil {
+		log.Fatal(err)
+	}
+}
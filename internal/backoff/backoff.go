@@ -0,0 +1,75 @@
+// Package backoff provides jittered exponential backoff helpers for requeue
+// scheduling, keeping many CRs sharing a rate-limited Cloudflare API token
+// from requeuing in lockstep and thundering the API on every retry.
+package backoff
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// RandomizeDuration returns a duration uniformly sampled from
+// [base*(1-variance), base*(1+variance)]. variance is clamped to [0, 1].
+func RandomizeDuration(base time.Duration, variance float64) time.Duration {
+	if variance <= 0 {
+		return base
+	}
+	if variance > 1 {
+		variance = 1
+	}
+
+	lower := float64(base) * (1 - variance)
+	spread := float64(base) * 2 * variance
+	return time.Duration(lower + rand.Float64()*spread)
+}
+
+// Tracker keeps a per-resource attempt counter, used to compute an
+// exponentially increasing requeue interval across repeated reconcile
+// failures. Reset clears the counter once a reconcile succeeds.
+type Tracker struct {
+	mu       sync.Mutex
+	attempts map[string]int
+}
+
+// NewTracker creates a new Tracker.
+func NewTracker() *Tracker {
+	return &Tracker{attempts: make(map[string]int)}
+}
+
+// Next records another failed attempt for key and returns its new count, starting at 1.
+func (t *Tracker) Next(key string) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.attempts[key]++
+	return t.attempts[key]
+}
+
+// Reset clears key's attempt counter, e.g. after a successful reconcile.
+func (t *Tracker) Reset(key string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.attempts, key)
+}
+
+// Duration returns the jittered exponential backoff for attempt (1-indexed):
+// base*2^(attempt-1), capped at max and then randomized by variance.
+func Duration(base, max time.Duration, attempt int, variance float64) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	d := base
+	for i := 1; i < attempt; i++ {
+		d *= 2
+		if d >= max {
+			d = max
+			break
+		}
+	}
+	if d > max {
+		d = max
+	}
+
+	return RandomizeDuration(d, variance)
+}
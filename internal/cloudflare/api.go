@@ -0,0 +1,214 @@
+// Package cloudflare provides a wrapper around cloudflare-go for cfgate's needs.
+package cloudflare
+
+import (
+	"context"
+	"time"
+)
+
+// CloudflareAPI is the narrow set of Cloudflare operations DNSService and
+// TunnelService need. It's kept separate from the full cloudflare-go client
+// surface (Client) so the ownership, cleanup, and drift logic built on top of
+// it can be unit-tested against a fake without implementing an entire SDK
+// client. Client satisfies this interface.
+//
+//go:generate mockgen -destination=mocks/mock_api.go -package=mocks cfgate.io/cfgate/internal/cloudflare CloudflareAPI
+type CloudflareAPI interface {
+	// GetZoneByName resolves a zone name to a Zone. Returns nil if not found.
+	GetZoneByName(ctx context.Context, name string) (*Zone, error)
+
+	// ListDNSRecords lists all DNS records in a zone.
+	ListDNSRecords(ctx context.Context, zoneID string) ([]DNSRecord, error)
+
+	// CreateDNSRecord creates a DNS record in a zone.
+	CreateDNSRecord(ctx context.Context, zoneID string, record DNSRecord) (*DNSRecord, error)
+
+	// UpdateDNSRecord updates an existing DNS record.
+	UpdateDNSRecord(ctx context.Context, zoneID, recordID string, record DNSRecord) (*DNSRecord, error)
+
+	// DeleteDNSRecord deletes a DNS record by ID.
+	DeleteDNSRecord(ctx context.Context, zoneID, recordID string) error
+
+	// GetTunnelConfiguration fetches a tunnel's ingress configuration.
+	GetTunnelConfiguration(ctx context.Context, tunnelID string) (*TunnelIngressConfig, error)
+
+	// UpdateTunnelConfiguration replaces a tunnel's ingress configuration.
+	UpdateTunnelConfiguration(ctx context.Context, tunnelID string, config TunnelIngressConfig) error
+}
+
+// Client is the full set of Cloudflare operations cfgate's services use,
+// spanning tunnels, DNS, redirects, WAF lists, health checks, load
+// balancers, virtual networks, and IP routes. It's broader than
+// CloudflareAPI because most services each touch only one Cloudflare
+// product area but, unlike DNSService and TunnelService, aren't performance-
+// or testability-sensitive enough to warrant their own narrow interface.
+// A real implementation wraps cloudflare-go/v6; fake (in the fake
+// subpackage) is an in-memory implementation for hermetic controller tests.
+type Client interface {
+	// CreateTunnel creates a Cloudflare Tunnel.
+	CreateTunnel(ctx context.Context, accountID, name string) (*Tunnel, error)
+
+	// GetTunnel fetches a tunnel by ID. Returns nil if not found.
+	GetTunnel(ctx context.Context, accountID, tunnelID string) (*Tunnel, error)
+
+	// ListTunnels lists an account's tunnels.
+	ListTunnels(ctx context.Context, accountID string) ([]Tunnel, error)
+
+	// DeleteTunnel deletes a tunnel by ID.
+	DeleteTunnel(ctx context.Context, accountID, tunnelID string) error
+
+	// GetTunnelConfiguration fetches a tunnel's ingress configuration.
+	GetTunnelConfiguration(ctx context.Context, tunnelID string) (*TunnelIngressConfig, error)
+
+	// UpdateTunnelConfiguration replaces a tunnel's ingress configuration.
+	UpdateTunnelConfiguration(ctx context.Context, tunnelID string, config TunnelIngressConfig) error
+
+	// CleanupTunnelConnections tears down a tunnel's active connections, the
+	// server-side equivalent of cloudflared's `tunnel run --force` preflight.
+	CleanupTunnelConnections(ctx context.Context, accountID, tunnelID string) error
+
+	// ListTunnelConnections fetches a tunnel's current and recently
+	// disconnected connector inventory, the equivalent of cloudflared's
+	// `--show-recently-disconnected` flag.
+	ListTunnelConnections(ctx context.Context, accountID, tunnelID string) ([]Connection, error)
+
+	// GetZoneByName resolves a zone name to a Zone. Returns nil if not found.
+	GetZoneByName(ctx context.Context, name string) (*Zone, error)
+
+	// ListDNSRecords lists all DNS records in a zone.
+	ListDNSRecords(ctx context.Context, zoneID string) ([]DNSRecord, error)
+
+	// CreateDNSRecord creates a DNS record in a zone.
+	CreateDNSRecord(ctx context.Context, zoneID string, record DNSRecord) (*DNSRecord, error)
+
+	// UpdateDNSRecord updates an existing DNS record.
+	UpdateDNSRecord(ctx context.Context, zoneID, recordID string, record DNSRecord) (*DNSRecord, error)
+
+	// DeleteDNSRecord deletes a DNS record by ID.
+	DeleteDNSRecord(ctx context.Context, zoneID, recordID string) error
+
+	// GetDynamicRedirectRuleset fetches a zone's http_request_dynamic_redirect ruleset.
+	GetDynamicRedirectRuleset(ctx context.Context, zoneID string) (*RedirectRuleset, error)
+
+	// UpdateDynamicRedirectRuleset replaces a zone's dynamic redirect rules.
+	UpdateDynamicRedirectRuleset(ctx context.Context, zoneID string, rules []RedirectRule) error
+
+	// ListWAFLists lists an account's WAF lists.
+	ListWAFLists(ctx context.Context, accountID string) ([]WAFList, error)
+
+	// CreateWAFList creates a WAF list.
+	CreateWAFList(ctx context.Context, accountID string, list WAFList) (*WAFList, error)
+
+	// ListWAFListItems lists a WAF list's items.
+	ListWAFListItems(ctx context.Context, accountID, listID string) ([]WAFListItem, error)
+
+	// CreateWAFListItems bulk-creates items in a WAF list.
+	CreateWAFListItems(ctx context.Context, accountID, listID string, items []WAFListItem) error
+
+	// DeleteWAFListItems bulk-deletes items from a WAF list by ID.
+	DeleteWAFListItems(ctx context.Context, accountID, listID string, itemIDs []string) error
+
+	// ListHealthChecks lists a zone's standalone health checks.
+	ListHealthChecks(ctx context.Context, zoneID string) ([]HealthCheck, error)
+
+	// CreateHealthCheck creates a health check.
+	CreateHealthCheck(ctx context.Context, zoneID string, check HealthCheck) (*HealthCheck, error)
+
+	// UpdateHealthCheck updates an existing health check.
+	UpdateHealthCheck(ctx context.Context, zoneID, healthCheckID string, check HealthCheck) (*HealthCheck, error)
+
+	// DeleteHealthCheck deletes a health check by ID.
+	DeleteHealthCheck(ctx context.Context, zoneID, healthCheckID string) error
+
+	// GetHealthCheckStatus fetches a health check's current status.
+	GetHealthCheckStatus(ctx context.Context, zoneID, healthCheckID string) (*HealthCheckStatus, error)
+
+	// ListLoadBalancerPools lists an account's load balancer pools.
+	ListLoadBalancerPools(ctx context.Context, accountID string) ([]LoadBalancerPool, error)
+
+	// CreateLoadBalancerPool creates a load balancer pool.
+	CreateLoadBalancerPool(ctx context.Context, accountID string, pool LoadBalancerPool) (*LoadBalancerPool, error)
+
+	// UpdateLoadBalancerPool updates an existing load balancer pool.
+	UpdateLoadBalancerPool(ctx context.Context, accountID, poolID string, pool LoadBalancerPool) (*LoadBalancerPool, error)
+
+	// DeleteLoadBalancerPool deletes a load balancer pool by ID.
+	DeleteLoadBalancerPool(ctx context.Context, accountID, poolID string) error
+
+	// GetLoadBalancerPoolStatus fetches a pool's current health.
+	GetLoadBalancerPoolStatus(ctx context.Context, accountID, poolID string) (*PoolStatus, error)
+
+	// ListLoadBalancers lists a zone's load balancers.
+	ListLoadBalancers(ctx context.Context, zoneID string) ([]LoadBalancer, error)
+
+	// CreateLoadBalancer creates a load balancer.
+	CreateLoadBalancer(ctx context.Context, zoneID string, lb LoadBalancer) (*LoadBalancer, error)
+
+	// UpdateLoadBalancer updates an existing load balancer.
+	UpdateLoadBalancer(ctx context.Context, zoneID, loadBalancerID string, lb LoadBalancer) (*LoadBalancer, error)
+
+	// DeleteLoadBalancer deletes a load balancer by ID.
+	DeleteLoadBalancer(ctx context.Context, zoneID, loadBalancerID string) error
+
+	// ListVirtualNetworks lists an account's virtual networks.
+	ListVirtualNetworks(ctx context.Context, accountID string) ([]VirtualNetwork, error)
+
+	// CreateVirtualNetwork creates a virtual network.
+	CreateVirtualNetwork(ctx context.Context, accountID string, vnet VirtualNetwork) (*VirtualNetwork, error)
+
+	// UpdateVirtualNetwork updates an existing virtual network.
+	UpdateVirtualNetwork(ctx context.Context, accountID, vnetID string, vnet VirtualNetwork) (*VirtualNetwork, error)
+
+	// DeleteVirtualNetwork deletes a virtual network by ID.
+	DeleteVirtualNetwork(ctx context.Context, accountID, vnetID string) error
+
+	// ListIPRoutes lists an account's Teamnet IP routes.
+	ListIPRoutes(ctx context.Context, accountID string) ([]IPRoute, error)
+
+	// CreateIPRoute creates a Teamnet IP route.
+	CreateIPRoute(ctx context.Context, accountID string, route IPRoute) (*IPRoute, error)
+
+	// DeleteIPRoute deletes a Teamnet IP route by ID.
+	DeleteIPRoute(ctx context.Context, accountID, routeID string) error
+}
+
+// Tunnel represents a Cloudflare Tunnel's identity, independent of its
+// ingress configuration (TunnelIngressConfig) or its Teamnet routes
+// (IPRoute). This repository's CloudflareTunnel controller isn't present in
+// this tree, so CreateTunnel/GetTunnel/ListTunnels/DeleteTunnel/
+// CleanupTunnelConnections have no caller here yet; they're part of Client
+// because a hermetic fake needs the full surface cfgate's operator uses,
+// not just the subset this snapshot happens to call.
+type Tunnel struct {
+	ID     string
+	Name   string
+	Domain string
+}
+
+// Connection is a single cloudflared connector's entry in a tunnel's
+// connection inventory, mirroring GET
+// /accounts/{id}/cfd_tunnel/{tid}/connections.
+type Connection struct {
+	// ID is the connector's UUID, assigned by cloudflared on connect.
+	ID string
+
+	// ColoName is the Cloudflare edge location the connector is linked to.
+	ColoName string
+
+	// OpenedAt is when the connection was established.
+	OpenedAt time.Time
+
+	// ClientVersion is the cloudflared version reported by the connector.
+	ClientVersion string
+
+	// Origin is the network origin the connector reported, e.g. an IP.
+	Origin string
+
+	// Disconnected is true for a connector no longer active but still
+	// within Cloudflare's recently-disconnected retention window.
+	Disconnected bool
+
+	// DisconnectedAt is when a Disconnected connector dropped. Zero for
+	// active connectors.
+	DisconnectedAt time.Time
+}
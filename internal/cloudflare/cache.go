@@ -3,40 +3,84 @@ package cloudflare
 
 import (
 	"context"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 )
 
 const (
 	// DefaultCacheTTL is the default TTL for cached credentials.
 	DefaultCacheTTL = 30 * time.Second
+
+	// DefaultMaxEntries bounds the cache when no MaxEntries is configured,
+	// so a long-running controller can't grow the map unbounded across
+	// secret rotations.
+	DefaultMaxEntries = 256
+
+	// DefaultSweepInterval is how often Start runs Cleanup when no interval
+	// is given.
+	DefaultSweepInterval = time.Minute
+)
+
+var (
+	credentialCacheHitsTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "cfgate_credential_cache_hits_total",
+		Help: "Total number of CredentialCache lookups served from cache.",
+	})
+	credentialCacheMissesTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "cfgate_credential_cache_misses_total",
+		Help: "Total number of CredentialCache lookups that missed (absent, expired, or never cached).",
+	})
+	credentialCacheEvictionsTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "cfgate_credential_cache_evictions_total",
+		Help: "Total number of CredentialCache entries evicted, for any reason.",
+	})
 )
 
+func init() {
+	metrics.Registry.MustRegister(credentialCacheHitsTotal, credentialCacheMissesTotal, credentialCacheEvictionsTotal)
+}
+
 // CredentialCache caches validated Cloudflare clients to avoid repeated API validations.
 // The cache key is based on secret UID and ResourceVersion, ensuring cache invalidation
 // when the secret changes.
 type CredentialCache struct {
-	mu      sync.RWMutex
-	entries map[string]cacheEntry
-	ttl     time.Duration
+	mu         sync.RWMutex
+	entries    map[string]cacheEntry
+	ttl        time.Duration
+	maxEntries int
+
+	stopOnce sync.Once
+	stopCh   chan struct{}
 }
 
 // cacheEntry stores a cached client and its expiration time.
 type cacheEntry struct {
-	client    Client
-	expiresAt time.Time
+	client     Client
+	expiresAt  time.Time
+	lastAccess time.Time
 }
 
-// NewCredentialCache creates a new CredentialCache with the specified TTL.
-func NewCredentialCache(ttl time.Duration) *CredentialCache {
+// NewCredentialCache creates a new CredentialCache with the specified TTL and
+// a maximum entry count (DefaultMaxEntries if maxEntries <= 0). Once Set
+// would push the cache past maxEntries, the least-recently-accessed entry is
+// evicted first.
+func NewCredentialCache(ttl time.Duration, maxEntries int) *CredentialCache {
 	if ttl <= 0 {
 		ttl = DefaultCacheTTL
 	}
+	if maxEntries <= 0 {
+		maxEntries = DefaultMaxEntries
+	}
 	return &CredentialCache{
-		entries: make(map[string]cacheEntry),
-		ttl:     ttl,
+		entries:    make(map[string]cacheEntry),
+		ttl:        ttl,
+		maxEntries: maxEntries,
 	}
 }
 
@@ -51,35 +95,65 @@ func cacheKey(secret *corev1.Secret) string {
 func (c *CredentialCache) Get(secret *corev1.Secret) Client {
 	key := cacheKey(secret)
 
-	c.mu.RLock()
+	c.mu.Lock()
 	entry, ok := c.entries[key]
-	c.mu.RUnlock()
-
-	if !ok {
-		return nil
+	if ok && time.Now().After(entry.expiresAt) {
+		delete(c.entries, key)
+		ok = false
+	}
+	if ok {
+		entry.lastAccess = time.Now()
+		c.entries[key] = entry
 	}
+	c.mu.Unlock()
 
-	if time.Now().After(entry.expiresAt) {
-		// Entry expired, remove it
-		c.mu.Lock()
-		delete(c.entries, key)
-		c.mu.Unlock()
+	if !ok {
+		credentialCacheMissesTotal.Inc()
 		return nil
 	}
-
+	credentialCacheHitsTotal.Inc()
 	return entry.client
 }
 
-// Set stores a client in the cache for the given secret.
+// Set stores a client in the cache for the given secret, evicting the
+// least-recently-accessed entry first if this would exceed maxEntries.
 func (c *CredentialCache) Set(secret *corev1.Secret, client Client) {
 	key := cacheKey(secret)
+	now := time.Now()
 
 	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, exists := c.entries[key]; !exists && len(c.entries) >= c.maxEntries {
+		c.evictOldestLocked()
+	}
+
 	c.entries[key] = cacheEntry{
-		client:    client,
-		expiresAt: time.Now().Add(c.ttl),
+		client:     client,
+		expiresAt:  now.Add(c.ttl),
+		lastAccess: now,
+	}
+}
+
+// evictOldestLocked removes the least-recently-accessed entry. c.mu must
+// already be held for writing.
+func (c *CredentialCache) evictOldestLocked() {
+	var oldestKey string
+	var oldestAccess time.Time
+	first := true
+
+	for key, entry := range c.entries {
+		if first || entry.lastAccess.Before(oldestAccess) {
+			oldestKey = key
+			oldestAccess = entry.lastAccess
+			first = false
+		}
+	}
+
+	if !first {
+		delete(c.entries, oldestKey)
+		credentialCacheEvictionsTotal.Inc()
 	}
-	c.mu.Unlock()
 }
 
 // GetOrCreate retrieves a cached client or creates a new one using the provided function.
@@ -111,6 +185,22 @@ func (c *CredentialCache) Invalidate(secret *corev1.Secret) {
 	c.mu.Unlock()
 }
 
+// InvalidateByUID removes every entry for uid, regardless of ResourceVersion.
+// Callers should use this on a Secret update event instead of Invalidate, so
+// a stale ResourceVersion from before the update doesn't linger in the cache
+// until its TTL expires.
+func (c *CredentialCache) InvalidateByUID(uid types.UID) {
+	prefix := string(uid) + ":"
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.entries {
+		if strings.HasPrefix(key, prefix) {
+			delete(c.entries, key)
+		}
+	}
+}
+
 // Clear removes all entries from the cache.
 func (c *CredentialCache) Clear() {
 	c.mu.Lock()
@@ -127,6 +217,7 @@ func (c *CredentialCache) Cleanup() {
 	for key, entry := range c.entries {
 		if now.After(entry.expiresAt) {
 			delete(c.entries, key)
+			credentialCacheEvictionsTotal.Inc()
 		}
 	}
 	c.mu.Unlock()
@@ -138,3 +229,39 @@ func (c *CredentialCache) Size() int {
 	defer c.mu.RUnlock()
 	return len(c.entries)
 }
+
+// Start runs Cleanup on a background goroutine every interval
+// (DefaultSweepInterval if interval <= 0), until ctx is cancelled or Stop is
+// called. Intended to be called once from a controller's SetupWithManager,
+// e.g. via mgr.Add(manager.RunnableFunc(...)).
+func (c *CredentialCache) Start(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultSweepInterval
+	}
+	c.stopCh = make(chan struct{})
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				c.Cleanup()
+			case <-ctx.Done():
+				return
+			case <-c.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+// Stop signals the goroutine started by Start to exit. Safe to call more
+// than once, and safe to call even if Start was never called.
+func (c *CredentialCache) Stop() {
+	c.stopOnce.Do(func() {
+		if c.stopCh != nil {
+			close(c.stopCh)
+		}
+	})
+}
@@ -0,0 +1,91 @@
+package cloudflare_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"cfgate.io/cfgate/internal/cloudflare"
+)
+
+func secretWithUID(uid, resourceVersion string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{UID: types.UID(uid), ResourceVersion: resourceVersion},
+	}
+}
+
+// fakeClient embeds a nil cloudflare.Client so distinct *fakeClient values
+// satisfy the interface without implementing its full method set, while
+// still comparing unequal to each other and to nil by pointer identity.
+type fakeClient struct {
+	cloudflare.Client
+}
+
+func TestCredentialCache_EvictsLeastRecentlyAccessedOverMaxEntries(t *testing.T) {
+	cache := cloudflare.NewCredentialCache(time.Minute, 2)
+
+	a := secretWithUID("a", "1")
+	b := secretWithUID("b", "1")
+	c := secretWithUID("c", "1")
+	clientA, clientC := &fakeClient{}, &fakeClient{}
+
+	cache.Set(a, clientA)
+	cache.Set(b, &fakeClient{})
+
+	// Touch a so it's more recently used than b.
+	cache.Get(a)
+
+	cache.Set(c, clientC)
+
+	if cache.Size() != 2 {
+		t.Fatalf("expected cache to stay at MaxEntries=2, got size %d", cache.Size())
+	}
+	if cache.Get(b) != nil {
+		t.Fatal("expected b, the least-recently-accessed entry, to have been evicted")
+	}
+	if cache.Get(a) != clientA || cache.Get(c) != clientC {
+		t.Fatal("expected a and c to remain cached")
+	}
+}
+
+func TestCredentialCache_InvalidateByUIDDropsAllResourceVersions(t *testing.T) {
+	cache := cloudflare.NewCredentialCache(time.Minute, 10)
+
+	oldSecret := secretWithUID("a", "1")
+	newSecret := secretWithUID("a", "2")
+	other := secretWithUID("b", "1")
+
+	cache.Set(oldSecret, nil)
+	cache.Set(newSecret, nil)
+	cache.Set(other, nil)
+
+	cache.InvalidateByUID(types.UID("a"))
+
+	if cache.Size() != 1 {
+		t.Fatalf("expected only the unrelated UID's entry to remain, got size %d", cache.Size())
+	}
+}
+
+func TestCredentialCache_StartSweepsExpiredEntries(t *testing.T) {
+	cache := cloudflare.NewCredentialCache(time.Millisecond, 10)
+	cache.Set(secretWithUID("a", "1"), nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cache.Start(ctx, 5*time.Millisecond)
+	defer cache.Stop()
+
+	deadline := time.Now().Add(time.Second)
+	for cache.Size() != 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	if cache.Size() != 0 {
+		t.Fatalf("expected Start's sweeper to clean up the expired entry, got size %d", cache.Size())
+	}
+}
@@ -5,60 +5,155 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
+
+	"golang.org/x/net/publicsuffix"
 )
 
 // DNSService handles DNS-specific operations.
 // It wraps the cloudflare-go client with cfgate-specific logic.
 type DNSService struct {
-	// client is the underlying Cloudflare client.
-	client Client
+	// client is the underlying Cloudflare API.
+	client CloudflareAPI
+
+	// zoneCache caches ResolveZoneForHostname results, keyed by hostname and
+	// candidate zone list, to avoid re-resolving the same hostname's zone on
+	// every reconcile.
+	zoneCacheMu sync.RWMutex
+	zoneCache   map[string]zoneCacheEntry
+
+	// signingKey, when set, makes BuildOwnerIdentity sign ownership payloads
+	// (see BuildSignedOwnerIdentity) instead of writing the plain
+	// heritage=cfgate payload, and makes GetOwnerIdentity require a valid
+	// signature before trusting a TXT ownership record.
+	signingKey []byte
+
+	// acceptLegacyMarkers lets GetOwnerIdentity fall back to the unsigned
+	// ParseOwnerIdentity format when signingKey is set but a record predates
+	// it, so a fleet can migrate to signed markers without every
+	// CloudflareDNSSync re-owning its records on the same reconcile.
+	acceptLegacyMarkers bool
+
+	// recordCache, when set, makes listRecords serve a zone's record list
+	// from it instead of calling ListDNSRecords every time, so a reconcile
+	// that looks up many hostnames in the same zone costs at most one list
+	// call (see WithRecordCache).
+	recordCache *ZoneRecordCache
+}
+
+// WithRecordCache returns a shallow copy of s that serves ListDNSRecords
+// results from cache (see ZoneRecordCache). Share one cache across the
+// DNSServices built for a zone's reconciles to collapse their per-hostname
+// record lookups into a single Cloudflare API call per zone.
+func (s *DNSService) WithRecordCache(cache *ZoneRecordCache) *DNSService {
+	clone := *s
+	clone.recordCache = cache
+	return &clone
+}
+
+// listRecords returns zoneID's full record list, via s.recordCache if one is
+// set, falling back to - and populating - a direct ListDNSRecords call on a
+// cache miss.
+func (s *DNSService) listRecords(ctx context.Context, zoneID string) ([]DNSRecord, error) {
+	if s.recordCache != nil {
+		if records, ok := s.recordCache.get(zoneID); ok {
+			return records, nil
+		}
+	}
+
+	records, err := s.client.ListDNSRecords(ctx, zoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.recordCache != nil {
+		s.recordCache.set(zoneID, records)
+	}
+	return records, nil
 }
 
 // NewDNSService creates a new DNSService.
-func NewDNSService(client Client) *DNSService {
+func NewDNSService(client CloudflareAPI) *DNSService {
 	return &DNSService{
-		client: client,
+		client:    client,
+		zoneCache: make(map[string]zoneCacheEntry),
 	}
 }
 
+// NewDNSServiceWithSigningKey creates a DNSService whose ownership TXT
+// records are HMAC-signed under signingKey (see BuildSignedOwnerIdentity),
+// so another party writing the same "heritage=cfgate,owner=..." plaintext
+// into a shared zone can no longer spoof ownership of a hostname. When
+// acceptLegacyMarkers is true, GetOwnerIdentity still accepts unsigned
+// records it can't verify, so migrating a CloudflareDNSSync onto a signing
+// key doesn't immediately treat every hostname it already owns as
+// conflicting.
+func NewDNSServiceWithSigningKey(client CloudflareAPI, signingKey []byte, acceptLegacyMarkers bool) *DNSService {
+	return &DNSService{
+		client:              client,
+		zoneCache:           make(map[string]zoneCacheEntry),
+		signingKey:          signingKey,
+		acceptLegacyMarkers: acceptLegacyMarkers,
+	}
+}
+
+// BuildOwnerIdentity builds the content SyncRecord's caller should pass to
+// CreateOwnershipRecord for owner/tunnelName: a signed payload (see
+// BuildSignedOwnerIdentity) if s was constructed with a signing key,
+// otherwise the legacy unsigned OwnerIdentity payload.
+func (s *DNSService) BuildOwnerIdentity(owner, tunnelName string) (string, error) {
+	if s.signingKey == nil {
+		return OwnerIdentity(owner, tunnelName), nil
+	}
+	return BuildSignedOwnerIdentity(s.signingKey, owner, tunnelName)
+}
+
 // SyncRecord ensures a DNS record exists with the desired configuration.
 // Creates the record if it doesn't exist, updates it if it differs.
-// Respects ownership - will NOT update records not owned by cfgate.
-// Returns the record, whether it was modified, and any error.
-func (s *DNSService) SyncRecord(ctx context.Context, zoneID string, desired DNSRecord) (*DNSRecord, bool, error) {
+// Before updating an existing record, verifies ownership via CheckOwnership
+// so that a CNAME owned by another CloudflareDNSSync instance (e.g. another
+// cluster managing the same tunnel) is never modified.
+// Returns the record, whether it was modified, whether an ownership
+// conflict blocked the update, and any error.
+func (s *DNSService) SyncRecord(ctx context.Context, zoneID string, desired DNSRecord, ownerID, prefix string, txtEnabled bool) (record *DNSRecord, modified, conflict bool, err error) {
 	// Find existing record
 	existing, err := s.FindRecordByName(ctx, zoneID, desired.Name, desired.Type)
 	if err != nil {
-		return nil, false, fmt.Errorf("failed to find existing record: %w", err)
+		return nil, false, false, fmt.Errorf("failed to find existing record: %w", err)
 	}
 
 	// Create if doesn't exist
 	if existing == nil {
-		record, err := s.client.CreateDNSRecord(ctx, zoneID, desired)
+		created, err := s.client.CreateDNSRecord(ctx, zoneID, desired)
 		if err != nil {
-			return nil, false, fmt.Errorf("failed to create DNS record: %w", err)
+			return nil, false, false, fmt.Errorf("failed to create DNS record: %w", err)
 		}
-		return record, true, nil
+		s.invalidateZone(zoneID)
+		return created, true, false, nil
 	}
 
 	// Check ownership before updating - only update records we own
-	if !IsOwnedByCfgate(existing, "", "") {
-		// Record exists but is not owned by cfgate - don't modify
-		return existing, false, nil
+	owned, err := s.CheckOwnership(ctx, zoneID, desired.Name, prefix, ownerID, txtEnabled, existing)
+	if err != nil {
+		return nil, false, false, fmt.Errorf("failed to check record ownership: %w", err)
+	}
+	if !owned {
+		return existing, false, true, nil
 	}
 
 	// Check if update needed
 	if recordsMatch(existing, &desired) {
-		return existing, false, nil
+		return existing, false, false, nil
 	}
 
 	// Update existing record (we own it)
-	record, err := s.client.UpdateDNSRecord(ctx, zoneID, existing.ID, desired)
+	updated, err := s.client.UpdateDNSRecord(ctx, zoneID, existing.ID, desired)
 	if err != nil {
-		return nil, false, fmt.Errorf("failed to update DNS record: %w", err)
+		return nil, false, false, fmt.Errorf("failed to update DNS record: %w", err)
 	}
 
-	return record, true, nil
+	s.invalidateZone(zoneID)
+	return updated, true, false, nil
 }
 
 // recordsMatch checks if two records have the same content.
@@ -71,13 +166,25 @@ func recordsMatch(a, b *DNSRecord) bool {
 
 // DeleteRecord deletes a DNS record by ID.
 func (s *DNSService) DeleteRecord(ctx context.Context, zoneID, recordID string) error {
-	return s.client.DeleteDNSRecord(ctx, zoneID, recordID)
+	if err := s.client.DeleteDNSRecord(ctx, zoneID, recordID); err != nil {
+		return err
+	}
+	s.invalidateZone(zoneID)
+	return nil
+}
+
+// invalidateZone drops zoneID from s.recordCache, if one is set, after a
+// mutation - a no-op when no cache is configured.
+func (s *DNSService) invalidateZone(zoneID string) {
+	if s.recordCache != nil {
+		s.recordCache.Invalidate(zoneID)
+	}
 }
 
 // FindRecordByName finds a DNS record by name and type.
 // Returns nil if not found.
 func (s *DNSService) FindRecordByName(ctx context.Context, zoneID, name, recordType string) (*DNSRecord, error) {
-	records, err := s.client.ListDNSRecords(ctx, zoneID)
+	records, err := s.listRecords(ctx, zoneID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list DNS records: %w", err)
 	}
@@ -95,7 +202,7 @@ func (s *DNSService) FindRecordByName(ctx context.Context, zoneID, name, recordT
 // ListManagedRecords lists all DNS records managed by cfgate.
 // Uses ownership markers (TXT records or comments) to identify managed records.
 func (s *DNSService) ListManagedRecords(ctx context.Context, zoneID, ownershipPrefix string) ([]DNSRecord, error) {
-	records, err := s.client.ListDNSRecords(ctx, zoneID)
+	records, err := s.listRecords(ctx, zoneID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list DNS records: %w", err)
 	}
@@ -117,10 +224,11 @@ func (s *DNSService) ListManagedRecords(ctx context.Context, zoneID, ownershipPr
 	return managed, nil
 }
 
-// CreateOwnershipRecord creates or updates a TXT record for ownership tracking.
+// CreateOwnershipRecord creates or updates a TXT record identifying the
+// cfgate instance (owner) that manages hostname's CNAME record.
 // Uses upsert pattern: checks if record exists before creating to avoid duplicate errors.
-func (s *DNSService) CreateOwnershipRecord(ctx context.Context, zoneID, hostname, tunnelName string, prefix string) error {
-	record := BuildOwnershipTXTRecord(hostname, tunnelName, prefix)
+func (s *DNSService) CreateOwnershipRecord(ctx context.Context, zoneID, hostname, ownerIdentity string, prefix string) error {
+	record := BuildOwnershipTXTRecord(hostname, ownerIdentity, prefix)
 
 	// Check if ownership record already exists
 	existing, err := s.FindRecordByName(ctx, zoneID, record.Name, record.Type)
@@ -138,6 +246,7 @@ func (s *DNSService) CreateOwnershipRecord(ctx context.Context, zoneID, hostname
 		if err != nil {
 			return fmt.Errorf("failed to update ownership record: %w", err)
 		}
+		s.invalidateZone(zoneID)
 		return nil
 	}
 
@@ -147,12 +256,13 @@ func (s *DNSService) CreateOwnershipRecord(ctx context.Context, zoneID, hostname
 		return fmt.Errorf("failed to create ownership record: %w", err)
 	}
 
+	s.invalidateZone(zoneID)
 	return nil
 }
 
 // DeleteOwnershipRecord deletes the TXT record for ownership tracking.
 func (s *DNSService) DeleteOwnershipRecord(ctx context.Context, zoneID, hostname, prefix string) error {
-	txtName := fmt.Sprintf("%s.%s", prefix, hostname)
+	txtName := OwnershipTXTName(hostname, prefix)
 	record, err := s.FindRecordByName(ctx, zoneID, txtName, "TXT")
 	if err != nil {
 		return fmt.Errorf("failed to find ownership record: %w", err)
@@ -165,26 +275,245 @@ func (s *DNSService) DeleteOwnershipRecord(ctx context.Context, zoneID, hostname
 	return s.DeleteRecord(ctx, zoneID, record.ID)
 }
 
+// GetOwnerIdentity fetches the ownership TXT record for hostname and returns
+// the owner namespaced-name it claims, if any.
+// Returns ok=false if no ownership record exists or it doesn't parse. When s
+// has a signing key configured, a record must carry a valid signature to be
+// trusted; an unsigned or forged record is only accepted as a fallback when
+// acceptLegacyMarkers is set, otherwise it's treated as unowned rather than
+// handed to the caller.
+func (s *DNSService) GetOwnerIdentity(ctx context.Context, zoneID, hostname, prefix string) (owner string, ok bool, err error) {
+	txtName := OwnershipTXTName(hostname, prefix)
+	record, err := s.FindRecordByName(ctx, zoneID, txtName, "TXT")
+	if err != nil {
+		return "", false, fmt.Errorf("failed to find ownership record: %w", err)
+	}
+	if record == nil {
+		return "", false, nil
+	}
+
+	if s.signingKey != nil {
+		owner, ok = VerifySignedOwnerIdentity(s.signingKey, record.Content)
+		if ok {
+			return owner, true, nil
+		}
+		if !s.acceptLegacyMarkers {
+			return "", false, nil
+		}
+	}
+
+	owner, ok = ParseOwnerIdentity(record.Content)
+	return owner, ok, nil
+}
+
+// CheckOwnership reports whether record may be safely updated or deleted by
+// ownerID. When txtEnabled is true, trust comes from the sibling ownership
+// TXT record written by CreateOwnershipRecord: the record is only considered
+// owned if that TXT record parses and names ownerID as its owner. This is
+// what keeps two CloudflareDNSSync instances (e.g. two clusters managing the
+// same tunnel) from deleting or overwriting each other's records when they
+// briefly overlap on a hostname. A record with no parseable ownership TXT is
+// treated as unowned. When txtEnabled is false, falls back to comment-based
+// ownership via IsOwnedByCfgate.
+func (s *DNSService) CheckOwnership(ctx context.Context, zoneID, hostname, prefix, ownerID string, txtEnabled bool, record *DNSRecord) (bool, error) {
+	if !txtEnabled {
+		return IsOwnedByCfgate(record, "", ""), nil
+	}
+
+	owner, ok, err := s.GetOwnerIdentity(ctx, zoneID, hostname, prefix)
+	if err != nil {
+		return false, err
+	}
+	if !ok {
+		return false, nil
+	}
+
+	return owner == ownerID, nil
+}
+
 // ResolveZone resolves a zone name to a Zone.
 // Returns nil if the zone doesn't exist or isn't accessible.
 func (s *DNSService) ResolveZone(ctx context.Context, zoneName string) (*Zone, error) {
 	return s.client.GetZoneByName(ctx, zoneName)
 }
 
-// ExtractZoneFromHostname extracts the zone name from a hostname.
-// For example, "app.example.com" -> "example.com".
+// SyncDelegationRecords ensures zoneID has exactly one NS record at
+// subdomain for each of nameServers, removing any NS record at subdomain
+// that isn't in the set. Used to delegate a child CloudflareManagedZone's
+// subdomain to its own nameservers from within its parent zone.
+// Returns whether any record was created, updated, or removed.
+func (s *DNSService) SyncDelegationRecords(ctx context.Context, zoneID, subdomain string, nameServers []string) (changed bool, err error) {
+	records, err := s.listRecords(ctx, zoneID)
+	if err != nil {
+		return false, fmt.Errorf("failed to list DNS records: %w", err)
+	}
+
+	want := make(map[string]bool, len(nameServers))
+	for _, ns := range nameServers {
+		want[ns] = true
+	}
+
+	have := make(map[string]string) // content -> record ID
+	for _, record := range records {
+		if record.Name == subdomain && record.Type == "NS" {
+			have[record.Content] = record.ID
+		}
+	}
+
+	for _, ns := range nameServers {
+		if _, ok := have[ns]; ok {
+			continue
+		}
+		if _, err := s.client.CreateDNSRecord(ctx, zoneID, DNSRecord{
+			Type:    "NS",
+			Name:    subdomain,
+			Content: ns,
+			TTL:     1, // Auto TTL
+			Comment: "cfgate zone delegation",
+		}); err != nil {
+			return changed, fmt.Errorf("failed to create delegation NS record for %s: %w", ns, err)
+		}
+		changed = true
+	}
+
+	for content, recordID := range have {
+		if want[content] {
+			continue
+		}
+		if err := s.client.DeleteDNSRecord(ctx, zoneID, recordID); err != nil {
+			return changed, fmt.Errorf("failed to delete stale delegation NS record for %s: %w", content, err)
+		}
+		changed = true
+	}
+
+	if changed {
+		s.invalidateZone(zoneID)
+	}
+
+	return changed, nil
+}
+
+// ExtractZoneFromHostname extracts the registrable domain (eTLD+1) from a
+// hostname, using the Public Suffix List so multi-label TLDs resolve
+// correctly, e.g. "app.example.co.uk" -> "example.co.uk" rather than the
+// bare "co.uk" a naive last-two-labels split would return. This is only a
+// default: it has no way to know about a multi-label apex-owned zone (e.g.
+// a Cloudflare zone of "team.example.com"), so callers that know the set of
+// zones actually available to them should prefer SplitHostnameZone or
+// DNSService.ResolveZoneForHostname instead.
 func ExtractZoneFromHostname(hostname string) string {
+	zone, _ := SplitHostnameZone(hostname, nil)
+	return zone
+}
+
+// SplitHostnameZone splits hostname into a zone name and the remaining
+// subdomain label(s) relative to that zone, e.g. ("app.example.com", nil)
+// -> ("example.com", "app"). If candidateZones is non-empty, the longest
+// zone in it that hostname actually falls under wins (so a configured
+// multi-label zone like "team.example.com" is preferred over the
+// Public Suffix List's eTLD+1 "example.com"); otherwise it falls back to
+// the eTLD+1. subdomain is "" for an apex record, matching the FQDN
+// construction BuildCNAMERecord and the ownership helpers expect.
+func SplitHostnameZone(hostname string, candidateZones []string) (zone, subdomain string) {
+	hostname = strings.TrimSuffix(hostname, ".")
+
+	if best := longestMatchingZone(hostname, candidateZones); best != "" {
+		return best, trimZoneSuffix(hostname, best)
+	}
+
+	etld1, err := publicsuffix.EffectiveTLDPlusOne(hostname)
+	if err != nil {
+		// Not a recognized public suffix (a bare single-label host, or an
+		// internal-only TLD publicsuffix.org doesn't know about) - fall
+		// back to the previous last-two-labels heuristic rather than
+		// erroring.
+		zone = lastTwoLabels(hostname)
+		return zone, trimZoneSuffix(hostname, zone)
+	}
+
+	return etld1, trimZoneSuffix(hostname, etld1)
+}
+
+// longestMatchingZone returns the longest zone in candidateZones that
+// hostname is equal to or a subdomain of, or "" if none match.
+func longestMatchingZone(hostname string, candidateZones []string) string {
+	best := ""
+	for _, z := range candidateZones {
+		z = strings.TrimSuffix(z, ".")
+		if z == "" || (hostname != z && !strings.HasSuffix(hostname, "."+z)) {
+			continue
+		}
+		if len(z) > len(best) {
+			best = z
+		}
+	}
+	return best
+}
+
+// trimZoneSuffix removes zone and its leading "." from hostname, e.g.
+// ("app.example.com", "example.com") -> "app". Returns "" for an apex
+// record, where hostname == zone.
+func trimZoneSuffix(hostname, zone string) string {
+	if hostname == zone {
+		return ""
+	}
+	return strings.TrimSuffix(hostname, "."+zone)
+}
+
+// lastTwoLabels returns hostname's last two dot-separated labels, the
+// heuristic ExtractZoneFromHostname used before it became Public Suffix
+// List-aware. Kept as the fallback for hostnames under a TLD the list
+// doesn't recognize.
+func lastTwoLabels(hostname string) string {
 	parts := strings.Split(hostname, ".")
 	if len(parts) < 2 {
 		return hostname
 	}
-
-	// Return last two parts (domain.tld)
-	// This is a simple heuristic that works for most cases
-	// For complex TLDs like .co.uk, this would need enhancement
 	return strings.Join(parts[len(parts)-2:], ".")
 }
 
+// zoneCacheEntry caches one ResolveZoneForHostname result.
+type zoneCacheEntry struct {
+	zone      *Zone
+	subdomain string
+}
+
+// ResolveZoneForHostname resolves hostname to the Cloudflare Zone it
+// belongs to and the remaining subdomain label(s) within that zone. It
+// picks the longest of candidateZones hostname falls under (typically a
+// CloudflareDNSSync's spec.zones or a CloudflareManagedZone's name),
+// falling back to the Public Suffix List eTLD+1 if none match, then
+// verifies the chosen zone is actually visible to the API token via
+// GetZoneByName. Returns a nil Zone, not an error, if the zone name isn't
+// visible to this token. Results are cached per hostname+candidateZones
+// combination so repeated calls (e.g. once per reconcile) don't re-hit the
+// Cloudflare API for the same hostname.
+func (s *DNSService) ResolveZoneForHostname(ctx context.Context, hostname string, candidateZones []string) (*Zone, string, error) {
+	key := hostname + "|" + strings.Join(candidateZones, ",")
+
+	s.zoneCacheMu.RLock()
+	entry, ok := s.zoneCache[key]
+	s.zoneCacheMu.RUnlock()
+	if ok {
+		return entry.zone, entry.subdomain, nil
+	}
+
+	zoneName, subdomain := SplitHostnameZone(hostname, candidateZones)
+	zone, err := s.client.GetZoneByName(ctx, zoneName)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to resolve zone %q for hostname %q: %w", zoneName, hostname, err)
+	}
+
+	s.zoneCacheMu.Lock()
+	if s.zoneCache == nil {
+		s.zoneCache = make(map[string]zoneCacheEntry)
+	}
+	s.zoneCache[key] = zoneCacheEntry{zone: zone, subdomain: subdomain}
+	s.zoneCacheMu.Unlock()
+
+	return zone, subdomain, nil
+}
+
 // BuildCNAMERecord builds a CNAME record for a tunnel.
 func BuildCNAMERecord(hostname, tunnelDomain string, proxied bool, comment string) DNSRecord {
 	return DNSRecord{
@@ -197,19 +526,53 @@ func BuildCNAMERecord(hostname, tunnelDomain string, proxied bool, comment strin
 	}
 }
 
-// BuildOwnershipTXTRecord builds a TXT record for ownership tracking.
-func BuildOwnershipTXTRecord(hostname, tunnelName, prefix string) DNSRecord {
+// OwnershipTXTName returns the name of the TXT record that tracks ownership
+// of hostname's CNAME record, e.g. "_cfgate-app.example.com".
+func OwnershipTXTName(hostname, prefix string) string {
+	return fmt.Sprintf("%s-%s", prefix, hostname)
+}
+
+// OwnerIdentity builds the stable identity string a CloudflareDNSSync writes
+// into its ownership TXT record, mirroring external-dns's TXT registry:
+// "heritage=cfgate,owner=<namespaced-name-or-override>,tunnel=<tunnelName>".
+func OwnerIdentity(owner, tunnelName string) string {
+	return fmt.Sprintf("heritage=cfgate,owner=%s,tunnel=%s", owner, tunnelName)
+}
+
+// ParseOwnerIdentity extracts the "owner" field from a TXT ownership
+// record's content. Returns ok=false if content isn't a cfgate identity.
+func ParseOwnerIdentity(content string) (owner string, ok bool) {
+	if !strings.Contains(content, "heritage=cfgate") {
+		return "", false
+	}
+
+	for _, part := range strings.Split(content, ",") {
+		key, value, found := strings.Cut(part, "=")
+		if found && key == "owner" {
+			return value, true
+		}
+	}
+
+	return "", false
+}
+
+// BuildOwnershipTXTRecord builds the TXT record that tracks ownership of
+// hostname, with ownerIdentity as its content (see OwnerIdentity).
+func BuildOwnershipTXTRecord(hostname, ownerIdentity, prefix string) DNSRecord {
 	return DNSRecord{
 		Type:    "TXT",
-		Name:    fmt.Sprintf("%s.%s", prefix, hostname),
-		Content: fmt.Sprintf("managed by cfgate, tunnel=%s", tunnelName),
+		Name:    OwnershipTXTName(hostname, prefix),
+		Content: ownerIdentity,
 		TTL:     1, // Auto TTL
 		Proxied: false,
 		Comment: "cfgate ownership record",
 	}
 }
 
-// IsOwnedByCfgate checks if a DNS record is managed by cfgate.
+// IsOwnedByCfgate checks if a DNS record is managed by cfgate via its
+// comment, per CommentOwnership. This is the fallback ownership check used
+// when TXT ownership tracking is disabled; it does not distinguish between
+// different cfgate instances the way CheckOwnership's TXT-based check does.
 func IsOwnedByCfgate(record *DNSRecord, ownershipPrefix, tunnelName string) bool {
 	// Check comment
 	if strings.Contains(record.Comment, "managed by cfgate") {
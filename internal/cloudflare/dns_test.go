@@ -0,0 +1,231 @@
+package cloudflare_test
+
+import (
+	"context"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+
+	"cfgate.io/cfgate/internal/cloudflare"
+	"cfgate.io/cfgate/internal/cloudflare/mocks"
+)
+
+func TestCheckOwnership_TXTEnabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	api := mocks.NewMockCloudflareAPI(ctrl)
+	svc := cloudflare.NewDNSService(api)
+
+	txtName := cloudflare.OwnershipTXTName("app.example.com", "_cfgate")
+	ownerIdentity := cloudflare.OwnerIdentity("default/my-sync", "my-tunnel")
+
+	api.EXPECT().ListDNSRecords(gomock.Any(), "zone-1").Return([]cloudflare.DNSRecord{
+		{Name: txtName, Type: "TXT", Content: ownerIdentity},
+	}, nil)
+
+	record := &cloudflare.DNSRecord{Name: "app.example.com", Type: "CNAME"}
+	owned, err := svc.CheckOwnership(context.Background(), "zone-1", "app.example.com", "_cfgate", "default/my-sync", true, record)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !owned {
+		t.Fatal("expected record to be owned by default/my-sync")
+	}
+}
+
+func TestCheckOwnership_TXTEnabled_DifferentOwner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	api := mocks.NewMockCloudflareAPI(ctrl)
+	svc := cloudflare.NewDNSService(api)
+
+	txtName := cloudflare.OwnershipTXTName("app.example.com", "_cfgate")
+	otherOwnerIdentity := cloudflare.OwnerIdentity("other-ns/other-sync", "my-tunnel")
+
+	api.EXPECT().ListDNSRecords(gomock.Any(), "zone-1").Return([]cloudflare.DNSRecord{
+		{Name: txtName, Type: "TXT", Content: otherOwnerIdentity},
+	}, nil)
+
+	record := &cloudflare.DNSRecord{Name: "app.example.com", Type: "CNAME"}
+	owned, err := svc.CheckOwnership(context.Background(), "zone-1", "app.example.com", "_cfgate", "default/my-sync", true, record)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if owned {
+		t.Fatal("expected record owned by a different instance to not be owned")
+	}
+}
+
+func TestCheckOwnership_TXTDisabled_CommentFallback(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	// No API calls expected: the comment fallback never consults Cloudflare.
+	api := mocks.NewMockCloudflareAPI(ctrl)
+	svc := cloudflare.NewDNSService(api)
+
+	record := &cloudflare.DNSRecord{Comment: "managed by cfgate, tunnel=my-tunnel"}
+	owned, err := svc.CheckOwnership(context.Background(), "zone-1", "app.example.com", "_cfgate", "default/my-sync", false, record)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !owned {
+		t.Fatal("expected comment-based ownership to be recognized")
+	}
+}
+
+func TestSyncRecord_OwnershipConflict(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	api := mocks.NewMockCloudflareAPI(ctrl)
+	svc := cloudflare.NewDNSService(api)
+
+	existing := cloudflare.DNSRecord{ID: "rec-1", Name: "app.example.com", Type: "CNAME", Content: "old.example.com"}
+	txtName := cloudflare.OwnershipTXTName("app.example.com", "_cfgate")
+	otherOwnerIdentity := cloudflare.OwnerIdentity("other-ns/other-sync", "my-tunnel")
+
+	findExisting := api.EXPECT().ListDNSRecords(gomock.Any(), "zone-1").Return([]cloudflare.DNSRecord{existing}, nil)
+	findOwnership := api.EXPECT().ListDNSRecords(gomock.Any(), "zone-1").Return([]cloudflare.DNSRecord{
+		{Name: txtName, Type: "TXT", Content: otherOwnerIdentity},
+	}, nil)
+	gomock.InOrder(findExisting, findOwnership)
+
+	desired := cloudflare.BuildCNAMERecord("app.example.com", "tunnel.cfargotunnel.com", true, "managed by cfgate, tunnel=my-tunnel")
+	_, modified, conflict, err := svc.SyncRecord(context.Background(), "zone-1", desired, "default/my-sync", "_cfgate", true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !conflict {
+		t.Fatal("expected a conflict against a record owned by another instance")
+	}
+	if modified {
+		t.Fatal("a conflicting record must not be modified")
+	}
+}
+
+func TestSplitHostnameZone_PublicSuffix(t *testing.T) {
+	cases := []struct {
+		hostname      string
+		wantZone      string
+		wantSubdomain string
+	}{
+		{"app.example.com", "example.com", "app"},
+		{"example.com", "example.com", ""},
+		{"app.example.co.uk", "example.co.uk", "app"},
+		{"deep.app.example.co.uk", "example.co.uk", "deep.app"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.hostname, func(t *testing.T) {
+			zone, subdomain := cloudflare.SplitHostnameZone(tc.hostname, nil)
+			if zone != tc.wantZone || subdomain != tc.wantSubdomain {
+				t.Fatalf("SplitHostnameZone(%q, nil) = (%q, %q), want (%q, %q)",
+					tc.hostname, zone, subdomain, tc.wantZone, tc.wantSubdomain)
+			}
+		})
+	}
+}
+
+func TestSplitHostnameZone_CandidateZonePreferred(t *testing.T) {
+	// "team.example.com" is itself the Cloudflare zone, not publicsuffix's
+	// eTLD+1 "example.com" - the longest matching candidate must win.
+	zone, subdomain := cloudflare.SplitHostnameZone("svc.team.example.com", []string{"example.com", "team.example.com"})
+	if zone != "team.example.com" {
+		t.Fatalf("expected the longer candidate zone to win, got %q", zone)
+	}
+	if subdomain != "svc" {
+		t.Fatalf("expected subdomain %q, got %q", "svc", subdomain)
+	}
+}
+
+func TestExtractZoneFromHostname_ComplexTLD(t *testing.T) {
+	if got := cloudflare.ExtractZoneFromHostname("app.example.co.uk"); got != "example.co.uk" {
+		t.Fatalf("ExtractZoneFromHostname(%q) = %q, want %q", "app.example.co.uk", got, "example.co.uk")
+	}
+}
+
+func TestGetOwnerIdentity_SignedRecord(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	api := mocks.NewMockCloudflareAPI(ctrl)
+	svc := cloudflare.NewDNSServiceWithSigningKey(api, []byte("shared-secret"), false)
+
+	signed, err := svc.BuildOwnerIdentity("default/my-sync", "my-tunnel")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	txtName := cloudflare.OwnershipTXTName("app.example.com", "_cfgate")
+	api.EXPECT().ListDNSRecords(gomock.Any(), "zone-1").Return([]cloudflare.DNSRecord{
+		{Name: txtName, Type: "TXT", Content: signed},
+	}, nil)
+
+	owner, ok, err := svc.GetOwnerIdentity(context.Background(), "zone-1", "app.example.com", "_cfgate")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !ok || owner != "default/my-sync" {
+		t.Fatalf("GetOwnerIdentity() = (%q, %v), want (%q, true)", owner, ok, "default/my-sync")
+	}
+}
+
+func TestGetOwnerIdentity_ForgedSignatureRejected(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	api := mocks.NewMockCloudflareAPI(ctrl)
+	svc := cloudflare.NewDNSServiceWithSigningKey(api, []byte("shared-secret"), false)
+
+	forged, err := cloudflare.BuildSignedOwnerIdentity([]byte("different-secret"), "attacker/fake-sync", "my-tunnel")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	txtName := cloudflare.OwnershipTXTName("app.example.com", "_cfgate")
+	api.EXPECT().ListDNSRecords(gomock.Any(), "zone-1").Return([]cloudflare.DNSRecord{
+		{Name: txtName, Type: "TXT", Content: forged},
+	}, nil)
+
+	_, ok, err := svc.GetOwnerIdentity(context.Background(), "zone-1", "app.example.com", "_cfgate")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ok {
+		t.Fatal("expected a record signed under a different key to be rejected")
+	}
+}
+
+func TestGetOwnerIdentity_LegacyMarkerAcceptedBehindFeatureGate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	api := mocks.NewMockCloudflareAPI(ctrl)
+	svc := cloudflare.NewDNSServiceWithSigningKey(api, []byte("shared-secret"), true)
+
+	legacyIdentity := cloudflare.OwnerIdentity("default/my-sync", "my-tunnel")
+	txtName := cloudflare.OwnershipTXTName("app.example.com", "_cfgate")
+	api.EXPECT().ListDNSRecords(gomock.Any(), "zone-1").Return([]cloudflare.DNSRecord{
+		{Name: txtName, Type: "TXT", Content: legacyIdentity},
+	}, nil)
+
+	owner, ok, err := svc.GetOwnerIdentity(context.Background(), "zone-1", "app.example.com", "_cfgate")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !ok || owner != "default/my-sync" {
+		t.Fatalf("GetOwnerIdentity() = (%q, %v), want (%q, true)", owner, ok, "default/my-sync")
+	}
+}
+
+func TestResolveZoneForHostname_CachesLookup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	api := mocks.NewMockCloudflareAPI(ctrl)
+	svc := cloudflare.NewDNSService(api)
+
+	zone := &cloudflare.Zone{ID: "zone-1", Name: "example.com"}
+	// Only one GetZoneByName call is expected even though ResolveZoneForHostname
+	// is called twice for the same hostname.
+	api.EXPECT().GetZoneByName(gomock.Any(), "example.com").Return(zone, nil).Times(1)
+
+	for i := 0; i < 2; i++ {
+		got, subdomain, err := svc.ResolveZoneForHostname(context.Background(), "app.example.com", nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != zone {
+			t.Fatalf("expected the resolved zone to be returned, got %+v", got)
+		}
+		if subdomain != "app" {
+			t.Fatalf("expected subdomain %q, got %q", "app", subdomain)
+		}
+	}
+}
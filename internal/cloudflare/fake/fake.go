@@ -0,0 +1,467 @@
+// Package fake provides an in-memory implementation of cloudflare.Client for
+// hermetic controller tests that don't want to hit the live Cloudflare API.
+package fake
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"cfgate.io/cfgate/internal/cloudflare"
+)
+
+// ErrInvalidToken is returned by any method when the fake is configured with
+// InvalidToken, simulating an authentication failure.
+var ErrInvalidToken = fmt.Errorf("cloudflare: invalid API token")
+
+// ErrRateLimited is returned by any method while RateLimited is set,
+// simulating a 429 response.
+var ErrRateLimited = fmt.Errorf("cloudflare: rate limited")
+
+// notFoundError formats like the real client's not-found errors so
+// cloudflare.IsNotFoundError recognizes it.
+func notFoundError(kind, id string) error {
+	return fmt.Errorf("%s %s: code: 10007: not found", kind, id)
+}
+
+// Client is an in-memory cloudflare.Client, storing every resource kind in
+// its own map keyed by ID. It's safe for concurrent use.
+type Client struct {
+	mu sync.Mutex
+
+	// InvalidToken makes every method return ErrInvalidToken, simulating a
+	// bad API token.
+	InvalidToken bool
+
+	// RateLimited makes every method return ErrRateLimited once, simulating
+	// a 429, then clears itself so the next call succeeds.
+	RateLimited bool
+
+	zones        map[string]cloudflare.Zone
+	dnsRecords   map[string]map[string]cloudflare.DNSRecord // zoneID -> recordID -> record
+	tunnels      map[string]cloudflare.Tunnel
+	tunnelConfig map[string]cloudflare.TunnelIngressConfig
+	vnets        map[string]cloudflare.VirtualNetwork
+	routes       map[string]cloudflare.IPRoute
+	connections  map[string][]cloudflare.Connection // tunnelID -> connections
+
+	nextID int
+}
+
+// New creates an empty fake Client.
+func New() *Client {
+	return &Client{
+		zones:        make(map[string]cloudflare.Zone),
+		dnsRecords:   make(map[string]map[string]cloudflare.DNSRecord),
+		tunnels:      make(map[string]cloudflare.Tunnel),
+		tunnelConfig: make(map[string]cloudflare.TunnelIngressConfig),
+		vnets:        make(map[string]cloudflare.VirtualNetwork),
+		routes:       make(map[string]cloudflare.IPRoute),
+		connections:  make(map[string][]cloudflare.Connection),
+	}
+}
+
+// SeedZone registers a zone so GetZoneByName can resolve it.
+func (c *Client) SeedZone(zone cloudflare.Zone) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.zones[zone.Name] = zone
+}
+
+// SeedTunnelConnections sets the connection inventory ListTunnelConnections
+// returns for tunnelID.
+func (c *Client) SeedTunnelConnections(tunnelID string, connections []cloudflare.Connection) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.connections[tunnelID] = connections
+}
+
+// checkFailureInjection returns a simulated error if the fake is configured
+// to fail, clearing any one-shot failure mode it triggers.
+func (c *Client) checkFailureInjection() error {
+	if c.InvalidToken {
+		return ErrInvalidToken
+	}
+	if c.RateLimited {
+		c.RateLimited = false
+		return ErrRateLimited
+	}
+	return nil
+}
+
+func (c *Client) genID(prefix string) string {
+	c.nextID++
+	return fmt.Sprintf("%s-%d", prefix, c.nextID)
+}
+
+// GetZoneByName resolves a zone name to a Zone. Returns nil if not found.
+func (c *Client) GetZoneByName(ctx context.Context, name string) (*cloudflare.Zone, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.checkFailureInjection(); err != nil {
+		return nil, err
+	}
+	zone, ok := c.zones[name]
+	if !ok {
+		return nil, nil
+	}
+	return &zone, nil
+}
+
+// ListDNSRecords lists all DNS records in a zone.
+func (c *Client) ListDNSRecords(ctx context.Context, zoneID string) ([]cloudflare.DNSRecord, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.checkFailureInjection(); err != nil {
+		return nil, err
+	}
+	var records []cloudflare.DNSRecord
+	for _, record := range c.dnsRecords[zoneID] {
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// CreateDNSRecord creates a DNS record in a zone.
+func (c *Client) CreateDNSRecord(ctx context.Context, zoneID string, record cloudflare.DNSRecord) (*cloudflare.DNSRecord, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.checkFailureInjection(); err != nil {
+		return nil, err
+	}
+	record.ID = c.genID("rec")
+	if c.dnsRecords[zoneID] == nil {
+		c.dnsRecords[zoneID] = make(map[string]cloudflare.DNSRecord)
+	}
+	c.dnsRecords[zoneID][record.ID] = record
+	return &record, nil
+}
+
+// UpdateDNSRecord updates an existing DNS record.
+func (c *Client) UpdateDNSRecord(ctx context.Context, zoneID, recordID string, record cloudflare.DNSRecord) (*cloudflare.DNSRecord, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.checkFailureInjection(); err != nil {
+		return nil, err
+	}
+	if _, ok := c.dnsRecords[zoneID][recordID]; !ok {
+		return nil, notFoundError("DNS record", recordID)
+	}
+	record.ID = recordID
+	c.dnsRecords[zoneID][recordID] = record
+	return &record, nil
+}
+
+// DeleteDNSRecord deletes a DNS record by ID.
+func (c *Client) DeleteDNSRecord(ctx context.Context, zoneID, recordID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.checkFailureInjection(); err != nil {
+		return err
+	}
+	if _, ok := c.dnsRecords[zoneID][recordID]; !ok {
+		return notFoundError("DNS record", recordID)
+	}
+	delete(c.dnsRecords[zoneID], recordID)
+	return nil
+}
+
+// CreateTunnel creates a Cloudflare Tunnel.
+func (c *Client) CreateTunnel(ctx context.Context, accountID, name string) (*cloudflare.Tunnel, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.checkFailureInjection(); err != nil {
+		return nil, err
+	}
+	tunnel := cloudflare.Tunnel{
+		ID:     c.genID("tunnel"),
+		Name:   name,
+		Domain: fmt.Sprintf("%s.cfargotunnel.com", c.genID("tunnel")),
+	}
+	c.tunnels[tunnel.ID] = tunnel
+	return &tunnel, nil
+}
+
+// GetTunnel fetches a tunnel by ID. Returns nil if not found.
+func (c *Client) GetTunnel(ctx context.Context, accountID, tunnelID string) (*cloudflare.Tunnel, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.checkFailureInjection(); err != nil {
+		return nil, err
+	}
+	tunnel, ok := c.tunnels[tunnelID]
+	if !ok {
+		return nil, nil
+	}
+	return &tunnel, nil
+}
+
+// ListTunnels lists an account's tunnels.
+func (c *Client) ListTunnels(ctx context.Context, accountID string) ([]cloudflare.Tunnel, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.checkFailureInjection(); err != nil {
+		return nil, err
+	}
+	var tunnels []cloudflare.Tunnel
+	for _, tunnel := range c.tunnels {
+		tunnels = append(tunnels, tunnel)
+	}
+	return tunnels, nil
+}
+
+// DeleteTunnel deletes a tunnel by ID.
+func (c *Client) DeleteTunnel(ctx context.Context, accountID, tunnelID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.checkFailureInjection(); err != nil {
+		return err
+	}
+	if _, ok := c.tunnels[tunnelID]; !ok {
+		return notFoundError("tunnel", tunnelID)
+	}
+	delete(c.tunnels, tunnelID)
+	delete(c.tunnelConfig, tunnelID)
+	return nil
+}
+
+// GetTunnelConfiguration fetches a tunnel's ingress configuration.
+func (c *Client) GetTunnelConfiguration(ctx context.Context, tunnelID string) (*cloudflare.TunnelIngressConfig, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.checkFailureInjection(); err != nil {
+		return nil, err
+	}
+	config, ok := c.tunnelConfig[tunnelID]
+	if !ok {
+		return &cloudflare.TunnelIngressConfig{}, nil
+	}
+	return &config, nil
+}
+
+// UpdateTunnelConfiguration replaces a tunnel's ingress configuration.
+func (c *Client) UpdateTunnelConfiguration(ctx context.Context, tunnelID string, config cloudflare.TunnelIngressConfig) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.checkFailureInjection(); err != nil {
+		return err
+	}
+	c.tunnelConfig[tunnelID] = config
+	return nil
+}
+
+// CleanupTunnelConnections tears down a tunnel's active connections. The
+// fake has no notion of live connectors, so this is a no-op once the tunnel
+// is known.
+func (c *Client) CleanupTunnelConnections(ctx context.Context, accountID, tunnelID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.checkFailureInjection(); err != nil {
+		return err
+	}
+	if _, ok := c.tunnels[tunnelID]; !ok {
+		return notFoundError("tunnel", tunnelID)
+	}
+	return nil
+}
+
+// ListTunnelConnections fetches a tunnel's connection inventory, as seeded
+// via SeedTunnelConnections.
+func (c *Client) ListTunnelConnections(ctx context.Context, accountID, tunnelID string) ([]cloudflare.Connection, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.checkFailureInjection(); err != nil {
+		return nil, err
+	}
+	if _, ok := c.tunnels[tunnelID]; !ok {
+		return nil, notFoundError("tunnel", tunnelID)
+	}
+	return c.connections[tunnelID], nil
+}
+
+// ListVirtualNetworks lists an account's virtual networks.
+func (c *Client) ListVirtualNetworks(ctx context.Context, accountID string) ([]cloudflare.VirtualNetwork, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.checkFailureInjection(); err != nil {
+		return nil, err
+	}
+	var vnets []cloudflare.VirtualNetwork
+	for _, vnet := range c.vnets {
+		vnets = append(vnets, vnet)
+	}
+	return vnets, nil
+}
+
+// CreateVirtualNetwork creates a virtual network.
+func (c *Client) CreateVirtualNetwork(ctx context.Context, accountID string, vnet cloudflare.VirtualNetwork) (*cloudflare.VirtualNetwork, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.checkFailureInjection(); err != nil {
+		return nil, err
+	}
+	vnet.ID = c.genID("vnet")
+	c.vnets[vnet.ID] = vnet
+	return &vnet, nil
+}
+
+// UpdateVirtualNetwork updates an existing virtual network.
+func (c *Client) UpdateVirtualNetwork(ctx context.Context, accountID, vnetID string, vnet cloudflare.VirtualNetwork) (*cloudflare.VirtualNetwork, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.checkFailureInjection(); err != nil {
+		return nil, err
+	}
+	if _, ok := c.vnets[vnetID]; !ok {
+		return nil, notFoundError("virtual network", vnetID)
+	}
+	vnet.ID = vnetID
+	c.vnets[vnetID] = vnet
+	return &vnet, nil
+}
+
+// DeleteVirtualNetwork deletes a virtual network by ID.
+func (c *Client) DeleteVirtualNetwork(ctx context.Context, accountID, vnetID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.checkFailureInjection(); err != nil {
+		return err
+	}
+	if _, ok := c.vnets[vnetID]; !ok {
+		return notFoundError("virtual network", vnetID)
+	}
+	delete(c.vnets, vnetID)
+	return nil
+}
+
+// ListIPRoutes lists an account's Teamnet IP routes.
+func (c *Client) ListIPRoutes(ctx context.Context, accountID string) ([]cloudflare.IPRoute, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.checkFailureInjection(); err != nil {
+		return nil, err
+	}
+	var routes []cloudflare.IPRoute
+	for _, route := range c.routes {
+		routes = append(routes, route)
+	}
+	return routes, nil
+}
+
+// CreateIPRoute creates a Teamnet IP route.
+func (c *Client) CreateIPRoute(ctx context.Context, accountID string, route cloudflare.IPRoute) (*cloudflare.IPRoute, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.checkFailureInjection(); err != nil {
+		return nil, err
+	}
+	route.ID = c.genID("route")
+	c.routes[route.ID] = route
+	return &route, nil
+}
+
+// DeleteIPRoute deletes a Teamnet IP route by ID.
+func (c *Client) DeleteIPRoute(ctx context.Context, accountID, routeID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.checkFailureInjection(); err != nil {
+		return err
+	}
+	if _, ok := c.routes[routeID]; !ok {
+		return notFoundError("IP route", routeID)
+	}
+	delete(c.routes, routeID)
+	return nil
+}
+
+// The remaining Client methods (redirects, WAF lists, health checks, load
+// balancers) have no controller in this tree driving them through tests yet;
+// they're stubbed to return "not implemented" rather than silently
+// succeeding, so a test that exercises them fails loudly instead of passing
+// on fake data nobody asked for.
+
+func (c *Client) GetDynamicRedirectRuleset(ctx context.Context, zoneID string) (*cloudflare.RedirectRuleset, error) {
+	return nil, fmt.Errorf("fake: GetDynamicRedirectRuleset not implemented")
+}
+
+func (c *Client) UpdateDynamicRedirectRuleset(ctx context.Context, zoneID string, rules []cloudflare.RedirectRule) error {
+	return fmt.Errorf("fake: UpdateDynamicRedirectRuleset not implemented")
+}
+
+func (c *Client) ListWAFLists(ctx context.Context, accountID string) ([]cloudflare.WAFList, error) {
+	return nil, fmt.Errorf("fake: ListWAFLists not implemented")
+}
+
+func (c *Client) CreateWAFList(ctx context.Context, accountID string, list cloudflare.WAFList) (*cloudflare.WAFList, error) {
+	return nil, fmt.Errorf("fake: CreateWAFList not implemented")
+}
+
+func (c *Client) ListWAFListItems(ctx context.Context, accountID, listID string) ([]cloudflare.WAFListItem, error) {
+	return nil, fmt.Errorf("fake: ListWAFListItems not implemented")
+}
+
+func (c *Client) CreateWAFListItems(ctx context.Context, accountID, listID string, items []cloudflare.WAFListItem) error {
+	return fmt.Errorf("fake: CreateWAFListItems not implemented")
+}
+
+func (c *Client) DeleteWAFListItems(ctx context.Context, accountID, listID string, itemIDs []string) error {
+	return fmt.Errorf("fake: DeleteWAFListItems not implemented")
+}
+
+func (c *Client) ListHealthChecks(ctx context.Context, zoneID string) ([]cloudflare.HealthCheck, error) {
+	return nil, fmt.Errorf("fake: ListHealthChecks not implemented")
+}
+
+func (c *Client) CreateHealthCheck(ctx context.Context, zoneID string, check cloudflare.HealthCheck) (*cloudflare.HealthCheck, error) {
+	return nil, fmt.Errorf("fake: CreateHealthCheck not implemented")
+}
+
+func (c *Client) UpdateHealthCheck(ctx context.Context, zoneID, healthCheckID string, check cloudflare.HealthCheck) (*cloudflare.HealthCheck, error) {
+	return nil, fmt.Errorf("fake: UpdateHealthCheck not implemented")
+}
+
+func (c *Client) DeleteHealthCheck(ctx context.Context, zoneID, healthCheckID string) error {
+	return fmt.Errorf("fake: DeleteHealthCheck not implemented")
+}
+
+func (c *Client) GetHealthCheckStatus(ctx context.Context, zoneID, healthCheckID string) (*cloudflare.HealthCheckStatus, error) {
+	return nil, fmt.Errorf("fake: GetHealthCheckStatus not implemented")
+}
+
+func (c *Client) ListLoadBalancerPools(ctx context.Context, accountID string) ([]cloudflare.LoadBalancerPool, error) {
+	return nil, fmt.Errorf("fake: ListLoadBalancerPools not implemented")
+}
+
+func (c *Client) CreateLoadBalancerPool(ctx context.Context, accountID string, pool cloudflare.LoadBalancerPool) (*cloudflare.LoadBalancerPool, error) {
+	return nil, fmt.Errorf("fake: CreateLoadBalancerPool not implemented")
+}
+
+func (c *Client) UpdateLoadBalancerPool(ctx context.Context, accountID, poolID string, pool cloudflare.LoadBalancerPool) (*cloudflare.LoadBalancerPool, error) {
+	return nil, fmt.Errorf("fake: UpdateLoadBalancerPool not implemented")
+}
+
+func (c *Client) DeleteLoadBalancerPool(ctx context.Context, accountID, poolID string) error {
+	return fmt.Errorf("fake: DeleteLoadBalancerPool not implemented")
+}
+
+func (c *Client) GetLoadBalancerPoolStatus(ctx context.Context, accountID, poolID string) (*cloudflare.PoolStatus, error) {
+	return nil, fmt.Errorf("fake: GetLoadBalancerPoolStatus not implemented")
+}
+
+func (c *Client) ListLoadBalancers(ctx context.Context, zoneID string) ([]cloudflare.LoadBalancer, error) {
+	return nil, fmt.Errorf("fake: ListLoadBalancers not implemented")
+}
+
+func (c *Client) CreateLoadBalancer(ctx context.Context, zoneID string, lb cloudflare.LoadBalancer) (*cloudflare.LoadBalancer, error) {
+	return nil, fmt.Errorf("fake: CreateLoadBalancer not implemented")
+}
+
+func (c *Client) UpdateLoadBalancer(ctx context.Context, zoneID, loadBalancerID string, lb cloudflare.LoadBalancer) (*cloudflare.LoadBalancer, error) {
+	return nil, fmt.Errorf("fake: UpdateLoadBalancer not implemented")
+}
+
+func (c *Client) DeleteLoadBalancer(ctx context.Context, zoneID, loadBalancerID string) error {
+	return fmt.Errorf("fake: DeleteLoadBalancer not implemented")
+}
+
+var _ cloudflare.Client = (*Client)(nil)
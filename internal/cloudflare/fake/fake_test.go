@@ -0,0 +1,81 @@
+package fake_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"cfgate.io/cfgate/internal/cloudflare"
+	"cfgate.io/cfgate/internal/cloudflare/fake"
+)
+
+func TestVirtualNetworkService_EnsureVirtualNetwork_CreatesThenAdopts(t *testing.T) {
+	client := fake.New()
+	svc := cloudflare.NewVirtualNetworkService(client)
+
+	created, err := svc.EnsureVirtualNetwork(context.Background(), "acct-1", cloudflare.VirtualNetwork{Name: "prod"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if created.ID == "" {
+		t.Fatal("expected a generated vnet ID")
+	}
+
+	adopted, err := svc.EnsureVirtualNetwork(context.Background(), "acct-1", cloudflare.VirtualNetwork{Name: "prod"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if adopted.ID != created.ID {
+		t.Fatalf("expected adoption to reuse ID %s, got %s", created.ID, adopted.ID)
+	}
+}
+
+func TestIPRouteService_EnsureRoute_IdentityIsNetworkAndVnet(t *testing.T) {
+	client := fake.New()
+	svc := cloudflare.NewIPRouteService(client)
+
+	first, err := svc.EnsureRoute(context.Background(), "acct-1", cloudflare.IPRoute{Network: "10.0.0.0/24", VnetID: "vnet-a"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Same network, different vnet: must not be adopted.
+	second, err := svc.EnsureRoute(context.Background(), "acct-1", cloudflare.IPRoute{Network: "10.0.0.0/24", VnetID: "vnet-b"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if second.ID == first.ID {
+		t.Fatal("expected routes scoped to different virtual networks to be distinct")
+	}
+
+	// Same (network, vnet) pair: must be adopted.
+	third, err := svc.EnsureRoute(context.Background(), "acct-1", cloudflare.IPRoute{Network: "10.0.0.0/24", VnetID: "vnet-a"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if third.ID != first.ID {
+		t.Fatalf("expected adoption to reuse ID %s, got %s", first.ID, third.ID)
+	}
+}
+
+func TestClient_InvalidToken(t *testing.T) {
+	client := fake.New()
+	client.InvalidToken = true
+
+	_, err := client.ListVirtualNetworks(context.Background(), "acct-1")
+	if !errors.Is(err, fake.ErrInvalidToken) {
+		t.Fatalf("expected ErrInvalidToken, got %v", err)
+	}
+}
+
+func TestClient_DeleteIPRoute_NotFound(t *testing.T) {
+	client := fake.New()
+
+	err := client.DeleteIPRoute(context.Background(), "acct-1", "missing-route")
+	if err == nil {
+		t.Fatal("expected an error for a non-existent route")
+	}
+	if !cloudflare.IsNotFoundError(err) {
+		t.Fatalf("expected a not-found error, got %v", err)
+	}
+}
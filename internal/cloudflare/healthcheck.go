@@ -0,0 +1,149 @@
+// Package cloudflare provides a wrapper around cloudflare-go for cfgate's needs.
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+)
+
+// HealthCheck represents a Cloudflare Standalone Health Check monitoring a
+// single hostname.
+type HealthCheck struct {
+	// ID is the Cloudflare health check ID, assigned on creation. Empty for
+	// a health check not yet created.
+	ID string
+
+	// Name identifies the health check. cfgate names these after the
+	// monitored hostname so EnsureHealthCheck can find an existing one.
+	Name string
+
+	// Address is the hostname or IP probed.
+	Address string
+
+	// Type is the probe protocol: HTTP, HTTPS, or TCP.
+	Type string
+
+	// Path is the HTTP(S) request path. Ignored for Type=TCP.
+	Path string
+
+	// Port is the port probed.
+	Port int32
+
+	// ExpectedCodes lists acceptable HTTP response code ranges (e.g.
+	// "2xx"). Ignored for Type=TCP.
+	ExpectedCodes []string
+
+	// IntervalSeconds is the time between probes.
+	IntervalSeconds int32
+
+	// ConsecutiveFailuresThreshold is the number of consecutive failed
+	// probes before Cloudflare reports the check unhealthy.
+	ConsecutiveFailuresThreshold int32
+
+	// AllowInsecure skips TLS certificate validation for Type=HTTPS.
+	AllowInsecure bool
+}
+
+// HealthCheckStatus is a health check's current, point-in-time result.
+type HealthCheckStatus struct {
+	// Healthy is the health check's current status.
+	Healthy bool
+
+	// ConsecutiveFailures is the number of consecutive failed probes
+	// Cloudflare has observed so far.
+	ConsecutiveFailures int32
+}
+
+// HealthCheckService manages Cloudflare Standalone Health Checks via the
+// Zone Health Checks API.
+type HealthCheckService struct {
+	// client is the underlying Cloudflare client.
+	client Client
+}
+
+// NewHealthCheckService creates a new HealthCheckService.
+func NewHealthCheckService(client Client) *HealthCheckService {
+	return &HealthCheckService{client: client}
+}
+
+// healthCheckName derives the stable Cloudflare health check name for
+// hostname, so EnsureHealthCheck can find a previously created check across
+// reconciles without persisting its ID anywhere but status.
+func healthCheckName(hostname string) string {
+	return fmt.Sprintf("cfgate-%s", hostname)
+}
+
+// EnsureHealthCheck finds the health check for desired.Address, creating it
+// if missing or updating it if its configuration has drifted from desired.
+func (s *HealthCheckService) EnsureHealthCheck(ctx context.Context, zoneID string, desired HealthCheck) (*HealthCheck, error) {
+	if desired.Name == "" {
+		desired.Name = healthCheckName(desired.Address)
+	}
+
+	checks, err := s.client.ListHealthChecks(ctx, zoneID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list health checks: %w", err)
+	}
+
+	for _, existing := range checks {
+		if existing.Name != desired.Name {
+			continue
+		}
+		if healthCheckConfigEqual(existing, desired) {
+			return &existing, nil
+		}
+		desired.ID = existing.ID
+		updated, err := s.client.UpdateHealthCheck(ctx, zoneID, existing.ID, desired)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update health check %s: %w", desired.Name, err)
+		}
+		return updated, nil
+	}
+
+	created, err := s.client.CreateHealthCheck(ctx, zoneID, desired)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create health check %s: %w", desired.Name, err)
+	}
+	return created, nil
+}
+
+// GetStatus fetches a health check's current health and consecutive-failure
+// count.
+func (s *HealthCheckService) GetStatus(ctx context.Context, zoneID, healthCheckID string) (*HealthCheckStatus, error) {
+	status, err := s.client.GetHealthCheckStatus(ctx, zoneID, healthCheckID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get health check status: %w", err)
+	}
+	return status, nil
+}
+
+// DeleteHealthCheck deletes a health check by ID.
+func (s *HealthCheckService) DeleteHealthCheck(ctx context.Context, zoneID, healthCheckID string) error {
+	if err := s.client.DeleteHealthCheck(ctx, zoneID, healthCheckID); err != nil {
+		return fmt.Errorf("failed to delete health check %s: %w", healthCheckID, err)
+	}
+	return nil
+}
+
+// healthCheckConfigEqual reports whether existing already matches desired's
+// configuration, so EnsureHealthCheck can skip a no-op update call.
+func healthCheckConfigEqual(existing HealthCheck, desired HealthCheck) bool {
+	if existing.Address != desired.Address ||
+		existing.Type != desired.Type ||
+		existing.Path != desired.Path ||
+		existing.Port != desired.Port ||
+		existing.IntervalSeconds != desired.IntervalSeconds ||
+		existing.ConsecutiveFailuresThreshold != desired.ConsecutiveFailuresThreshold ||
+		existing.AllowInsecure != desired.AllowInsecure {
+		return false
+	}
+	if len(existing.ExpectedCodes) != len(desired.ExpectedCodes) {
+		return false
+	}
+	for i, code := range existing.ExpectedCodes {
+		if desired.ExpectedCodes[i] != code {
+			return false
+		}
+	}
+	return true
+}
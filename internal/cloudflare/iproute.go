@@ -0,0 +1,128 @@
+// Package cloudflare provides a wrapper around cloudflare-go for cfgate's needs.
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+)
+
+// IPRoute represents a Cloudflare Teamnet route, advertising a private CIDR
+// to WARP clients through a tunnel, optionally scoped to a virtual network.
+type IPRoute struct {
+	ID       string
+	Network  string
+	TunnelID string
+	VnetID   string
+	Comment  string
+}
+
+// IPRouteService manages Cloudflare Teamnet routes (the
+// /accounts/{id}/teamnet/routes endpoints backing "cloudflared tunnel route ip").
+type IPRouteService struct {
+	// client is the underlying Cloudflare client.
+	client Client
+}
+
+// NewIPRouteService creates a new IPRouteService.
+func NewIPRouteService(client Client) *IPRouteService {
+	return &IPRouteService{client: client}
+}
+
+// EnsureRoute finds a route matching (Network, VnetID), adopting it if it
+// already exists, or creating it otherwise. A route is identified by the
+// (network, vnet) pair, not by tunnel, since Cloudflare only allows one
+// active route per CIDR per virtual network.
+func (s *IPRouteService) EnsureRoute(ctx context.Context, accountID string, desired IPRoute) (*IPRoute, error) {
+	routes, err := s.client.ListIPRoutes(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list IP routes: %w", err)
+	}
+
+	for _, route := range routes {
+		if route.Network == desired.Network && route.VnetID == desired.VnetID {
+			return &route, nil
+		}
+	}
+
+	created, err := s.client.CreateIPRoute(ctx, accountID, desired)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create IP route %s: %w", desired.Network, err)
+	}
+
+	return created, nil
+}
+
+// DeleteRoute deletes a Teamnet route by ID. A 404 is treated as
+// already-deleted.
+func (s *IPRouteService) DeleteRoute(ctx context.Context, accountID, routeID string) error {
+	err := s.client.DeleteIPRoute(ctx, accountID, routeID)
+	if err != nil && !IsNotFoundError(err) {
+		return fmt.Errorf("failed to delete IP route: %w", err)
+	}
+	return nil
+}
+
+// RouteReconcileResult summarizes a ReconcileRoutes pass.
+type RouteReconcileResult struct {
+	Created, Deleted int
+}
+
+// ReconcileRoutes ensures exactly the routes in desired exist for tunnelID,
+// scoped to vnetID ("" for the default virtual network): it lists
+// accountID's routes once, creates any desired (Network, VnetID) pair
+// missing from that list, and deletes any existing route that (a) belongs to
+// tunnelID and (b) is no longer desired. Routes belonging to other tunnels
+// are left untouched even if their CIDR collides with one no longer desired
+// here, since Cloudflare only allows one active route per CIDR per vnet and
+// such a collision is a conflict for the caller to surface, not silently
+// delete around. This is the batch counterpart to calling EnsureRoute once
+// per desired route, each of which re-lists routes on its own.
+func (s *IPRouteService) ReconcileRoutes(ctx context.Context, accountID, tunnelID, vnetID string, desired []IPRoute) (RouteReconcileResult, error) {
+	actual, err := s.client.ListIPRoutes(ctx, accountID)
+	if err != nil {
+		return RouteReconcileResult{}, fmt.Errorf("failed to list IP routes: %w", err)
+	}
+
+	actualByKey := make(map[string]IPRoute, len(actual))
+	for _, route := range actual {
+		actualByKey[ipRouteKey(route.Network, route.VnetID)] = route
+	}
+
+	desiredKeys := make(map[string]bool, len(desired))
+	var result RouteReconcileResult
+
+	for _, d := range desired {
+		key := ipRouteKey(d.Network, vnetID)
+		desiredKeys[key] = true
+
+		if _, ok := actualByKey[key]; ok {
+			continue // already exists, whoever owns it; creation is idempotent by (network, vnet)
+		}
+
+		d.TunnelID = tunnelID
+		d.VnetID = vnetID
+		if _, err := s.client.CreateIPRoute(ctx, accountID, d); err != nil {
+			return result, fmt.Errorf("failed to create IP route %s: %w", d.Network, err)
+		}
+		result.Created++
+	}
+
+	for key, route := range actualByKey {
+		if desiredKeys[key] || route.TunnelID != tunnelID || route.VnetID != vnetID {
+			continue
+		}
+		if err := s.DeleteRoute(ctx, accountID, route.ID); err != nil {
+			return result, fmt.Errorf("failed to delete stale IP route %s: %w", route.Network, err)
+		}
+		result.Deleted++
+	}
+
+	return result, nil
+}
+
+// ipRouteKey is the (Network, VnetID) identity ReconcileRoutes diffs routes
+// by, matching Cloudflare's uniqueness constraint of one active route per
+// CIDR per virtual network.
+func ipRouteKey(network, vnetID string) string {
+	return network + "|" + vnetID
+}
@@ -0,0 +1,80 @@
+package cloudflare_test
+
+import (
+	"context"
+	"testing"
+
+	"cfgate.io/cfgate/internal/cloudflare"
+)
+
+// fakeIPRouteClient embeds a nil cloudflare.Client and implements only the
+// IP route methods ReconcileRoutes needs, keeping the test hermetic without
+// a generated mock for the full Client surface.
+type fakeIPRouteClient struct {
+	cloudflare.Client
+
+	routes  []cloudflare.IPRoute
+	created []cloudflare.IPRoute
+	deleted []string
+}
+
+func (f *fakeIPRouteClient) ListIPRoutes(ctx context.Context, accountID string) ([]cloudflare.IPRoute, error) {
+	return f.routes, nil
+}
+
+func (f *fakeIPRouteClient) CreateIPRoute(ctx context.Context, accountID string, route cloudflare.IPRoute) (*cloudflare.IPRoute, error) {
+	f.created = append(f.created, route)
+	return &route, nil
+}
+
+func (f *fakeIPRouteClient) DeleteIPRoute(ctx context.Context, accountID, routeID string) error {
+	f.deleted = append(f.deleted, routeID)
+	return nil
+}
+
+func TestReconcileRoutes_LeavesOtherVnetsOnSameTunnelAlone(t *testing.T) {
+	client := &fakeIPRouteClient{
+		routes: []cloudflare.IPRoute{
+			{ID: "route-a", Network: "10.0.0.0/24", TunnelID: "tunnel-1", VnetID: "vnet-a"},
+			{ID: "route-b", Network: "10.0.1.0/24", TunnelID: "tunnel-1", VnetID: "vnet-b"},
+		},
+	}
+	svc := cloudflare.NewIPRouteService(client)
+
+	// Reconciling vnet-a's routes with nothing desired should only ever
+	// touch vnet-a's route, even though route-b shares the same tunnel.
+	result, err := svc.ReconcileRoutes(context.Background(), "account-1", "tunnel-1", "vnet-a", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Deleted != 1 {
+		t.Fatalf("expected exactly 1 route deleted, got %d", result.Deleted)
+	}
+	if len(client.deleted) != 1 || client.deleted[0] != "route-a" {
+		t.Fatalf("expected only route-a to be deleted, got %v", client.deleted)
+	}
+}
+
+func TestReconcileRoutes_CreatesMissingAndKeepsDesired(t *testing.T) {
+	client := &fakeIPRouteClient{
+		routes: []cloudflare.IPRoute{
+			{ID: "route-a", Network: "10.0.0.0/24", TunnelID: "tunnel-1", VnetID: "vnet-a"},
+		},
+	}
+	svc := cloudflare.NewIPRouteService(client)
+
+	desired := []cloudflare.IPRoute{
+		{Network: "10.0.0.0/24"},
+		{Network: "10.0.2.0/24"},
+	}
+	result, err := svc.ReconcileRoutes(context.Background(), "account-1", "tunnel-1", "vnet-a", desired)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Created != 1 || len(client.created) != 1 || client.created[0].Network != "10.0.2.0/24" {
+		t.Fatalf("expected only 10.0.2.0/24 to be created, got %+v", client.created)
+	}
+	if result.Deleted != 0 || len(client.deleted) != 0 {
+		t.Fatalf("expected nothing deleted, got %v", client.deleted)
+	}
+}
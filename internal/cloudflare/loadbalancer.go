@@ -0,0 +1,240 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+)
+
+// PoolOrigin is one origin within a Load Balancer pool.
+type PoolOrigin struct {
+	// Name identifies the origin within the pool. cfgate names these after
+	// the target address so EnsurePool can diff origins by name.
+	Name string
+
+	// Address is the origin's hostname or IP.
+	Address string
+
+	// Weight is this origin's share of traffic under weighted
+	// round-robin, normalized across the pool (e.g. 0.5 for an even
+	// two-origin split).
+	Weight float64
+
+	// Enabled controls whether Cloudflare includes this origin in
+	// rotation without removing it from the pool.
+	Enabled bool
+}
+
+// LoadBalancerPool is a named group of origins backing one hostname's
+// traffic-steering configuration.
+type LoadBalancerPool struct {
+	// ID is the Cloudflare pool ID, assigned on creation.
+	ID string
+
+	// Name identifies the pool. cfgate names these after the monitored
+	// hostname so EnsurePool can find an existing one.
+	Name string
+
+	// Origins lists the pool's member origins.
+	Origins []PoolOrigin
+}
+
+// LoadBalancer steers traffic for one hostname across one or more pools
+// according to SteeringPolicy.
+type LoadBalancer struct {
+	// ID is the Cloudflare load balancer ID, assigned on creation.
+	ID string
+
+	// Name is the hostname this load balancer answers for.
+	Name string
+
+	// DefaultPools lists pool IDs used when no region/pop override
+	// applies, in failover priority order.
+	DefaultPools []string
+
+	// SteeringPolicy selects how Cloudflare picks among DefaultPools:
+	// "off" for plain failover priority, "geo" to honor RegionPools, or
+	// "random"/"dynamic_latency" for weighted/latency-based steering.
+	SteeringPolicy string
+
+	// RegionPools maps a Cloudflare region code (e.g. WNAM, EEU) to the
+	// pool IDs serving it under the Geo steering policy.
+	RegionPools map[string][]string
+
+	// Proxied enables the Cloudflare proxy for this hostname.
+	Proxied bool
+}
+
+// PoolStatus is a pool's current, point-in-time health.
+type PoolStatus struct {
+	// Healthy is true if at least one enabled origin in the pool is
+	// healthy.
+	Healthy bool
+
+	// Origins reports per-origin health and current weight.
+	Origins []OriginStatus
+}
+
+// OriginStatus is one pool origin's current health.
+type OriginStatus struct {
+	// Address is the origin's hostname or IP.
+	Address string
+
+	// Healthy is the origin's current monitor status.
+	Healthy bool
+
+	// Weight is the origin's currently applied weight.
+	Weight float64
+}
+
+// LoadBalancerService manages Cloudflare Load Balancer pools and load
+// balancers via the Zone Load Balancing API.
+type LoadBalancerService struct {
+	// client is the underlying Cloudflare client.
+	client Client
+}
+
+// NewLoadBalancerService creates a new LoadBalancerService.
+func NewLoadBalancerService(client Client) *LoadBalancerService {
+	return &LoadBalancerService{client: client}
+}
+
+// poolName derives the stable Cloudflare pool name for hostname, so
+// EnsurePool can find a previously created pool across reconciles without
+// persisting its ID anywhere but status.
+func poolName(hostname string) string {
+	return fmt.Sprintf("cfgate-%s", hostname)
+}
+
+// EnsurePool finds the pool backing hostname, creating it if missing or
+// updating it if its origins have drifted from desired.
+func (s *LoadBalancerService) EnsurePool(ctx context.Context, accountID, hostname string, desired LoadBalancerPool) (*LoadBalancerPool, error) {
+	desired.Name = poolName(hostname)
+
+	pools, err := s.client.ListLoadBalancerPools(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list load balancer pools: %w", err)
+	}
+
+	for _, existing := range pools {
+		if existing.Name != desired.Name {
+			continue
+		}
+		if poolOriginsEqual(existing.Origins, desired.Origins) {
+			return &existing, nil
+		}
+		desired.ID = existing.ID
+		updated, err := s.client.UpdateLoadBalancerPool(ctx, accountID, existing.ID, desired)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update load balancer pool %s: %w", desired.Name, err)
+		}
+		return updated, nil
+	}
+
+	created, err := s.client.CreateLoadBalancerPool(ctx, accountID, desired)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create load balancer pool %s: %w", desired.Name, err)
+	}
+	return created, nil
+}
+
+// EnsureLoadBalancer finds the load balancer for desired.Name, creating it
+// if missing or updating it if its configuration has drifted from desired.
+func (s *LoadBalancerService) EnsureLoadBalancer(ctx context.Context, zoneID string, desired LoadBalancer) (*LoadBalancer, error) {
+	balancers, err := s.client.ListLoadBalancers(ctx, zoneID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list load balancers: %w", err)
+	}
+
+	for _, existing := range balancers {
+		if existing.Name != desired.Name {
+			continue
+		}
+		if loadBalancerConfigEqual(existing, desired) {
+			return &existing, nil
+		}
+		desired.ID = existing.ID
+		updated, err := s.client.UpdateLoadBalancer(ctx, zoneID, existing.ID, desired)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update load balancer %s: %w", desired.Name, err)
+		}
+		return updated, nil
+	}
+
+	created, err := s.client.CreateLoadBalancer(ctx, zoneID, desired)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create load balancer %s: %w", desired.Name, err)
+	}
+	return created, nil
+}
+
+// GetPoolStatus fetches a pool's current per-origin health and weight.
+func (s *LoadBalancerService) GetPoolStatus(ctx context.Context, accountID, poolID string) (*PoolStatus, error) {
+	status, err := s.client.GetLoadBalancerPoolStatus(ctx, accountID, poolID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get load balancer pool status: %w", err)
+	}
+	return status, nil
+}
+
+// DeletePool deletes a pool by ID.
+func (s *LoadBalancerService) DeletePool(ctx context.Context, accountID, poolID string) error {
+	if err := s.client.DeleteLoadBalancerPool(ctx, accountID, poolID); err != nil {
+		return fmt.Errorf("failed to delete load balancer pool %s: %w", poolID, err)
+	}
+	return nil
+}
+
+// DeleteLoadBalancer deletes a load balancer by ID.
+func (s *LoadBalancerService) DeleteLoadBalancer(ctx context.Context, zoneID, loadBalancerID string) error {
+	if err := s.client.DeleteLoadBalancer(ctx, zoneID, loadBalancerID); err != nil {
+		return fmt.Errorf("failed to delete load balancer %s: %w", loadBalancerID, err)
+	}
+	return nil
+}
+
+// poolOriginsEqual reports whether existing already matches desired's
+// origin set, so EnsurePool can skip a no-op update call.
+func poolOriginsEqual(existing, desired []PoolOrigin) bool {
+	if len(existing) != len(desired) {
+		return false
+	}
+	for i, origin := range existing {
+		d := desired[i]
+		if origin.Name != d.Name || origin.Address != d.Address || origin.Weight != d.Weight || origin.Enabled != d.Enabled {
+			return false
+		}
+	}
+	return true
+}
+
+// loadBalancerConfigEqual reports whether existing already matches
+// desired's configuration, so EnsureLoadBalancer can skip a no-op update
+// call.
+func loadBalancerConfigEqual(existing, desired LoadBalancer) bool {
+	if existing.SteeringPolicy != desired.SteeringPolicy || existing.Proxied != desired.Proxied {
+		return false
+	}
+	if len(existing.DefaultPools) != len(desired.DefaultPools) {
+		return false
+	}
+	for i, pool := range existing.DefaultPools {
+		if desired.DefaultPools[i] != pool {
+			return false
+		}
+	}
+	if len(existing.RegionPools) != len(desired.RegionPools) {
+		return false
+	}
+	for region, pools := range existing.RegionPools {
+		other, ok := desired.RegionPools[region]
+		if !ok || len(other) != len(pools) {
+			return false
+		}
+		for i, pool := range pools {
+			if other[i] != pool {
+				return false
+			}
+		}
+	}
+	return true
+}
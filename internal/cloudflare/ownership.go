@@ -0,0 +1,89 @@
+// Package cloudflare provides a wrapper around cloudflare-go for cfgate's needs.
+package cloudflare
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// OwnershipSchemaVersion is the current signed ownership payload format
+// BuildSignedOwnerIdentity writes and VerifySignedOwnerIdentity expects,
+// e.g. "v=cfgate1; tunnel=<name>; owner=<id>; nonce=<hex>; sig=<base64>".
+// Bump it whenever the signed fields or their encoding changes.
+const OwnershipSchemaVersion = "cfgate1"
+
+// BuildSignedOwnerIdentity builds the TXT ownership payload
+// BuildOwnershipTXTRecord should write for owner/tunnelName, HMAC-SHA256-signed
+// under key (a per-installation secret) so the claim can't be forged by
+// another party writing the same plaintext into a shared zone - only
+// whoever holds key can produce a sig VerifySignedOwnerIdentity accepts.
+func BuildSignedOwnerIdentity(key []byte, owner, tunnelName string) (string, error) {
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate ownership nonce: %w", err)
+	}
+
+	payload := signedOwnershipPayload(owner, tunnelName, hex.EncodeToString(nonce))
+	return payload + "; sig=" + signOwnershipPayload(key, payload), nil
+}
+
+// VerifySignedOwnerIdentity parses a signed ownership TXT payload (as built
+// by BuildSignedOwnerIdentity), verifies its HMAC under key, and returns the
+// owner it claims. ok is false if content isn't the current schema version,
+// is missing a field, or its signature doesn't verify - any of which means
+// the record must be treated as unowned rather than silently trusted.
+func VerifySignedOwnerIdentity(key []byte, content string) (owner string, ok bool) {
+	fields := parseOwnershipFields(content)
+	if fields["v"] != OwnershipSchemaVersion {
+		return "", false
+	}
+
+	owner, hasOwner := fields["owner"]
+	tunnel, hasTunnel := fields["tunnel"]
+	nonce, hasNonce := fields["nonce"]
+	sig, hasSig := fields["sig"]
+	if !hasOwner || !hasTunnel || !hasNonce || !hasSig {
+		return "", false
+	}
+
+	expected := signOwnershipPayload(key, signedOwnershipPayload(owner, tunnel, nonce))
+	if !hmac.Equal([]byte(expected), []byte(sig)) {
+		return "", false
+	}
+
+	return owner, true
+}
+
+// signedOwnershipPayload renders the unsigned portion of a signed ownership
+// TXT payload, shared by BuildSignedOwnerIdentity (to sign) and
+// VerifySignedOwnerIdentity (to recompute the expected signature).
+func signedOwnershipPayload(owner, tunnelName, nonce string) string {
+	return fmt.Sprintf("v=%s; tunnel=%s; owner=%s; nonce=%s", OwnershipSchemaVersion, tunnelName, owner, nonce)
+}
+
+// signOwnershipPayload computes the base64-encoded HMAC-SHA256 of payload
+// under key.
+func signOwnershipPayload(key []byte, payload string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(payload))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// parseOwnershipFields splits a "k=v; k=v; ..." ownership TXT payload into
+// a field map.
+func parseOwnershipFields(content string) map[string]string {
+	fields := make(map[string]string)
+	for _, part := range strings.Split(content, ";") {
+		key, value, found := strings.Cut(strings.TrimSpace(part), "=")
+		if !found {
+			continue
+		}
+		fields[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return fields
+}
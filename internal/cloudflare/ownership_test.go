@@ -0,0 +1,54 @@
+package cloudflare_test
+
+import (
+	"strings"
+	"testing"
+
+	"cfgate.io/cfgate/internal/cloudflare"
+)
+
+func TestBuildSignedOwnerIdentity_RoundTrip(t *testing.T) {
+	key := []byte("shared-secret")
+
+	signed, err := cloudflare.BuildSignedOwnerIdentity(key, "default/my-sync", "my-tunnel")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	owner, ok := cloudflare.VerifySignedOwnerIdentity(key, signed)
+	if !ok || owner != "default/my-sync" {
+		t.Fatalf("VerifySignedOwnerIdentity() = (%q, %v), want (%q, true)", owner, ok, "default/my-sync")
+	}
+}
+
+func TestVerifySignedOwnerIdentity_WrongKeyRejected(t *testing.T) {
+	signed, err := cloudflare.BuildSignedOwnerIdentity([]byte("shared-secret"), "default/my-sync", "my-tunnel")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, ok := cloudflare.VerifySignedOwnerIdentity([]byte("other-secret"), signed); ok {
+		t.Fatal("expected verification under the wrong key to fail")
+	}
+}
+
+func TestVerifySignedOwnerIdentity_TamperedOwnerRejected(t *testing.T) {
+	key := []byte("shared-secret")
+	signed, err := cloudflare.BuildSignedOwnerIdentity(key, "default/my-sync", "my-tunnel")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tampered := strings.Replace(signed, "owner=default/my-sync", "owner=attacker/fake-sync", 1)
+
+	if _, ok := cloudflare.VerifySignedOwnerIdentity(key, tampered); ok {
+		t.Fatal("expected a tampered owner field to fail verification")
+	}
+}
+
+func TestVerifySignedOwnerIdentity_UnsignedContentRejected(t *testing.T) {
+	legacy := cloudflare.OwnerIdentity("default/my-sync", "my-tunnel")
+	if _, ok := cloudflare.VerifySignedOwnerIdentity([]byte("shared-secret"), legacy); ok {
+		t.Fatal("expected unsigned legacy content to fail signed verification")
+	}
+}
@@ -0,0 +1,211 @@
+// Package cloudflare provides a wrapper around cloudflare-go for cfgate's needs.
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// ChangeType identifies the kind of mutation a planned Change represents.
+type ChangeType string
+
+const (
+	// ChangeCreate indicates a record should be created.
+	ChangeCreate ChangeType = "Create"
+
+	// ChangeUpdate indicates an existing, cfgate-owned record should be updated.
+	ChangeUpdate ChangeType = "Update"
+
+	// ChangeDelete indicates a previously-managed record should be removed.
+	ChangeDelete ChangeType = "Delete"
+)
+
+// DesiredRecord is a single record cfgate wants to exist, independent of
+// whatever Cloudflare currently has.
+type DesiredRecord struct {
+	ZoneID  string
+	Name    string
+	Type    string
+	Content string
+	Proxied bool
+	Comment string
+}
+
+// key returns the (zone, name, type) identity used to match desired records
+// against actual ones.
+func (d DesiredRecord) key() string {
+	return d.ZoneID + "|" + d.Name + "|" + d.Type
+}
+
+// DesiredState is the full set of records cfgate wants to exist for a sync.
+type DesiredState struct {
+	Records []DesiredRecord
+}
+
+// Change is a single planned mutation, keyed by (zone, name, type).
+type Change struct {
+	// Type is the kind of mutation.
+	Type ChangeType
+
+	// Zone, Name and RecordType identify the record.
+	ZoneID     string
+	Name       string
+	RecordType string
+
+	// Desired is the record cfgate wants (nil for ChangeDelete).
+	Desired *DesiredRecord
+
+	// Existing is the record Cloudflare currently has (nil for ChangeCreate).
+	Existing *DNSRecord
+}
+
+// String renders a human-readable, event/log-friendly summary of the change.
+func (c Change) String() string {
+	switch c.Type {
+	case ChangeCreate:
+		return fmt.Sprintf("create %s %s -> %s", c.RecordType, c.Name, c.Desired.Content)
+	case ChangeUpdate:
+		return fmt.Sprintf("update %s %s -> %s", c.RecordType, c.Name, c.Desired.Content)
+	case ChangeDelete:
+		return fmt.Sprintf("delete %s %s", c.RecordType, c.Name)
+	default:
+		return fmt.Sprintf("unknown change for %s %s", c.RecordType, c.Name)
+	}
+}
+
+// FetchActualState lists the cfgate-managed records in a zone, so they can be
+// diffed against a DesiredState without mutating anything.
+func FetchActualState(ctx context.Context, dnsService *DNSService, zoneID, ownershipPrefix string) ([]DNSRecord, error) {
+	records, err := dnsService.ListManagedRecords(ctx, zoneID, ownershipPrefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch actual state for zone %s: %w", zoneID, err)
+	}
+	return records, nil
+}
+
+// Plan computes an ordered, stable list of Changes required to move the
+// actual state (current cfgate-managed records, keyed by zone) to the
+// desired state. Only managed fields (content, proxied, comment) are
+// compared; TTL is ignored since cfgate always requests automatic TTL.
+// pruneOrphans controls whether actual records with no corresponding desired
+// record are planned for deletion.
+func Plan(desired DesiredState, actualByZone map[string][]DNSRecord, pruneOrphans bool) []Change {
+	desiredByKey := make(map[string]DesiredRecord, len(desired.Records))
+	for _, d := range desired.Records {
+		desiredByKey[d.key()] = d
+	}
+
+	actualByKey := make(map[string]DNSRecord)
+	actualZoneByKey := make(map[string]string)
+	for zoneID, records := range actualByZone {
+		for _, rec := range records {
+			if rec.Type == "TXT" {
+				continue // ownership TXT records are managed separately, not planned here
+			}
+			key := zoneID + "|" + rec.Name + "|" + rec.Type
+			actualByKey[key] = rec
+			actualZoneByKey[key] = zoneID
+		}
+	}
+
+	var changes []Change
+	for key, d := range desiredByKey {
+		existing, ok := actualByKey[key]
+		if !ok {
+			changes = append(changes, Change{
+				Type:       ChangeCreate,
+				ZoneID:     d.ZoneID,
+				Name:       d.Name,
+				RecordType: d.Type,
+				Desired:    recordPtr(d),
+			})
+			continue
+		}
+
+		if !desiredMatchesActual(d, &existing) {
+			changes = append(changes, Change{
+				Type:       ChangeUpdate,
+				ZoneID:     d.ZoneID,
+				Name:       d.Name,
+				RecordType: d.Type,
+				Desired:    recordPtr(d),
+				Existing:   &existing,
+			})
+		}
+	}
+
+	if pruneOrphans {
+		for key, existing := range actualByKey {
+			if _, ok := desiredByKey[key]; !ok {
+				existingCopy := existing
+				changes = append(changes, Change{
+					Type:       ChangeDelete,
+					ZoneID:     actualZoneByKey[key],
+					Name:       existing.Name,
+					RecordType: existing.Type,
+					Existing:   &existingCopy,
+				})
+			}
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Name != changes[j].Name {
+			return changes[i].Name < changes[j].Name
+		}
+		return changes[i].RecordType < changes[j].RecordType
+	})
+
+	return changes
+}
+
+// desiredMatchesActual reports whether an actual record already satisfies a
+// desired record's managed fields.
+func desiredMatchesActual(d DesiredRecord, actual *DNSRecord) bool {
+	return actual.Content == d.Content &&
+		actual.Proxied == d.Proxied &&
+		actual.Comment == d.Comment
+}
+
+// recordPtr returns a pointer to a copy of d, for embedding in a Change.
+func recordPtr(d DesiredRecord) *DesiredRecord {
+	return &d
+}
+
+// Apply executes a single planned Change against Cloudflare via dnsService.
+// Returns the resulting record (nil for ChangeDelete) and any error.
+func Apply(ctx context.Context, dnsService *DNSService, change Change) (*DNSRecord, error) {
+	switch change.Type {
+	case ChangeCreate:
+		record := DNSRecord{
+			Type:    change.Desired.Type,
+			Name:    change.Desired.Name,
+			Content: change.Desired.Content,
+			TTL:     1,
+			Proxied: change.Desired.Proxied,
+			Comment: change.Desired.Comment,
+		}
+		return dnsService.client.CreateDNSRecord(ctx, change.ZoneID, record)
+
+	case ChangeUpdate:
+		record := DNSRecord{
+			Type:    change.Desired.Type,
+			Name:    change.Desired.Name,
+			Content: change.Desired.Content,
+			TTL:     1,
+			Proxied: change.Desired.Proxied,
+			Comment: change.Desired.Comment,
+		}
+		return dnsService.client.UpdateDNSRecord(ctx, change.ZoneID, change.Existing.ID, record)
+
+	case ChangeDelete:
+		if !IsOwnedByCfgate(change.Existing, "", "") {
+			return nil, fmt.Errorf("refusing to delete record %s not owned by cfgate", change.Name)
+		}
+		return nil, dnsService.client.DeleteDNSRecord(ctx, change.ZoneID, change.Existing.ID)
+
+	default:
+		return nil, fmt.Errorf("unknown change type %q", change.Type)
+	}
+}
@@ -0,0 +1,59 @@
+// Package cloudflare provides a wrapper around cloudflare-go for cfgate's needs.
+package cloudflare
+
+import (
+	"context"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// Cloudflare's documented limits are roughly 1200 requests per 5 minutes per
+// API token, with per-zone endpoints rate-limited independently of that
+// account-wide ceiling. These defaults are deliberately conservative so a
+// single CloudflareDNSSync can't exhaust the whole account's quota on its own.
+const (
+	defaultZoneRPS   = 4
+	defaultZoneBurst = 4
+	globalRPS        = 1200.0 / (5 * 60)
+	globalBurst      = 40
+)
+
+// RateLimiter throttles Cloudflare API calls to stay within documented
+// limits: one limiter per zone, since zones are rate-limited independently,
+// plus a limiter shared across all zones for the account-wide ceiling.
+type RateLimiter struct {
+	mu     sync.Mutex
+	zones  map[string]*rate.Limiter
+	global *rate.Limiter
+}
+
+// NewRateLimiter creates a RateLimiter using Cloudflare's documented limits.
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{
+		zones:  make(map[string]*rate.Limiter),
+		global: rate.NewLimiter(rate.Limit(globalRPS), globalBurst),
+	}
+}
+
+// Wait blocks until a request against zoneID is permitted by both the
+// global and the per-zone limiter, or until ctx is done.
+func (rl *RateLimiter) Wait(ctx context.Context, zoneID string) error {
+	if err := rl.global.Wait(ctx); err != nil {
+		return err
+	}
+	return rl.zoneLimiter(zoneID).Wait(ctx)
+}
+
+// zoneLimiter returns the limiter for zoneID, creating it on first use.
+func (rl *RateLimiter) zoneLimiter(zoneID string) *rate.Limiter {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	limiter, ok := rl.zones[zoneID]
+	if !ok {
+		limiter = rate.NewLimiter(rate.Limit(defaultZoneRPS), defaultZoneBurst)
+		rl.zones[zoneID] = limiter
+	}
+	return limiter
+}
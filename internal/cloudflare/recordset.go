@@ -0,0 +1,270 @@
+// Package cloudflare provides a wrapper around cloudflare-go for cfgate's needs.
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// DesiredRecordSpec is the logical desired state for one hostname, before
+// BuildRecordSet expands it into the concrete Cloudflare record(s) that
+// satisfy it.
+type DesiredRecordSpec struct {
+	// Hostname is the fully-qualified name being synced: a plain name, a
+	// wildcard (e.g. "*.apps.example.com"), or a zone apex (equal to
+	// ZoneName).
+	Hostname string
+
+	// ZoneName is the zone Hostname belongs to. Only used to recognize an
+	// apex record (Hostname == ZoneName); Cloudflare CNAME-flattens a
+	// proxied apex CNAME server-side, so no special record shape is needed
+	// for it.
+	ZoneName string
+
+	// TunnelDomain, if set, builds a single CNAME record pointing at it.
+	// Mutually exclusive with Addresses/AddressesV6.
+	TunnelDomain string
+
+	// Addresses and AddressesV6, if set, build one A record per IPv4
+	// address and one AAAA record per IPv6 address instead of a CNAME.
+	// Mutually exclusive with TunnelDomain.
+	Addresses   []string
+	AddressesV6 []string
+
+	Proxied bool
+	Comment string
+}
+
+// BuildRecordSet expands spec into the concrete Cloudflare DNS records it
+// requires: a single CNAME for the common tunnel-backed case, or an A/AAAA
+// pair for a direct-address spec. It rejects a Hostname that isn't a legal
+// owner name under RFC 4592's wildcard rule (the "*" label may only appear
+// as the whole leftmost label).
+func BuildRecordSet(spec DesiredRecordSpec) ([]DNSRecord, error) {
+	if err := validateOwnerName(spec.Hostname); err != nil {
+		return nil, err
+	}
+
+	if len(spec.Addresses) == 0 && len(spec.AddressesV6) == 0 {
+		return []DNSRecord{BuildCNAMERecord(spec.Hostname, spec.TunnelDomain, spec.Proxied, spec.Comment)}, nil
+	}
+
+	records := make([]DNSRecord, 0, len(spec.Addresses)+len(spec.AddressesV6))
+	for _, addr := range spec.Addresses {
+		records = append(records, DNSRecord{Type: "A", Name: spec.Hostname, Content: addr, TTL: 1, Proxied: spec.Proxied, Comment: spec.Comment})
+	}
+	for _, addr := range spec.AddressesV6 {
+		records = append(records, DNSRecord{Type: "AAAA", Name: spec.Hostname, Content: addr, TTL: 1, Proxied: spec.Proxied, Comment: spec.Comment})
+	}
+	return records, nil
+}
+
+// validateOwnerName reports an error if name uses "*" anywhere but as the
+// whole leftmost label, e.g. "*.apps.example.com" is valid while
+// "a.*.example.com" and "*foo.example.com" are not.
+func validateOwnerName(name string) error {
+	labels := strings.Split(name, ".")
+	for i, label := range labels {
+		if !strings.Contains(label, "*") {
+			continue
+		}
+		if label != "*" || i != 0 {
+			return fmt.Errorf("invalid wildcard owner name %q: \"*\" may only appear as the whole leftmost label", name)
+		}
+	}
+	return nil
+}
+
+// SyncRecordSet ensures exactly the records desired (as built by
+// BuildRecordSet for one hostname) exist: creating or updating each one
+// through SyncRecord, so ownership and content checks apply per-record, and
+// deleting any previously-synced record at the same name whose type is no
+// longer desired (e.g. a hostname that switches from a CNAME to a direct
+// A/AAAA pair, or back). desired must all share the same Name.
+// records mirrors desired positionally; a slot is nil if an ownership
+// conflict blocked that record. conflict is true if any record hit one.
+func (s *DNSService) SyncRecordSet(ctx context.Context, zoneID string, desired []DNSRecord, ownerID, prefix string, txtEnabled bool) (records []*DNSRecord, modified, conflict bool, err error) {
+	if len(desired) == 0 {
+		return nil, false, false, nil
+	}
+
+	name := desired[0].Name
+	desiredTypes := make(map[string]bool, len(desired))
+	for _, d := range desired {
+		desiredTypes[d.Type] = true
+	}
+
+	existing, err := s.FindRecordsByName(ctx, zoneID, name)
+	if err != nil {
+		return nil, false, false, fmt.Errorf("failed to find existing records for %s: %w", name, err)
+	}
+
+	records = make([]*DNSRecord, len(desired))
+	for i, d := range desired {
+		record, recModified, recConflict, syncErr := s.SyncRecord(ctx, zoneID, d, ownerID, prefix, txtEnabled)
+		if syncErr != nil {
+			return nil, false, false, syncErr
+		}
+		records[i] = record
+		modified = modified || recModified
+		conflict = conflict || recConflict
+	}
+
+	for _, rec := range existing {
+		if rec.Type == "TXT" || desiredTypes[rec.Type] {
+			continue // not part of this record set, or still desired
+		}
+		recCopy := rec
+		owned, err := s.CheckOwnership(ctx, zoneID, name, prefix, ownerID, txtEnabled, &recCopy)
+		if err != nil {
+			return nil, false, false, fmt.Errorf("failed to check ownership of stale %s record for %s: %w", rec.Type, name, err)
+		}
+		if !owned {
+			continue
+		}
+		if err := s.DeleteRecord(ctx, zoneID, rec.ID); err != nil {
+			return nil, false, false, fmt.Errorf("failed to delete stale %s record for %s: %w", rec.Type, name, err)
+		}
+		modified = true
+	}
+
+	return records, modified, conflict, nil
+}
+
+// FindRecordsByName returns every record at name, across all types (e.g. a
+// hostname's CNAME alongside its ownership TXT record). Returns nil if none
+// exist.
+func (s *DNSService) FindRecordsByName(ctx context.Context, zoneID, name string) ([]DNSRecord, error) {
+	records, err := s.listRecords(ctx, zoneID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list DNS records: %w", err)
+	}
+
+	var matches []DNSRecord
+	for _, record := range records {
+		if record.Name == name {
+			matches = append(matches, record)
+		}
+	}
+	return matches, nil
+}
+
+// ReconcileResult summarizes a ReconcileZone pass.
+type ReconcileResult struct {
+	Created, Updated, Deleted int
+
+	// Conflicts lists the (name, type) keys of desired records that would
+	// have been updated but weren't, because the existing record at that
+	// key isn't IsOwnedByCfgate. Unowned records that aren't desired are
+	// skipped without being listed here.
+	Conflicts []string
+}
+
+// ReconcileZone reconciles an entire zone's desired record set in a single
+// pass: it lists zoneID exactly once (via s.recordCache, if set, so
+// concurrent reconciles of the same zone share the call), computes the
+// create/update/delete sets by diffing desired against the actual records
+// keyed by (Name, Type), and executes them with at most maxParallel
+// concurrent requests - the batch counterpart to calling SyncRecord once per
+// hostname, each of which re-lists the zone on its own. Ownership TXT
+// records are left untouched; only IsOwnedByCfgate records are ever updated
+// or deleted, so a pre-existing record cfgate doesn't manage is never
+// touched; if it sits at a desired (Name, Type) it is reported in Conflicts.
+func (s *DNSService) ReconcileZone(ctx context.Context, zoneID string, desired []DNSRecord, ownershipPrefix string, maxParallel int) (ReconcileResult, error) {
+	actual, err := s.listRecords(ctx, zoneID)
+	if err != nil {
+		return ReconcileResult{}, fmt.Errorf("failed to list zone records: %w", err)
+	}
+
+	actualByKey := make(map[string]DNSRecord, len(actual))
+	for _, rec := range actual {
+		if rec.Type == "TXT" {
+			continue
+		}
+		actualByKey[recordSetKey(rec.Name, rec.Type)] = rec
+	}
+
+	desiredByKey := make(map[string]DNSRecord, len(desired))
+	for _, rec := range desired {
+		desiredByKey[recordSetKey(rec.Name, rec.Type)] = rec
+	}
+
+	if maxParallel <= 0 {
+		maxParallel = 1
+	}
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxParallel)
+
+	var result ReconcileResult
+	var mu sync.Mutex
+
+	for key, d := range desiredByKey {
+		d := d
+		existing, ok := actualByKey[key]
+		if ok && recordsMatch(&existing, &d) {
+			continue
+		}
+
+		g.Go(func() error {
+			if ok {
+				if !IsOwnedByCfgate(&existing, ownershipPrefix, "") {
+					mu.Lock()
+					result.Conflicts = append(result.Conflicts, key)
+					mu.Unlock()
+					return nil
+				}
+				if _, err := s.client.UpdateDNSRecord(gctx, zoneID, existing.ID, d); err != nil {
+					return fmt.Errorf("failed to update %s %s: %w", d.Type, d.Name, err)
+				}
+				mu.Lock()
+				result.Updated++
+				mu.Unlock()
+				return nil
+			}
+
+			if _, err := s.client.CreateDNSRecord(gctx, zoneID, d); err != nil {
+				return fmt.Errorf("failed to create %s %s: %w", d.Type, d.Name, err)
+			}
+			mu.Lock()
+			result.Created++
+			mu.Unlock()
+			return nil
+		})
+	}
+
+	for key, existing := range actualByKey {
+		if _, ok := desiredByKey[key]; ok {
+			continue
+		}
+		existing := existing
+		if !IsOwnedByCfgate(&existing, ownershipPrefix, "") {
+			continue
+		}
+
+		g.Go(func() error {
+			if err := s.client.DeleteDNSRecord(gctx, zoneID, existing.ID); err != nil {
+				return fmt.Errorf("failed to delete %s %s: %w", existing.Type, existing.Name, err)
+			}
+			mu.Lock()
+			result.Deleted++
+			mu.Unlock()
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return result, err
+	}
+
+	s.invalidateZone(zoneID)
+	return result, nil
+}
+
+// recordSetKey is the (Name, Type) identity ReconcileZone diffs records by.
+func recordSetKey(name, recordType string) string {
+	return name + "|" + recordType
+}
@@ -0,0 +1,219 @@
+package cloudflare_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	gomock "github.com/golang/mock/gomock"
+
+	"cfgate.io/cfgate/internal/cloudflare"
+	"cfgate.io/cfgate/internal/cloudflare/mocks"
+)
+
+func TestBuildRecordSet_Wildcard(t *testing.T) {
+	records, err := cloudflare.BuildRecordSet(cloudflare.DesiredRecordSpec{
+		Hostname:     "*.apps.example.com",
+		ZoneName:     "example.com",
+		TunnelDomain: "tunnel.cfargotunnel.com",
+		Proxied:      true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(records) != 1 || records[0].Name != "*.apps.example.com" || records[0].Type != "CNAME" {
+		t.Fatalf("unexpected records: %+v", records)
+	}
+}
+
+func TestBuildRecordSet_InvalidWildcardRejected(t *testing.T) {
+	cases := []string{"a.*.example.com", "*foo.example.com", "foo.*bar.example.com"}
+	for _, hostname := range cases {
+		if _, err := cloudflare.BuildRecordSet(cloudflare.DesiredRecordSpec{Hostname: hostname, TunnelDomain: "tunnel.cfargotunnel.com"}); err == nil {
+			t.Errorf("expected %q to be rejected as an invalid wildcard owner name", hostname)
+		}
+	}
+}
+
+func TestBuildRecordSet_Apex(t *testing.T) {
+	records, err := cloudflare.BuildRecordSet(cloudflare.DesiredRecordSpec{
+		Hostname:     "example.com",
+		ZoneName:     "example.com",
+		TunnelDomain: "tunnel.cfargotunnel.com",
+		Proxied:      true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(records) != 1 || records[0].Name != "example.com" {
+		t.Fatalf("unexpected records: %+v", records)
+	}
+}
+
+func TestBuildRecordSet_AddressPair(t *testing.T) {
+	records, err := cloudflare.BuildRecordSet(cloudflare.DesiredRecordSpec{
+		Hostname:    "app.example.com",
+		Addresses:   []string{"10.0.0.1"},
+		AddressesV6: []string{"fd00::1"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(records) != 2 || records[0].Type != "A" || records[1].Type != "AAAA" {
+		t.Fatalf("unexpected records: %+v", records)
+	}
+}
+
+func TestSyncRecordSet_DeletesStaleTypeOnKindChange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	api := mocks.NewMockCloudflareAPI(ctrl)
+	svc := cloudflare.NewDNSService(api)
+
+	staleA := cloudflare.DNSRecord{ID: "rec-a", Name: "app.example.com", Type: "A", Content: "10.0.0.1", Comment: "managed by cfgate"}
+
+	api.EXPECT().ListDNSRecords(gomock.Any(), "zone-1").Return([]cloudflare.DNSRecord{staleA}, nil).Times(2)
+	api.EXPECT().CreateDNSRecord(gomock.Any(), "zone-1", gomock.Any()).Return(&cloudflare.DNSRecord{ID: "rec-cname", Name: "app.example.com", Type: "CNAME"}, nil)
+	api.EXPECT().DeleteDNSRecord(gomock.Any(), "zone-1", "rec-a").Return(nil)
+
+	desired, err := cloudflare.BuildRecordSet(cloudflare.DesiredRecordSpec{Hostname: "app.example.com", TunnelDomain: "tunnel.cfargotunnel.com", Comment: "managed by cfgate"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	records, modified, conflict, err := svc.SyncRecordSet(context.Background(), "zone-1", desired, "default/my-sync", "_cfgate", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if conflict {
+		t.Fatal("expected no ownership conflict")
+	}
+	if !modified {
+		t.Fatal("expected modified=true: a new CNAME was created and the stale A record deleted")
+	}
+	if len(records) != 1 || records[0] == nil || records[0].ID != "rec-cname" {
+		t.Fatalf("unexpected records: %+v", records)
+	}
+}
+
+func TestSyncRecordSet_LeavesUnownedStaleRecord(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	api := mocks.NewMockCloudflareAPI(ctrl)
+	svc := cloudflare.NewDNSService(api)
+
+	staleA := cloudflare.DNSRecord{ID: "rec-a", Name: "app.example.com", Type: "A", Content: "10.0.0.1"}
+
+	api.EXPECT().ListDNSRecords(gomock.Any(), "zone-1").Return([]cloudflare.DNSRecord{staleA}, nil).Times(2)
+	api.EXPECT().CreateDNSRecord(gomock.Any(), "zone-1", gomock.Any()).Return(&cloudflare.DNSRecord{ID: "rec-cname", Name: "app.example.com", Type: "CNAME"}, nil)
+
+	desired, err := cloudflare.BuildRecordSet(cloudflare.DesiredRecordSpec{Hostname: "app.example.com", TunnelDomain: "tunnel.cfargotunnel.com"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, _, _, err = svc.SyncRecordSet(context.Background(), "zone-1", desired, "default/my-sync", "_cfgate", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestReconcileZone_CreatesUpdatesAndDeletes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	api := mocks.NewMockCloudflareAPI(ctrl)
+	svc := cloudflare.NewDNSService(api)
+
+	actual := []cloudflare.DNSRecord{
+		{ID: "rec-stale", Name: "gone.example.com", Type: "CNAME", Content: "old.cfargotunnel.com", Comment: "managed by cfgate"},
+		{ID: "rec-update", Name: "app.example.com", Type: "CNAME", Content: "old.cfargotunnel.com", Comment: "managed by cfgate"},
+	}
+	desired := []cloudflare.DNSRecord{
+		{Name: "app.example.com", Type: "CNAME", Content: "new.cfargotunnel.com", Comment: "managed by cfgate"},
+		{Name: "new.example.com", Type: "CNAME", Content: "new.cfargotunnel.com", Comment: "managed by cfgate"},
+	}
+
+	api.EXPECT().ListDNSRecords(gomock.Any(), "zone-1").Return(actual, nil)
+	api.EXPECT().UpdateDNSRecord(gomock.Any(), "zone-1", "rec-update", gomock.Any()).Return(&cloudflare.DNSRecord{ID: "rec-update"}, nil)
+	api.EXPECT().CreateDNSRecord(gomock.Any(), "zone-1", gomock.Any()).Return(&cloudflare.DNSRecord{ID: "rec-new"}, nil)
+	api.EXPECT().DeleteDNSRecord(gomock.Any(), "zone-1", "rec-stale").Return(nil)
+
+	result, err := svc.ReconcileZone(context.Background(), "zone-1", desired, "_cfgate", 4)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Created != 1 || result.Updated != 1 || result.Deleted != 1 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestReconcileZone_LeavesUnownedRecordAsConflict(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	api := mocks.NewMockCloudflareAPI(ctrl)
+	svc := cloudflare.NewDNSService(api)
+
+	actual := []cloudflare.DNSRecord{
+		{ID: "rec-foreign", Name: "app.example.com", Type: "CNAME", Content: "old.cfargotunnel.com"},
+	}
+	desired := []cloudflare.DNSRecord{
+		{Name: "app.example.com", Type: "CNAME", Content: "new.cfargotunnel.com", Comment: "managed by cfgate"},
+	}
+
+	api.EXPECT().ListDNSRecords(gomock.Any(), "zone-1").Return(actual, nil)
+
+	result, err := svc.ReconcileZone(context.Background(), "zone-1", desired, "_cfgate", 4)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Updated != 0 || len(result.Conflicts) != 1 {
+		t.Fatalf("expected the unowned record to be reported as a conflict, got: %+v", result)
+	}
+}
+
+func TestZoneRecordCache_SharedAcrossServicesCollapsesListCalls(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	api := mocks.NewMockCloudflareAPI(ctrl)
+
+	records := []cloudflare.DNSRecord{{ID: "rec-1", Name: "app.example.com", Type: "CNAME", Content: "tunnel.cfargotunnel.com"}}
+	api.EXPECT().ListDNSRecords(gomock.Any(), "zone-1").Return(records, nil).Times(1)
+
+	cache := cloudflare.NewZoneRecordCache(time.Minute)
+	svc1 := cloudflare.NewDNSService(api).WithRecordCache(cache)
+	svc2 := cloudflare.NewDNSService(api).WithRecordCache(cache)
+
+	if _, err := svc1.FindRecordsByName(context.Background(), "zone-1", "app.example.com"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := svc2.FindRecordsByName(context.Background(), "zone-1", "app.example.com"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestZoneRecordCache_InvalidatedAfterMutation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	api := mocks.NewMockCloudflareAPI(ctrl)
+
+	before := []cloudflare.DNSRecord{{ID: "rec-1", Name: "app.example.com", Type: "CNAME", Content: "old.cfargotunnel.com", Comment: "managed by cfgate"}}
+	after := []cloudflare.DNSRecord{{ID: "rec-1", Name: "app.example.com", Type: "CNAME", Content: "new.cfargotunnel.com", Comment: "managed by cfgate"}}
+
+	gomock.InOrder(
+		api.EXPECT().ListDNSRecords(gomock.Any(), "zone-1").Return(before, nil),
+		api.EXPECT().UpdateDNSRecord(gomock.Any(), "zone-1", "rec-1", gomock.Any()).Return(&after[0], nil),
+		api.EXPECT().ListDNSRecords(gomock.Any(), "zone-1").Return(after, nil),
+	)
+
+	cache := cloudflare.NewZoneRecordCache(time.Minute)
+	svc := cloudflare.NewDNSService(api).WithRecordCache(cache)
+
+	desired := cloudflare.DNSRecord{Name: "app.example.com", Type: "CNAME", Content: "new.cfargotunnel.com"}
+	if _, _, _, err := svc.SyncRecord(context.Background(), "zone-1", desired, "default/my-sync", "_cfgate", false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	records, err := svc.FindRecordsByName(context.Background(), "zone-1", "app.example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].Content < records[j].Content })
+	if len(records) != 1 || records[0].Content != "new.cfargotunnel.com" {
+		t.Fatalf("expected cache to be invalidated after update, got: %+v", records)
+	}
+}
@@ -0,0 +1,126 @@
+// Package cloudflare provides a wrapper around cloudflare-go for cfgate's needs.
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// RedirectRule represents a single rule in a zone's http_request_dynamic_redirect
+// phase ruleset (Single Redirects), mirroring the shape of the Rulesets API.
+type RedirectRule struct {
+	// ID is the Cloudflare rule ID, assigned on creation. Empty for rules not yet created.
+	ID string
+
+	// Description carries cfgate's ownership marker (see RedirectRuleDescription).
+	Description string
+
+	// Expression is the wirefilter match expression.
+	Expression string
+
+	// TargetURL is the forwarding destination, e.g. "https://example.com/new${1}".
+	TargetURL string
+
+	// StatusCode is the HTTP redirect status code (301, 302, 307, or 308).
+	StatusCode int
+
+	PreserveQueryString bool
+	PreservePath        bool
+}
+
+// RedirectRuleset is a zone's http_request_dynamic_redirect phase ruleset.
+type RedirectRuleset struct {
+	ID    string
+	Rules []RedirectRule
+}
+
+// RedirectService manages a zone's Single Redirects (dynamic redirect ruleset).
+type RedirectService struct {
+	// client is the underlying Cloudflare client.
+	client Client
+}
+
+// NewRedirectService creates a new RedirectService.
+func NewRedirectService(client Client) *RedirectService {
+	return &RedirectService{client: client}
+}
+
+// BuildHostPathExpression builds a wirefilter expression matching requests to
+// hostname, optionally restricted to a path prefix.
+func BuildHostPathExpression(hostname, path string) string {
+	if path == "" {
+		return fmt.Sprintf(`(http.host eq "%s")`, hostname)
+	}
+	return fmt.Sprintf(`(http.host eq "%s" and starts_with(http.request.uri.path, "%s"))`, hostname, path)
+}
+
+// RedirectRuleDescription returns the description cfgate writes onto every
+// redirect rule it creates, embedding name as the rule's stable identity so a
+// later sync can find and update it. Mirrors the "managed by cfgate (prefix)"
+// convention used for DNS ownership and WAF list items.
+func RedirectRuleDescription(name, prefix string) string {
+	return fmt.Sprintf("%s: managed by cfgate (%s)", name, prefix)
+}
+
+// IsOwnedRedirectRule reports whether rule carries cfgate's ownership marker for prefix.
+func IsOwnedRedirectRule(rule RedirectRule, prefix string) bool {
+	return strings.Contains(rule.Description, fmt.Sprintf("managed by cfgate (%s)", prefix))
+}
+
+// SyncRules reconciles the zone's dynamic-redirect ruleset to contain exactly
+// desired plus whatever foreign rules (not carrying cfgate's ownership
+// marker) were already present. cfgate-owned rules missing from desired are
+// dropped; rules added outside the operator are left untouched. The ruleset
+// is only PUT if the merged result differs from what's live.
+// Returns the ruleset ID, the number of rules successfully synced (i.e.
+// len(desired) on success), and the number failed.
+func (s *RedirectService) SyncRules(ctx context.Context, zoneID, ownershipPrefix string, desired []RedirectRule) (rulesetID string, synced, failed int, err error) {
+	ruleset, err := s.client.GetDynamicRedirectRuleset(ctx, zoneID)
+	if err != nil {
+		return "", 0, len(desired), fmt.Errorf("failed to fetch dynamic redirect ruleset: %w", err)
+	}
+
+	var merged []RedirectRule
+	for _, rule := range ruleset.Rules {
+		if IsOwnedRedirectRule(rule, ownershipPrefix) {
+			continue // re-added below if still desired
+		}
+		merged = append(merged, rule)
+	}
+	merged = append(merged, desired...)
+
+	if redirectRulesEqual(ruleset.Rules, merged) {
+		return ruleset.ID, len(desired), 0, nil
+	}
+
+	if err := s.client.UpdateDynamicRedirectRuleset(ctx, zoneID, merged); err != nil {
+		return ruleset.ID, 0, len(desired), fmt.Errorf("failed to update dynamic redirect ruleset: %w", err)
+	}
+
+	return ruleset.ID, len(desired), 0, nil
+}
+
+// redirectRulesEqual reports whether two rule sets are equivalent, ignoring
+// order and rule IDs (desired rules built from a spec never carry one).
+func redirectRulesEqual(a, b []RedirectRule) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	ac := make([]RedirectRule, len(a))
+	bc := make([]RedirectRule, len(b))
+	copy(ac, a)
+	copy(bc, b)
+	sort.Slice(ac, func(i, j int) bool { return ac[i].Description < ac[j].Description })
+	sort.Slice(bc, func(i, j int) bool { return bc[i].Description < bc[j].Description })
+
+	for i := range ac {
+		ac[i].ID, bc[i].ID = "", ""
+		if ac[i] != bc[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,104 @@
+// Package cloudflare provides a wrapper around cloudflare-go for cfgate's needs.
+package cloudflare
+
+import (
+	"context"
+	"math/rand"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// retryableStatusMarkers are substrings cloudflare-go's API errors carry for
+// responses worth retrying: 429 (rate limited) and 5xx (server error).
+var retryableStatusMarkers = []string{"429", "500", "502", "503", "504"}
+
+// isRetryable reports whether err looks like a transient Cloudflare API
+// failure. cloudflare-go doesn't expose a typed status code on its errors,
+// so this is a conservative, message-based heuristic.
+func isRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := err.Error()
+	for _, marker := range retryableStatusMarkers {
+		if strings.Contains(msg, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// notFoundMarkers are substrings cloudflare-go's API errors carry when the
+// target resource no longer exists.
+var notFoundMarkers = []string{"not found", "could not be found", "could not find"}
+
+// notFoundStatusPattern matches a standalone "404" in an error message - a
+// status code or Cloudflare error code, not a digit run that merely contains
+// "404" (a zone/record ID like "140404", say). A bare strings.Contains(msg,
+// "404") would misclassify those as not-found and silently defeat
+// DeletionFailurePolicy=Fail, since the caller would treat the delete as a
+// successful no-op instead of surfacing the real error.
+var notFoundStatusPattern = regexp.MustCompile(`\b404\b`)
+
+// IsNotFoundError reports whether err looks like a Cloudflare "resource
+// doesn't exist" response. Callers deleting a record should treat this as
+// success (already gone) rather than a failure to report.
+func IsNotFoundError(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	if notFoundStatusPattern.MatchString(msg) {
+		return true
+	}
+	for _, marker := range notFoundMarkers {
+		if strings.Contains(msg, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// errorCodePattern matches a Cloudflare API error code, e.g. the "81057" in
+// "HTTP status 400: code: 81057, message: ...".
+var errorCodePattern = regexp.MustCompile(`code:\s*(\d+)`)
+
+// ErrorCode extracts the Cloudflare API error code from err's message, if
+// present. cloudflare-go doesn't expose a typed error code, so this is a
+// best-effort, message-based extraction for surfacing in status.
+func ErrorCode(err error) string {
+	if err == nil {
+		return ""
+	}
+	match := errorCodePattern.FindStringSubmatch(err.Error())
+	if match == nil {
+		return ""
+	}
+	return match[1]
+}
+
+// WithBackoff retries fn up to maxAttempts times with exponential backoff
+// and jitter between attempts, stopping as soon as fn succeeds or returns a
+// non-retryable error.
+func WithBackoff(ctx context.Context, maxAttempts int, fn func() error) error {
+	var err error
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		err = fn()
+		if err == nil || !isRetryable(err) {
+			return err
+		}
+		if attempt == maxAttempts-1 {
+			break
+		}
+
+		backoff := time.Duration(1<<uint(attempt)) * 250 * time.Millisecond
+		jitter := time.Duration(rand.Int63n(int64(backoff) + 1))
+		select {
+		case <-time.After(backoff + jitter):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return err
+}
@@ -0,0 +1,30 @@
+package cloudflare_test
+
+import (
+	"errors"
+	"testing"
+
+	"cfgate.io/cfgate/internal/cloudflare"
+)
+
+func TestIsNotFoundError(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil error", nil, false},
+		{"HTTP status 404", errors.New("HTTP status 404: record not found"), true},
+		{"not found phrase", errors.New("DNS record could not be found"), true},
+		{"could not find phrase", errors.New("could not find zone"), true},
+		{"id containing 404 is not a not-found response", errors.New("zone 140404 failed: code: 81057, message: invalid zone"), false},
+		{"unrelated error", errors.New("connection refused"), false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := cloudflare.IsNotFoundError(tc.err); got != tc.want {
+				t.Fatalf("IsNotFoundError(%v) = %v, want %v", tc.err, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,313 @@
+// Package cloudflare provides a wrapper around cloudflare-go for cfgate's needs.
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// OriginRequestConfig carries per-hostname overrides for cloudflared's
+// connection behavior to the origin, mirroring a subset of the
+// cfd_tunnel/{id}/configurations API's originRequest block.
+type OriginRequestConfig struct {
+	// NoTLSVerify disables TLS certificate verification when connecting to the origin.
+	NoTLSVerify bool `json:"noTLSVerify,omitempty"`
+
+	// ConnectTimeoutSeconds overrides cloudflared's origin connection timeout.
+	ConnectTimeoutSeconds int32 `json:"connectTimeoutSeconds,omitempty"`
+
+	// HTTPHostHeader overrides the Host header cloudflared sends to the origin.
+	HTTPHostHeader string `json:"httpHostHeader,omitempty"`
+}
+
+// TunnelIngressRule represents a single rule in a tunnel's ingress configuration,
+// mirroring the shape of the Cloudflare cfd_tunnel/{id}/configurations API.
+type TunnelIngressRule struct {
+	// Hostname is the public hostname to match. Empty for the catch-all rule.
+	Hostname string `json:"hostname,omitempty"`
+
+	// Path restricts the rule to requests under this path. Empty matches all paths.
+	Path string `json:"path,omitempty"`
+
+	// Service is the origin to proxy to, e.g. "http://svc.ns.svc.cluster.local:8080"
+	// or "http_status:404" for the catch-all rule.
+	Service string `json:"service"`
+
+	// OriginRequest configures cloudflared's connection behavior to the origin.
+	OriginRequest OriginRequestConfig `json:"originRequest,omitempty"`
+}
+
+// TunnelIngressConfig is the desired or actual ingress configuration for a tunnel.
+type TunnelIngressConfig struct {
+	Ingress []TunnelIngressRule `json:"ingress"`
+}
+
+// TunnelService manages a tunnel's ingress configuration.
+type TunnelService struct {
+	// client is the underlying Cloudflare API.
+	client CloudflareAPI
+}
+
+// NewTunnelService creates a new TunnelService.
+func NewTunnelService(client CloudflareAPI) *TunnelService {
+	return &TunnelService{client: client}
+}
+
+// BuildIngressRule builds a single ingress rule for a hostname routed to a
+// Kubernetes Service, translating the Service + port into the in-cluster DNS
+// name cloudflared's origin needs: "http(s)://svc.ns.svc.cluster.local:port".
+func BuildIngressRule(hostname, serviceName, serviceNamespace string, port int32, useHTTPS bool) TunnelIngressRule {
+	scheme := "http"
+	if useHTTPS {
+		scheme = "https"
+	}
+	return TunnelIngressRule{
+		Hostname: hostname,
+		Service:  fmt.Sprintf("%s://%s.%s.svc.cluster.local:%d", scheme, serviceName, serviceNamespace, port),
+	}
+}
+
+// BuildIngressConfig builds the desired tunnel ingress configuration from a set
+// of per-hostname rules, appending the required catch-all rule.
+// Rules are sorted by hostname for a stable, diffable ordering.
+func BuildIngressConfig(rules []TunnelIngressRule) TunnelIngressConfig {
+	sorted := make([]TunnelIngressRule, len(rules))
+	copy(sorted, rules)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Hostname < sorted[j].Hostname })
+
+	sorted = append(sorted, TunnelIngressRule{Service: "http_status:404"})
+	return TunnelIngressConfig{Ingress: sorted}
+}
+
+// Equal reports whether two ingress configurations are equivalent, ignoring order.
+func (c TunnelIngressConfig) Equal(other TunnelIngressConfig) bool {
+	if len(c.Ingress) != len(other.Ingress) {
+		return false
+	}
+
+	a := make([]TunnelIngressRule, len(c.Ingress))
+	b := make([]TunnelIngressRule, len(other.Ingress))
+	copy(a, c.Ingress)
+	copy(b, other.Ingress)
+	sort.Slice(a, func(i, j int) bool { return a[i].Hostname < a[j].Hostname })
+	sort.Slice(b, func(i, j int) bool { return b[i].Hostname < b[j].Hostname })
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// SyncIngress fetches the tunnel's current configuration, merges in the
+// desired rules and, if the merged result differs from what's live, PUTs the
+// update. Rules for hostnames outside of desired but present in the current
+// config are left untouched so unrelated DNSSync/tunnel consumers don't
+// clobber each other's routes, except for remove, whose hostnames are
+// dropped outright: the caller's own hostnames that are no longer wanted,
+// e.g. because their DNS record was just pruned.
+// Returns whether the configuration was modified.
+func (s *TunnelService) SyncIngress(ctx context.Context, tunnelID string, desired []TunnelIngressRule, remove []string) (bool, error) {
+	current, err := s.client.GetTunnelConfiguration(ctx, tunnelID)
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch tunnel configuration: %w", err)
+	}
+
+	merged := mergeIngressRules(current.Ingress, desired, remove)
+	desiredConfig := BuildIngressConfig(merged)
+
+	if current != nil && desiredConfig.Equal(TunnelIngressConfig{Ingress: current.Ingress}) {
+		return false, nil
+	}
+
+	if err := s.client.UpdateTunnelConfiguration(ctx, tunnelID, desiredConfig); err != nil {
+		return false, fmt.Errorf("failed to update tunnel configuration: %w", err)
+	}
+
+	return true, nil
+}
+
+// mergeIngressRules overlays desired rules onto the current rule set, keyed
+// by hostname, dropping the existing catch-all (a new one is added by
+// BuildIngressConfig), dropping any hostname in remove, and leaving rules
+// for hostnames that are in neither desired nor remove untouched.
+func mergeIngressRules(current, desired []TunnelIngressRule, remove []string) []TunnelIngressRule {
+	desiredByHostname := make(map[string]TunnelIngressRule, len(desired))
+	for _, r := range desired {
+		desiredByHostname[r.Hostname] = r
+	}
+
+	merged := make(map[string]TunnelIngressRule)
+	for _, r := range current {
+		if r.Hostname == "" {
+			continue // drop the old catch-all
+		}
+		merged[r.Hostname] = r
+	}
+	for _, hostname := range remove {
+		delete(merged, hostname)
+	}
+	for hostname, r := range desiredByHostname {
+		merged[hostname] = r
+	}
+
+	out := make([]TunnelIngressRule, 0, len(merged))
+	for _, r := range merged {
+		out = append(out, r)
+	}
+	return out
+}
+
+// TakeoverPolicy controls whether a reconciler forces out a tunnel's
+// existing connectors before rolling out its own, mirroring cloudflared's
+// `tunnel run --force` preflight (CleanupTunnelConnections).
+type TakeoverPolicy string
+
+const (
+	// TakeoverDisabled never cleans up foreign connections; reconciliation
+	// fails loudly instead of fighting another connector for the tunnel.
+	TakeoverDisabled TakeoverPolicy = "Disabled"
+
+	// TakeoverIfStale cleans up connections only when none of the active
+	// connectors belong to the current Deployment's pods, i.e. the tunnel
+	// looks abandoned rather than actively served elsewhere.
+	TakeoverIfStale TakeoverPolicy = "IfStale"
+
+	// TakeoverAlways cleans up any foreign connections regardless of
+	// whether they look stale.
+	TakeoverAlways TakeoverPolicy = "Always"
+)
+
+// ShouldTakeover decides whether a reconciler should call
+// CleanupTunnelConnections before rolling out its cloudflared Deployment.
+// connectorIDs are the tunnel's currently active connector UUIDs, from
+// GET .../cfd_tunnel/{id}/connections; ownPodConnectorIDs are the connector
+// UUIDs this reconciler can attribute to its own Deployment's current pods
+// (e.g. via a pod annotation). A connector not in ownPodConnectorIDs is
+// foreign.
+func ShouldTakeover(policy TakeoverPolicy, connectorIDs, ownPodConnectorIDs []string) bool {
+	if policy == TakeoverDisabled {
+		return false
+	}
+	if policy == TakeoverAlways {
+		return len(connectorIDs) > 0
+	}
+
+	// IfStale: take over only if every active connector is foreign.
+	own := make(map[string]struct{}, len(ownPodConnectorIDs))
+	for _, id := range ownPodConnectorIDs {
+		own[id] = struct{}{}
+	}
+	for _, id := range connectorIDs {
+		if _, isOwn := own[id]; isOwn {
+			return false
+		}
+	}
+	return len(connectorIDs) > 0
+}
+
+// HasForeignConnectors reports whether any of connectorIDs aren't in
+// ownPodConnectorIDs, for surfacing a TunnelOwned condition with reason
+// ForeignConnectorsPresent when takeover is disabled.
+func HasForeignConnectors(connectorIDs, ownPodConnectorIDs []string) bool {
+	own := make(map[string]struct{}, len(ownPodConnectorIDs))
+	for _, id := range ownPodConnectorIDs {
+		own[id] = struct{}{}
+	}
+	for _, id := range connectorIDs {
+		if _, isOwn := own[id]; !isOwn {
+			return true
+		}
+	}
+	return false
+}
+
+// ConnectorStatus is one connector's point-in-time status, resolved from a
+// Connection plus, if the connector belongs to one of this reconciler's own
+// pods, that pod's name.
+type ConnectorStatus struct {
+	ID             string
+	ColoName       string
+	OpenedAt       time.Time
+	ClientVersion  string
+	Origin         string
+	PodName        string
+	Disconnected   bool
+	DisconnectedAt time.Time
+}
+
+// BuildConnectorStatuses maps a tunnel's raw connection inventory onto
+// ConnectorStatus, resolving each connector's PodName via
+// connectorIDToPodName (built by the caller from a connector-ID pod
+// annotation), so a connector with no matching pod is left with an empty
+// PodName.
+func BuildConnectorStatuses(connections []Connection, connectorIDToPodName map[string]string) []ConnectorStatus {
+	statuses := make([]ConnectorStatus, 0, len(connections))
+	for _, conn := range connections {
+		statuses = append(statuses, ConnectorStatus{
+			ID:             conn.ID,
+			ColoName:       conn.ColoName,
+			OpenedAt:       conn.OpenedAt,
+			ClientVersion:  conn.ClientVersion,
+			Origin:         conn.Origin,
+			PodName:        connectorIDToPodName[conn.ID],
+			Disconnected:   conn.Disconnected,
+			DisconnectedAt: conn.DisconnectedAt,
+		})
+	}
+	return statuses
+}
+
+// ActiveConnectionCount counts connectors that aren't in the
+// recently-disconnected state, for the status.activeConnections printcolumn
+// and TunnelHealthy condition.
+func ActiveConnectionCount(statuses []ConnectorStatus) int {
+	count := 0
+	for _, s := range statuses {
+		if !s.Disconnected {
+			count++
+		}
+	}
+	return count
+}
+
+// TunnelMode is how a tunnel's credentials are provisioned: either this
+// operator owns the tunnel's lifecycle and ingress via the Cloudflare API
+// (APIManaged), or the tunnel was created out-of-band and cloudflared runs
+// purely off a TUNNEL_TOKEN, with ingress managed in Cloudflare's dashboard
+// (RemotelyManaged).
+type TunnelMode string
+
+const (
+	// TunnelModeAPIManaged is the default mode: the operator creates/adopts
+	// the tunnel and pushes ingress configuration via the Cloudflare API.
+	TunnelModeAPIManaged TunnelMode = "APIManaged"
+
+	// TunnelModeRemotelyManaged skips all Cloudflare API calls; cloudflared
+	// is handed a token and reads its ingress from Cloudflare's dashboard.
+	TunnelModeRemotelyManaged TunnelMode = "RemotelyManaged"
+)
+
+// ErrConflictingTunnelCredentials is returned by ResolveTunnelMode when both
+// an API-token secretRef and a tunnel tokenSecretRef are set: the two
+// provisioning paths are mutually exclusive.
+var ErrConflictingTunnelCredentials = fmt.Errorf("spec.cloudflare.secretRef and spec.tunnel.tokenSecretRef are mutually exclusive")
+
+// ResolveTunnelMode decides a tunnel's TunnelMode from which of its two
+// mutually exclusive credential refs is set. At most one may be set, and
+// with neither set the mode defaults to APIManaged; setting both is rejected
+// here so a validating webhook and the reconciler share one source of truth
+// for the mutual-exclusion rule.
+func ResolveTunnelMode(hasCloudflareSecretRef, hasTunnelTokenSecretRef bool) (TunnelMode, error) {
+	switch {
+	case hasCloudflareSecretRef && hasTunnelTokenSecretRef:
+		return "", ErrConflictingTunnelCredentials
+	case hasTunnelTokenSecretRef:
+		return TunnelModeRemotelyManaged, nil
+	default:
+		return TunnelModeAPIManaged, nil
+	}
+}
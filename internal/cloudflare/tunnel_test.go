@@ -0,0 +1,152 @@
+package cloudflare_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gomock "github.com/golang/mock/gomock"
+
+	"cfgate.io/cfgate/internal/cloudflare"
+	"cfgate.io/cfgate/internal/cloudflare/mocks"
+)
+
+func TestSyncIngress_RemoveDropsHostnameNoLongerWanted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	api := mocks.NewMockCloudflareAPI(ctrl)
+	svc := cloudflare.NewTunnelService(api)
+
+	api.EXPECT().GetTunnelConfiguration(gomock.Any(), "tunnel-1").Return(&cloudflare.TunnelIngressConfig{
+		Ingress: []cloudflare.TunnelIngressRule{
+			{Hostname: "kept.example.com", Service: "http://kept"},
+			{Hostname: "gone.example.com", Service: "http://gone"},
+			{Service: "http_status:404"},
+		},
+	}, nil)
+
+	var updated cloudflare.TunnelIngressConfig
+	api.EXPECT().UpdateTunnelConfiguration(gomock.Any(), "tunnel-1", gomock.Any()).DoAndReturn(
+		func(ctx context.Context, tunnelID string, config cloudflare.TunnelIngressConfig) error {
+			updated = config
+			return nil
+		})
+
+	desired := []cloudflare.TunnelIngressRule{{Hostname: "kept.example.com", Service: "http://kept"}}
+	modified, err := svc.SyncIngress(context.Background(), "tunnel-1", desired, []string{"gone.example.com"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !modified {
+		t.Fatal("expected configuration to be modified")
+	}
+	for _, rule := range updated.Ingress {
+		if rule.Hostname == "gone.example.com" {
+			t.Fatal("expected gone.example.com's ingress rule to be removed")
+		}
+	}
+}
+
+func TestShouldTakeover_Disabled(t *testing.T) {
+	if cloudflare.ShouldTakeover(cloudflare.TakeoverDisabled, []string{"foreign-1"}, nil) {
+		t.Fatal("Disabled must never take over")
+	}
+}
+
+func TestShouldTakeover_Always(t *testing.T) {
+	if !cloudflare.ShouldTakeover(cloudflare.TakeoverAlways, []string{"foreign-1"}, []string{"own-1"}) {
+		t.Fatal("Always must take over whenever any connector is active")
+	}
+	if cloudflare.ShouldTakeover(cloudflare.TakeoverAlways, nil, nil) {
+		t.Fatal("Always must not take over when there are no active connectors")
+	}
+}
+
+func TestShouldTakeover_IfStale(t *testing.T) {
+	cases := []struct {
+		name       string
+		connectors []string
+		own        []string
+		want       bool
+	}{
+		{"no connectors", nil, []string{"own-1"}, false},
+		{"only own connectors", []string{"own-1"}, []string{"own-1"}, false},
+		{"mixed own and foreign", []string{"own-1", "foreign-1"}, []string{"own-1"}, false},
+		{"only foreign connectors", []string{"foreign-1"}, []string{"own-1"}, true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := cloudflare.ShouldTakeover(cloudflare.TakeoverIfStale, tc.connectors, tc.own)
+			if got != tc.want {
+				t.Fatalf("ShouldTakeover(IfStale, %v, %v) = %v, want %v", tc.connectors, tc.own, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestHasForeignConnectors(t *testing.T) {
+	if cloudflare.HasForeignConnectors([]string{"own-1"}, []string{"own-1"}) {
+		t.Fatal("expected no foreign connectors when all connectors are owned")
+	}
+	if !cloudflare.HasForeignConnectors([]string{"own-1", "foreign-1"}, []string{"own-1"}) {
+		t.Fatal("expected a foreign connector to be detected")
+	}
+}
+
+func TestBuildConnectorStatuses(t *testing.T) {
+	opened := time.Now().Add(-time.Hour)
+	disconnectedAt := time.Now().Add(-time.Minute)
+
+	connections := []cloudflare.Connection{
+		{ID: "conn-1", ColoName: "SJC", OpenedAt: opened, ClientVersion: "2024.1.0"},
+		{ID: "conn-2", ColoName: "LHR", Disconnected: true, DisconnectedAt: disconnectedAt},
+	}
+	podByConnector := map[string]string{"conn-1": "cloudflared-abc"}
+
+	statuses := cloudflare.BuildConnectorStatuses(connections, podByConnector)
+	if len(statuses) != 2 {
+		t.Fatalf("expected 2 statuses, got %d", len(statuses))
+	}
+	if statuses[0].PodName != "cloudflared-abc" {
+		t.Fatalf("expected conn-1 resolved to its pod, got %q", statuses[0].PodName)
+	}
+	if statuses[1].PodName != "" {
+		t.Fatalf("expected conn-2 to have no resolved pod, got %q", statuses[1].PodName)
+	}
+	if !statuses[1].Disconnected {
+		t.Fatal("expected conn-2 to be marked disconnected")
+	}
+
+	if got := cloudflare.ActiveConnectionCount(statuses); got != 1 {
+		t.Fatalf("expected 1 active connection, got %d", got)
+	}
+}
+
+func TestResolveTunnelMode(t *testing.T) {
+	mode, err := cloudflare.ResolveTunnelMode(true, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mode != cloudflare.TunnelModeAPIManaged {
+		t.Fatalf("expected APIManaged, got %v", mode)
+	}
+
+	mode, err = cloudflare.ResolveTunnelMode(false, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mode != cloudflare.TunnelModeRemotelyManaged {
+		t.Fatalf("expected RemotelyManaged, got %v", mode)
+	}
+
+	mode, err = cloudflare.ResolveTunnelMode(false, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mode != cloudflare.TunnelModeAPIManaged {
+		t.Fatalf("expected APIManaged as the default, got %v", mode)
+	}
+
+	if _, err := cloudflare.ResolveTunnelMode(true, true); err != cloudflare.ErrConflictingTunnelCredentials {
+		t.Fatalf("expected ErrConflictingTunnelCredentials, got %v", err)
+	}
+}
@@ -0,0 +1,99 @@
+// Package cloudflare provides a wrapper around cloudflare-go for cfgate's needs.
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+)
+
+// VirtualNetwork represents a Cloudflare WARP virtual network, which scopes
+// tunnel routes so overlapping RFC1918 CIDRs can be served to different
+// WARP client populations without colliding.
+type VirtualNetwork struct {
+	ID        string
+	Name      string
+	Comment   string
+	IsDefault bool
+}
+
+// VirtualNetworkService manages Cloudflare virtual networks.
+type VirtualNetworkService struct {
+	// client is the underlying Cloudflare client.
+	client Client
+}
+
+// NewVirtualNetworkService creates a new VirtualNetworkService.
+func NewVirtualNetworkService(client Client) *VirtualNetworkService {
+	return &VirtualNetworkService{client: client}
+}
+
+// EnsureVirtualNetwork finds the named virtual network, adopting it if it
+// already exists (same list-by-name pattern used for tunnel adoption), or
+// creating it otherwise. isDefault is only applied on creation; an existing
+// vnet's default flag is left to SetDefault, since flipping it is a
+// dedicated atomic operation.
+func (s *VirtualNetworkService) EnsureVirtualNetwork(ctx context.Context, accountID string, desired VirtualNetwork) (*VirtualNetwork, error) {
+	vnets, err := s.client.ListVirtualNetworks(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list virtual networks: %w", err)
+	}
+
+	for _, vnet := range vnets {
+		if vnet.Name == desired.Name {
+			return &vnet, nil
+		}
+	}
+
+	created, err := s.client.CreateVirtualNetwork(ctx, accountID, desired)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create virtual network %s: %w", desired.Name, err)
+	}
+
+	return created, nil
+}
+
+// UpdateComment updates a virtual network's comment if it differs from
+// current.
+func (s *VirtualNetworkService) UpdateComment(ctx context.Context, accountID string, vnet *VirtualNetwork, comment string) (*VirtualNetwork, error) {
+	if vnet.Comment == comment {
+		return vnet, nil
+	}
+
+	updated := *vnet
+	updated.Comment = comment
+	result, err := s.client.UpdateVirtualNetwork(ctx, accountID, vnet.ID, updated)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update virtual network %s: %w", vnet.Name, err)
+	}
+
+	return result, nil
+}
+
+// SetDefault flips vnet's default flag to isDefault. Cloudflare only allows
+// one default virtual network per account, so setting isDefault=true
+// atomically clears it from whichever other vnet currently holds it as part
+// of the same API call.
+func (s *VirtualNetworkService) SetDefault(ctx context.Context, accountID string, vnet *VirtualNetwork, isDefault bool) (*VirtualNetwork, error) {
+	if vnet.IsDefault == isDefault {
+		return vnet, nil
+	}
+
+	updated := *vnet
+	updated.IsDefault = isDefault
+	result, err := s.client.UpdateVirtualNetwork(ctx, accountID, vnet.ID, updated)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set default=%t on virtual network %s: %w", isDefault, vnet.Name, err)
+	}
+
+	return result, nil
+}
+
+// DeleteVirtualNetwork deletes a virtual network by ID. A 404 is treated as
+// already-deleted.
+func (s *VirtualNetworkService) DeleteVirtualNetwork(ctx context.Context, accountID, vnetID string) error {
+	err := s.client.DeleteVirtualNetwork(ctx, accountID, vnetID)
+	if err != nil && !IsNotFoundError(err) {
+		return fmt.Errorf("failed to delete virtual network: %w", err)
+	}
+	return nil
+}
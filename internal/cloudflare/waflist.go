@@ -0,0 +1,126 @@
+// Package cloudflare provides a wrapper around cloudflare-go for cfgate's needs.
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// WAFList represents a Cloudflare account-scoped WAF list (IP, ASN, or hostname).
+type WAFList struct {
+	ID          string
+	Name        string
+	Kind        string
+	Description string
+}
+
+// WAFListItem represents a single value in a WAF list, e.g. a CIDR, ASN, or
+// hostname depending on the list's Kind.
+type WAFListItem struct {
+	// ID is the Cloudflare item ID, assigned on creation. Empty for items not
+	// yet created.
+	ID      string
+	Value   string
+	Comment string
+}
+
+// WAFListService manages Cloudflare WAF Lists via the Rules API.
+type WAFListService struct {
+	// client is the underlying Cloudflare client.
+	client Client
+}
+
+// NewWAFListService creates a new WAFListService.
+func NewWAFListService(client Client) *WAFListService {
+	return &WAFListService{client: client}
+}
+
+// EnsureList finds the named WAF list, creating it if it doesn't exist.
+func (s *WAFListService) EnsureList(ctx context.Context, accountID, name, kind, description string) (*WAFList, error) {
+	lists, err := s.client.ListWAFLists(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list WAF lists: %w", err)
+	}
+
+	for _, list := range lists {
+		if list.Name == name {
+			return &list, nil
+		}
+	}
+
+	created, err := s.client.CreateWAFList(ctx, accountID, WAFList{Name: name, Kind: kind, Description: description})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create WAF list %s: %w", name, err)
+	}
+
+	return created, nil
+}
+
+// ItemOwnershipComment returns the comment cfgate writes onto every list item
+// it creates, so SyncItems can tell cfgate-managed items apart from ones
+// added outside the operator.
+func ItemOwnershipComment(prefix string) string {
+	return fmt.Sprintf("managed by cfgate (%s)", prefix)
+}
+
+// IsOwnedWAFItem reports whether item carries cfgate's ownership comment for prefix.
+func IsOwnedWAFItem(item WAFListItem, prefix string) bool {
+	return strings.Contains(item.Comment, ItemOwnershipComment(prefix))
+}
+
+// SyncItems reconciles a WAF list's items to match desired: cfgate-owned
+// items (identified via IsOwnedWAFItem) not in desired are bulk-deleted,
+// desired values missing from the list are bulk-created with the ownership
+// comment, and items with no ownership comment (added outside the operator)
+// are left untouched. Returns the number of items successfully synced
+// (created or already present) and failed.
+func (s *WAFListService) SyncItems(ctx context.Context, accountID, listID, ownershipPrefix string, desired []string) (synced, failed int, err error) {
+	current, err := s.client.ListWAFListItems(ctx, accountID, listID)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to list WAF list items: %w", err)
+	}
+
+	currentByValue := make(map[string]WAFListItem, len(current))
+	for _, item := range current {
+		currentByValue[item.Value] = item
+	}
+
+	desiredSet := make(map[string]struct{}, len(desired))
+	for _, value := range desired {
+		desiredSet[value] = struct{}{}
+	}
+
+	var toDeleteIDs []string
+	for _, item := range current {
+		if _, wanted := desiredSet[item.Value]; wanted {
+			continue
+		}
+		if !IsOwnedWAFItem(item, ownershipPrefix) {
+			continue // not ours to touch
+		}
+		toDeleteIDs = append(toDeleteIDs, item.ID)
+	}
+	if len(toDeleteIDs) > 0 {
+		if err := s.client.DeleteWAFListItems(ctx, accountID, listID, toDeleteIDs); err != nil {
+			return 0, 0, fmt.Errorf("failed to bulk-delete WAF list items: %w", err)
+		}
+	}
+
+	var toCreate []WAFListItem
+	for _, value := range desired {
+		if _, exists := currentByValue[value]; exists {
+			synced++
+			continue
+		}
+		toCreate = append(toCreate, WAFListItem{Value: value, Comment: ItemOwnershipComment(ownershipPrefix)})
+	}
+	if len(toCreate) > 0 {
+		if err := s.client.CreateWAFListItems(ctx, accountID, listID, toCreate); err != nil {
+			return synced, len(toCreate), fmt.Errorf("failed to bulk-create WAF list items: %w", err)
+		}
+		synced += len(toCreate)
+	}
+
+	return synced, failed, nil
+}
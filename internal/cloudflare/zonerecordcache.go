@@ -0,0 +1,60 @@
+// Package cloudflare provides a wrapper around cloudflare-go for cfgate's needs.
+package cloudflare
+
+import (
+	"sync"
+	"time"
+)
+
+// ZoneRecordCache caches each zone's full DNS record list for a short TTL.
+// Share one instance across the DNSServices built for a zone's reconciles
+// (see DNSService.WithRecordCache) so a reconcile with many hostnames - each
+// of which calls FindRecordByName/FindRecordsByName/ListManagedRecords -
+// issues at most one ListDNSRecords call per zone instead of one per
+// hostname, keeping well clear of Cloudflare's per-account rate limit.
+type ZoneRecordCache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[string]zoneRecordCacheEntry
+}
+
+type zoneRecordCacheEntry struct {
+	records   []DNSRecord
+	expiresAt time.Time
+}
+
+// NewZoneRecordCache creates a ZoneRecordCache whose entries are served for
+// up to ttl before a cached read falls back to a fresh ListDNSRecords call.
+func NewZoneRecordCache(ttl time.Duration) *ZoneRecordCache {
+	return &ZoneRecordCache{ttl: ttl, entries: make(map[string]zoneRecordCacheEntry)}
+}
+
+// get returns zoneID's cached record list, if present and not yet expired.
+func (c *ZoneRecordCache) get(zoneID string) ([]DNSRecord, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[zoneID]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil, false
+	}
+	return entry.records, true
+}
+
+// set caches records for zoneID until the configured TTL elapses.
+func (c *ZoneRecordCache) set(zoneID string, records []DNSRecord) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[zoneID] = zoneRecordCacheEntry{records: records, expiresAt: time.Now().Add(c.ttl)}
+}
+
+// Invalidate drops zoneID's cached record list, so the next read re-fetches
+// from Cloudflare. DNSService calls this itself after any mutation, so
+// callers only need it when a record changes through some other path (e.g.
+// directly via the underlying CloudflareAPI).
+func (c *ZoneRecordCache) Invalidate(zoneID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, zoneID)
+}
@@ -0,0 +1,46 @@
+// Package cloudflared provides utilities for managing cloudflared Kubernetes resources.
+package cloudflared
+
+import (
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cfgatev1alpha1 "cfgate.io/cfgate/api/v1alpha1"
+)
+
+// BuildHorizontalPodAutoscaler creates an autoscaling/v2 HPA targeting the
+// cloudflared Deployment, combining resource metrics (CPU/memory) with
+// External metrics scraped from cloudflared's own Prometheus endpoint (e.g.
+// cloudflared_tunnel_active_streams, cloudflared_tunnel_request_errors,
+// quic_active_sessions) via Spec.Cloudflared.Autoscaling.Metrics. Returns nil
+// if Spec.Cloudflared.Autoscaling isn't set, since an HPA's presence is what
+// tells the reconciler to stop reconciling Spec.Cloudflared.Replicas.
+func (b *DefaultBuilder) BuildHorizontalPodAutoscaler(tunnel *cfgatev1alpha1.CloudflareTunnel) *autoscalingv2.HorizontalPodAutoscaler {
+	autoscaling := tunnel.Spec.Cloudflared.Autoscaling
+	if autoscaling == nil {
+		return nil
+	}
+
+	metrics := make([]autoscalingv2.MetricSpec, 0, len(autoscaling.Metrics))
+	for _, m := range autoscaling.Metrics {
+		metrics = append(metrics, m)
+	}
+
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      DeploymentName(tunnel.Name),
+			Namespace: tunnel.Namespace,
+			Labels:    Labels(tunnel.Name),
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       DeploymentName(tunnel.Name),
+			},
+			MinReplicas: autoscaling.MinReplicas,
+			MaxReplicas: autoscaling.MaxReplicas,
+			Metrics:     metrics,
+		},
+	}
+}
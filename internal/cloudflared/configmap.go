@@ -49,7 +49,9 @@ type IngressRule struct {
 	// Path is the path regex to match.
 	Path string `yaml:"path,omitempty"`
 
-	// Service is the origin service URL.
+	// Service is the origin service URL. Besides the usual http:// and
+	// https://, cloudflared also accepts h2c:// and grpc:// for gRPC origins
+	// and tcp:// / tls:// for TCPRoute/TLSRoute passthrough origins.
 	Service string `yaml:"service"`
 
 	// OriginRequest contains per-rule origin settings.
@@ -90,6 +92,14 @@ func NewTunnelConfig(tunnel *cfgatev1alpha1.CloudflareTunnel, tunnelID string) *
 		Ingress:      []IngressRule{},
 	}
 
+	if tunnelMode(tunnel) == TunnelModeLocal {
+		config.CredentialsFile = fmt.Sprintf("%s/%s", CredentialsMountPath, CredentialsSecretKey)
+	}
+
+	if tunnel.Spec.Cloudflared.WarpRouting != nil && tunnel.Spec.Cloudflared.WarpRouting.Enabled {
+		config.WarpRouting = &WarpRoutingConfig{Enabled: true}
+	}
+
 	// Set protocol if specified
 	if tunnel.Spec.Cloudflared.Protocol != "" && tunnel.Spec.Cloudflared.Protocol != "auto" {
 		config.Protocol = tunnel.Spec.Cloudflared.Protocol
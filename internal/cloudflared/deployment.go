@@ -5,11 +5,15 @@ import (
 	"fmt"
 
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+
 	cfgatev1alpha1 "cfgate.io/cfgate/api/v1alpha1"
 )
 
@@ -25,13 +29,63 @@ const (
 
 	// TokenSecretKey is the key in the secret containing the token.
 	TokenSecretKey = "token"
+
+	// CABundleMountPath is where the CA bundle Secret (materialized from
+	// BackendTLSPolicy CACertificateRefs) is mounted in the cloudflared
+	// container. OriginRequestConfig.CAPool entries point at PEM files
+	// under this path.
+	CABundleMountPath = "/etc/cloudflared/ca-certs"
+
+	// caBundleVolumeName is the Pod volume name for the CA bundle mount.
+	caBundleVolumeName = "ca-certs"
+
+	// ConfigMountPath is where TunnelConfig's rendered config.yaml (see
+	// BuildConfigMap) is mounted in TunnelModeLocal, so buildArgs' --config
+	// flag and the ConfigMap agree on where to find it.
+	ConfigMountPath = "/etc/cloudflared/config.yaml"
+
+	// configVolumeName is the Pod volume name for the ConfigMap mount.
+	configVolumeName = "config"
+
+	// CredentialsMountPath is where the credentials Secret built by
+	// BuildCredentialsSecret is mounted in TunnelModeLocal.
+	CredentialsMountPath = "/etc/cloudflared/creds"
+
+	// CredentialsSecretKey is the key in the credentials Secret holding the
+	// tunnel's credentials JSON (AccountTag/TunnelSecret/TunnelID).
+	CredentialsSecretKey = "credentials.json"
+
+	// credentialsVolumeName is the Pod volume name for the credentials mount.
+	credentialsVolumeName = "creds"
+)
+
+// TunnelMode selects how the cloudflared container in BuildDeployment
+// authenticates and loads its ingress. It's a separate axis from
+// cloudflare.TunnelMode (which decides who provisions the Cloudflare-side
+// tunnel resource): TunnelModeRemote always runs purely off TUNNEL_TOKEN
+// regardless of how that token was provisioned, while TunnelModeLocal runs
+// from a mounted config file plus a credentials file, so the ingress rules
+// TunnelConfigAssembler renders into the ConfigMap actually take effect in
+// the running process instead of being ignored in favor of Cloudflare's
+// dashboard-managed ingress.
+type TunnelMode string
+
+const (
+	// TunnelModeRemote is the default: cloudflared runs "tunnel run --token
+	// $(TUNNEL_TOKEN)" and takes its ingress from Cloudflare's edge.
+	TunnelModeRemote TunnelMode = "remote"
+
+	// TunnelModeLocal runs cloudflared from a mounted ConfigMap and
+	// credentials Secret instead of a token.
+	TunnelModeLocal TunnelMode = "local"
 )
 
 // Builder creates Kubernetes resources for cloudflared deployments.
 type Builder interface {
-	// BuildDeployment creates a Deployment for cloudflared.
-	// The deployment uses the tunnel token for authentication.
-	BuildDeployment(tunnel *cfgatev1alpha1.CloudflareTunnel, token string) *appsv1.Deployment
+	// BuildDeployment creates a Deployment for cloudflared. Authenticates
+	// with token (TunnelModeRemote, the default) or, in TunnelModeLocal,
+	// with a mounted ConfigMap and credentials Secret instead.
+	BuildDeployment(tunnel *cfgatev1alpha1.CloudflareTunnel, token, caBundleSecretName string) *appsv1.Deployment
 
 	// BuildConfigMap creates a ConfigMap for cloudflared configuration.
 	// This is used when running with a config file instead of remote config.
@@ -39,6 +93,36 @@ type Builder interface {
 
 	// BuildTokenSecret creates a Secret containing the tunnel token.
 	BuildTokenSecret(tunnel *cfgatev1alpha1.CloudflareTunnel, token string) *corev1.Secret
+
+	// BuildCredentialsSecret creates a Secret containing the tunnel
+	// credentials JSON cloudflared needs to run in TunnelModeLocal. Used
+	// instead of BuildTokenSecret when the tunnel is locally-managed.
+	BuildCredentialsSecret(tunnel *cfgatev1alpha1.CloudflareTunnel, credentialsJSON []byte) *corev1.Secret
+
+	// BuildCABundleSecret creates a Secret holding the CA certificate PEM
+	// bundles referenced by BackendTLSPolicy resources (keyed by file name,
+	// e.g. "<policy-name>.pem"). Mounted into the cloudflared container at
+	// CABundleMountPath.
+	BuildCABundleSecret(tunnel *cfgatev1alpha1.CloudflareTunnel, bundles map[string][]byte) *corev1.Secret
+
+	// BuildPodDisruptionBudget creates a PodDisruptionBudget for the
+	// cloudflared Deployment, keeping at least minAvailable replicas up
+	// during voluntary disruptions (e.g. node drains) so a tunnel is never
+	// taken fully offline by maintenance.
+	BuildPodDisruptionBudget(tunnel *cfgatev1alpha1.CloudflareTunnel) *policyv1.PodDisruptionBudget
+
+	// BuildMetricsService creates a headless Service fronting the
+	// cloudflared pods' metrics port, for BuildServiceMonitor to target.
+	BuildMetricsService(tunnel *cfgatev1alpha1.CloudflareTunnel) *corev1.Service
+
+	// BuildServiceMonitor creates a prometheus-operator ServiceMonitor
+	// scraping the Service from BuildMetricsService. Only call this when
+	// Spec.Cloudflared.Metrics.ServiceMonitor.Enabled is set.
+	BuildServiceMonitor(tunnel *cfgatev1alpha1.CloudflareTunnel) *monitoringv1.ServiceMonitor
+
+	// BuildHorizontalPodAutoscaler creates an HPA targeting the cloudflared
+	// Deployment, or nil if Spec.Cloudflared.Autoscaling isn't set.
+	BuildHorizontalPodAutoscaler(tunnel *cfgatev1alpha1.CloudflareTunnel) *autoscalingv2.HorizontalPodAutoscaler
 }
 
 // DefaultBuilder is the default implementation of Builder.
@@ -54,9 +138,17 @@ func NewBuilder() *DefaultBuilder {
 // - Proper labels for selection
 // - Resource limits and requests
 // - Liveness and readiness probes
-// - Token-based authentication
+// - Token-based or, in TunnelModeLocal, config-file/credentials-file authentication
 // - Metrics endpoint configuration
-func (b *DefaultBuilder) BuildDeployment(tunnel *cfgatev1alpha1.CloudflareTunnel, token string) *appsv1.Deployment {
+// - Topology spread constraints and pod anti-affinity, so replicas land on
+//   different nodes/zones (overridable via Spec.Cloudflared.TopologySpreadConstraints
+//   and Spec.Cloudflared.Affinity; see also BuildPodDisruptionBudget)
+// caBundleSecretName, if non-empty, mounts that Secret (see
+// BuildCABundleSecret) read-only at CABundleMountPath so OriginRequestConfig
+// CAPool entries resolve to real files in the container. In TunnelModeLocal,
+// the ConfigMap (see BuildConfigMap) and credentials Secret (see
+// BuildCredentialsSecret) are mounted instead of wiring up TokenEnvVar.
+func (b *DefaultBuilder) BuildDeployment(tunnel *cfgatev1alpha1.CloudflareTunnel, token, caBundleSecretName string) *appsv1.Deployment {
 	labels := Labels(tunnel.Name)
 	selector := Selector(tunnel.Name)
 	tokenSecretName := TokenSecretName(tunnel.Name)
@@ -67,11 +159,58 @@ func (b *DefaultBuilder) BuildDeployment(tunnel *cfgatev1alpha1.CloudflareTunnel
 	}
 
 	container := buildContainer(tunnel, tokenSecretName)
-	liveness, readiness := buildProbes(getMetricsPort(tunnel))
+	liveness, readiness := buildProbes(tunnel)
 
 	container.LivenessProbe = liveness
 	container.ReadinessProbe = readiness
 
+	var volumes []corev1.Volume
+	if caBundleSecretName != "" {
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      caBundleVolumeName,
+			MountPath: CABundleMountPath,
+			ReadOnly:  true,
+		})
+		volumes = append(volumes, corev1.Volume{
+			Name: caBundleVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{SecretName: caBundleSecretName},
+			},
+		})
+	}
+
+	if tunnelMode(tunnel) == TunnelModeLocal {
+		container.VolumeMounts = append(container.VolumeMounts,
+			corev1.VolumeMount{
+				Name:      configVolumeName,
+				MountPath: ConfigMountPath,
+				SubPath:   "config.yaml",
+				ReadOnly:  true,
+			},
+			corev1.VolumeMount{
+				Name:      credentialsVolumeName,
+				MountPath: CredentialsMountPath,
+				ReadOnly:  true,
+			},
+		)
+		volumes = append(volumes,
+			corev1.Volume{
+				Name: configVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{
+						LocalObjectReference: corev1.LocalObjectReference{Name: ConfigMapName(tunnel.Name)},
+					},
+				},
+			},
+			corev1.Volume{
+				Name: credentialsVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{SecretName: CredentialsSecretName(tunnel.Name)},
+				},
+			},
+		)
+	}
+
 	// Merge pod annotations from spec
 	podAnnotations := map[string]string{}
 	for k, v := range tunnel.Spec.Cloudflared.PodAnnotations {
@@ -85,7 +224,15 @@ func (b *DefaultBuilder) BuildDeployment(tunnel *cfgatev1alpha1.CloudflareTunnel
 			Labels:    labels,
 		},
 		Spec: appsv1.DeploymentSpec{
-			Replicas: &replicas,
+			Replicas:        &replicas,
+			MinReadySeconds: 10,
+			Strategy: appsv1.DeploymentStrategy{
+				Type: appsv1.RollingUpdateDeploymentStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateDeployment{
+					MaxSurge:       ptrFromIntOrString(intstr.FromString("25%")),
+					MaxUnavailable: ptrFromIntOrString(intstr.FromInt32(0)),
+				},
+			},
 			Selector: &metav1.LabelSelector{
 				MatchLabels: selector,
 			},
@@ -96,6 +243,7 @@ func (b *DefaultBuilder) BuildDeployment(tunnel *cfgatev1alpha1.CloudflareTunnel
 				},
 				Spec: corev1.PodSpec{
 					Containers: []corev1.Container{container},
+					Volumes:    volumes,
 				},
 			},
 		},
@@ -111,9 +259,69 @@ func (b *DefaultBuilder) BuildDeployment(tunnel *cfgatev1alpha1.CloudflareTunnel
 		deployment.Spec.Template.Spec.Tolerations = tunnel.Spec.Cloudflared.Tolerations
 	}
 
+	if len(tunnel.Spec.Cloudflared.TopologySpreadConstraints) > 0 {
+		deployment.Spec.Template.Spec.TopologySpreadConstraints = tunnel.Spec.Cloudflared.TopologySpreadConstraints
+	} else {
+		deployment.Spec.Template.Spec.TopologySpreadConstraints = defaultTopologySpreadConstraints(selector)
+	}
+
+	if tunnel.Spec.Cloudflared.Affinity != nil {
+		deployment.Spec.Template.Spec.Affinity = tunnel.Spec.Cloudflared.Affinity
+	} else {
+		deployment.Spec.Template.Spec.Affinity = defaultAntiAffinity(selector)
+	}
+
 	return deployment
 }
 
+// defaultTopologySpreadConstraints spreads cloudflared pods evenly across
+// nodes and zones (soft: ScheduleAnyway) so a single node or zone outage
+// doesn't take down every replica at once.
+func defaultTopologySpreadConstraints(selector map[string]string) []corev1.TopologySpreadConstraint {
+	labelSelector := &metav1.LabelSelector{MatchLabels: selector}
+	return []corev1.TopologySpreadConstraint{
+		{
+			MaxSkew:           1,
+			TopologyKey:       "kubernetes.io/hostname",
+			WhenUnsatisfiable: corev1.ScheduleAnyway,
+			LabelSelector:     labelSelector,
+		},
+		{
+			MaxSkew:           1,
+			TopologyKey:       "topology.kubernetes.io/zone",
+			WhenUnsatisfiable: corev1.ScheduleAnyway,
+			LabelSelector:     labelSelector,
+		},
+	}
+}
+
+// defaultAntiAffinity prefers (but doesn't require) scheduling cloudflared
+// pods onto different nodes and zones from each other, complementing the
+// topology spread constraints above.
+func defaultAntiAffinity(selector map[string]string) *corev1.Affinity {
+	labelSelector := &metav1.LabelSelector{MatchLabels: selector}
+	return &corev1.Affinity{
+		PodAntiAffinity: &corev1.PodAntiAffinity{
+			PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
+				{
+					Weight: 100,
+					PodAffinityTerm: corev1.PodAffinityTerm{
+						LabelSelector: labelSelector,
+						TopologyKey:   "kubernetes.io/hostname",
+					},
+				},
+				{
+					Weight: 50,
+					PodAffinityTerm: corev1.PodAffinityTerm{
+						LabelSelector: labelSelector,
+						TopologyKey:   "topology.kubernetes.io/zone",
+					},
+				},
+			},
+		},
+	}
+}
+
 // BuildConfigMap creates a ConfigMap for cloudflared configuration.
 // This is used when running with a config file instead of remote config.
 func (b *DefaultBuilder) BuildConfigMap(tunnel *cfgatev1alpha1.CloudflareTunnel, config *TunnelConfig) *corev1.ConfigMap {
@@ -146,11 +354,82 @@ func (b *DefaultBuilder) BuildTokenSecret(tunnel *cfgatev1alpha1.CloudflareTunne
 	}
 }
 
+// BuildCredentialsSecret creates a Secret containing the tunnel credentials
+// JSON (as returned by the Cloudflare API on tunnel creation) cloudflared
+// reads via --credentials-file in TunnelModeLocal.
+func (b *DefaultBuilder) BuildCredentialsSecret(tunnel *cfgatev1alpha1.CloudflareTunnel, credentialsJSON []byte) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      CredentialsSecretName(tunnel.Name),
+			Namespace: tunnel.Namespace,
+			Labels:    Labels(tunnel.Name),
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			CredentialsSecretKey: credentialsJSON,
+		},
+	}
+}
+
+// BuildCABundleSecret creates a Secret holding CA certificate PEM bundles
+// resolved from BackendTLSPolicy CACertificateRefs, keyed by file name so
+// each policy's bundle can be addressed independently under
+// CABundleMountPath.
+func (b *DefaultBuilder) BuildCABundleSecret(tunnel *cfgatev1alpha1.CloudflareTunnel, bundles map[string][]byte) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      CABundleSecretName(tunnel.Name),
+			Namespace: tunnel.Namespace,
+			Labels:    Labels(tunnel.Name),
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: bundles,
+	}
+}
+
+// BuildPodDisruptionBudget creates a PodDisruptionBudget for the cloudflared
+// Deployment. minAvailable defaults to replicas-1 (minimum 1) unless
+// tunnel.Spec.Cloudflared.PDB.MinAvailable overrides it, keeping at least one
+// replica of headroom during a voluntary disruption.
+func (b *DefaultBuilder) BuildPodDisruptionBudget(tunnel *cfgatev1alpha1.CloudflareTunnel) *policyv1.PodDisruptionBudget {
+	replicas := tunnel.Spec.Cloudflared.Replicas
+	if replicas == 0 {
+		replicas = 2
+	}
+
+	minAvailable := intstr.FromInt32(replicas - 1)
+	if replicas-1 < 1 {
+		minAvailable = intstr.FromInt32(1)
+	}
+	if tunnel.Spec.Cloudflared.PDB.MinAvailable != nil {
+		minAvailable = *tunnel.Spec.Cloudflared.PDB.MinAvailable
+	}
+
+	return &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      PDBName(tunnel.Name),
+			Namespace: tunnel.Namespace,
+			Labels:    Labels(tunnel.Name),
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MinAvailable: &minAvailable,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: Selector(tunnel.Name),
+			},
+		},
+	}
+}
+
 // DeploymentName returns the name for the cloudflared Deployment.
 func DeploymentName(tunnelName string) string {
 	return tunnelName + "-cloudflared"
 }
 
+// CABundleSecretName returns the name for the materialized CA bundle Secret.
+func CABundleSecretName(tunnelName string) string {
+	return tunnelName + "-cloudflared-ca-bundle"
+}
+
 // ConfigMapName returns the name for the cloudflared ConfigMap.
 func ConfigMapName(tunnelName string) string {
 	return tunnelName + "-cloudflared-config"
@@ -161,6 +440,26 @@ func TokenSecretName(tunnelName string) string {
 	return tunnelName + "-tunnel-token"
 }
 
+// CredentialsSecretName returns the name for the tunnel credentials Secret
+// used in TunnelModeLocal.
+func CredentialsSecretName(tunnelName string) string {
+	return tunnelName + "-tunnel-credentials"
+}
+
+// PDBName returns the name for the cloudflared PodDisruptionBudget.
+func PDBName(tunnelName string) string {
+	return tunnelName + "-cloudflared"
+}
+
+// tunnelMode returns tunnel's configured TunnelMode, defaulting to
+// TunnelModeRemote when unset.
+func tunnelMode(tunnel *cfgatev1alpha1.CloudflareTunnel) TunnelMode {
+	if tunnel.Spec.Cloudflared.TunnelMode == "" {
+		return TunnelModeRemote
+	}
+	return TunnelMode(tunnel.Spec.Cloudflared.TunnelMode)
+}
+
 // Labels returns the standard labels for cloudflared resources.
 func Labels(tunnelName string) map[string]string {
 	return map[string]string{
@@ -199,28 +498,29 @@ func buildContainer(tunnel *cfgatev1alpha1.CloudflareTunnel, tokenSecretName str
 		Image:           image,
 		ImagePullPolicy: pullPolicy,
 		Args:            args,
-		Env: []corev1.EnvVar{
-			{
-				Name: TokenEnvVar,
-				ValueFrom: &corev1.EnvVarSource{
-					SecretKeyRef: &corev1.SecretKeySelector{
-						LocalObjectReference: corev1.LocalObjectReference{
-							Name: tokenSecretName,
-						},
-						Key: TokenSecretKey,
-					},
-				},
-			},
-		},
 		Ports: []corev1.ContainerPort{
 			{
-				Name:          "metrics",
+				Name:          MetricsPortName,
 				ContainerPort: metricsPort,
 				Protocol:      corev1.ProtocolTCP,
 			},
 		},
 	}
 
+	if tunnelMode(tunnel) == TunnelModeRemote {
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name: TokenEnvVar,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: tokenSecretName,
+					},
+					Key: TokenSecretKey,
+				},
+			},
+		})
+	}
+
 	// Add resource requirements if specified
 	if tunnel.Spec.Cloudflared.Resources.Limits != nil || tunnel.Spec.Cloudflared.Resources.Requests != nil {
 		container.Resources = tunnel.Spec.Cloudflared.Resources
@@ -241,37 +541,62 @@ func buildContainer(tunnel *cfgatev1alpha1.CloudflareTunnel, tokenSecretName str
 	return container
 }
 
-// buildProbes creates liveness and readiness probes for cloudflared.
-func buildProbes(metricsPort int32) (liveness, readiness *corev1.Probe) {
-	liveness = &corev1.Probe{
-		ProbeHandler: corev1.ProbeHandler{
-			HTTPGet: &corev1.HTTPGetAction{
-				Path: "/ready",
-				Port: intstr.FromInt32(metricsPort),
-			},
+// buildProbes creates liveness and readiness probes for cloudflared,
+// defaulting to "/ready" on the metrics port and the values below. All of
+// these are overridable via Spec.Cloudflared.Probes: cloudflared's /ready
+// only returns HTTP 200 once at least one edge connection is established, so
+// deployments on constrained or high-latency networks often need a longer
+// InitialDelaySeconds/PeriodSeconds and a higher FailureThreshold than
+// cloudflared's own defaults to avoid flapping restarts.
+func buildProbes(tunnel *cfgatev1alpha1.CloudflareTunnel) (liveness, readiness *corev1.Probe) {
+	metricsPort := getMetricsPort(tunnel)
+	cfg := tunnel.Spec.Cloudflared.Probes
+
+	path := cfg.Path
+	if path == "" {
+		path = "/ready"
+	}
+
+	handler := corev1.ProbeHandler{
+		HTTPGet: &corev1.HTTPGetAction{
+			Path: path,
+			Port: intstr.FromInt32(metricsPort),
 		},
-		InitialDelaySeconds: 10,
-		PeriodSeconds:       10,
-		TimeoutSeconds:      5,
-		FailureThreshold:    3,
+	}
+
+	liveness = &corev1.Probe{
+		ProbeHandler:        handler,
+		InitialDelaySeconds: probeOrDefault(cfg.InitialDelaySeconds, 10),
+		PeriodSeconds:       probeOrDefault(cfg.PeriodSeconds, 10),
+		TimeoutSeconds:      probeOrDefault(cfg.TimeoutSeconds, 5),
+		FailureThreshold:    probeOrDefault(cfg.FailureThreshold, 3),
 	}
 
 	readiness = &corev1.Probe{
-		ProbeHandler: corev1.ProbeHandler{
-			HTTPGet: &corev1.HTTPGetAction{
-				Path: "/ready",
-				Port: intstr.FromInt32(metricsPort),
-			},
-		},
-		InitialDelaySeconds: 5,
-		PeriodSeconds:       5,
-		TimeoutSeconds:      5,
-		FailureThreshold:    3,
+		ProbeHandler:        handler,
+		InitialDelaySeconds: probeOrDefault(cfg.InitialDelaySeconds, 5),
+		PeriodSeconds:       probeOrDefault(cfg.PeriodSeconds, 5),
+		TimeoutSeconds:      probeOrDefault(cfg.TimeoutSeconds, 5),
+		FailureThreshold:    probeOrDefault(cfg.FailureThreshold, 3),
 	}
 
 	return liveness, readiness
 }
 
+// ptrFromIntOrString returns a pointer to v, for the *intstr.IntOrString
+// fields RollingUpdateDeployment requires.
+func ptrFromIntOrString(v intstr.IntOrString) *intstr.IntOrString {
+	return &v
+}
+
+// probeOrDefault returns configured if the user set it (>0), else def.
+func probeOrDefault(configured, def int32) int32 {
+	if configured > 0 {
+		return configured
+	}
+	return def
+}
+
 // buildArgs creates the command line arguments for cloudflared.
 func buildArgs(tunnel *cfgatev1alpha1.CloudflareTunnel) []string {
 	args := []string{
@@ -291,6 +616,12 @@ func buildArgs(tunnel *cfgatev1alpha1.CloudflareTunnel) []string {
 	// Add extra args
 	args = append(args, tunnel.Spec.Cloudflared.ExtraArgs...)
 
+	if tunnelMode(tunnel) == TunnelModeLocal {
+		credentialsFile := fmt.Sprintf("%s/%s", CredentialsMountPath, CredentialsSecretKey)
+		args = append(args, "--config", ConfigMountPath, "--credentials-file", credentialsFile, "run", tunnel.Status.TunnelID)
+		return args
+	}
+
 	// Add run command with token from environment
 	args = append(args, "run", "--token", fmt.Sprintf("$(%s)", TokenEnvVar))
 
@@ -0,0 +1,80 @@
+// Package cloudflared provides utilities for managing cloudflared Kubernetes resources.
+package cloudflared
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+
+	cfgatev1alpha1 "cfgate.io/cfgate/api/v1alpha1"
+)
+
+// MetricsPortName is the name shared by the metrics ContainerPort (see
+// buildContainer), the metrics Service port, and the ServiceMonitor
+// endpoint's Port field, so the three stay in agreement.
+const MetricsPortName = "metrics"
+
+// BuildMetricsService creates a headless Service fronting the cloudflared
+// pods' metrics port, so it can be scraped by name instead of by pod IP
+// (required for BuildServiceMonitor's endpoint selector to resolve targets).
+func (b *DefaultBuilder) BuildMetricsService(tunnel *cfgatev1alpha1.CloudflareTunnel) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      MetricsServiceName(tunnel.Name),
+			Namespace: tunnel.Namespace,
+			Labels:    Labels(tunnel.Name),
+		},
+		Spec: corev1.ServiceSpec{
+			ClusterIP: corev1.ClusterIPNone,
+			Selector:  Selector(tunnel.Name),
+			Ports: []corev1.ServicePort{
+				{
+					Name:       MetricsPortName,
+					Port:       getMetricsPort(tunnel),
+					TargetPort: intstr.FromString(MetricsPortName),
+					Protocol:   corev1.ProtocolTCP,
+				},
+			},
+		},
+	}
+}
+
+// BuildServiceMonitor creates a prometheus-operator ServiceMonitor scraping
+// /metrics on the Service built by BuildMetricsService. Callers should only
+// create this when Spec.Cloudflared.Metrics.ServiceMonitor.Enabled is set,
+// since the monitoring.coreos.com CRDs it depends on may not be installed in
+// every cluster.
+func (b *DefaultBuilder) BuildServiceMonitor(tunnel *cfgatev1alpha1.CloudflareTunnel) *monitoringv1.ServiceMonitor {
+	interval := tunnel.Spec.Cloudflared.Metrics.ServiceMonitor.Interval
+	if interval == "" {
+		interval = "30s"
+	}
+
+	return &monitoringv1.ServiceMonitor{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      MetricsServiceName(tunnel.Name),
+			Namespace: tunnel.Namespace,
+			Labels:    Labels(tunnel.Name),
+		},
+		Spec: monitoringv1.ServiceMonitorSpec{
+			Selector: metav1.LabelSelector{
+				MatchLabels: Labels(tunnel.Name),
+			},
+			Endpoints: []monitoringv1.Endpoint{
+				{
+					Port:     MetricsPortName,
+					Path:     "/metrics",
+					Interval: monitoringv1.Duration(interval),
+				},
+			},
+		},
+	}
+}
+
+// MetricsServiceName returns the name for the cloudflared metrics Service
+// and its ServiceMonitor.
+func MetricsServiceName(tunnelName string) string {
+	return tunnelName + "-cloudflared-metrics"
+}
@@ -0,0 +1,121 @@
+// Package controller contains the reconciliation logic for cfgate CRDs.
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	awscreds "github.com/aws/aws-sdk-go-v2/credentials"
+	awsroute53 "github.com/aws/aws-sdk-go-v2/service/route53"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	cfgatev1alpha1 "cfgate.io/cfgate/api/v1alpha1"
+	cfgatedns "cfgate.io/cfgate/internal/dns"
+	"cfgate.io/cfgate/internal/dns/providers/rfc2136"
+	"cfgate.io/cfgate/internal/dns/providers/route53"
+)
+
+// resolveApexProvider builds the dns.Provider and resolves the zone ID
+// sync.Spec.ApexProvider describes, so syncRecords can additionally write
+// each hostname's tunnel CNAME into a non-Cloudflare authoritative DNS
+// backend. Returns a nil provider if ApexProvider is unset.
+func (r *CloudflareDNSSyncReconciler) resolveApexProvider(ctx context.Context, sync *cfgatev1alpha1.CloudflareDNSSync) (cfgatedns.Provider, string, error) {
+	cfg := sync.Spec.ApexProvider
+	if cfg == nil {
+		return nil, "", nil
+	}
+
+	var provider cfgatedns.Provider
+	switch cfg.Type {
+	case "Route53":
+		p, err := r.newRoute53Provider(ctx, sync.Namespace, cfg.Route53)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to build Route53 provider: %w", err)
+		}
+		provider = p
+	case "RFC2136":
+		p, err := r.newRFC2136Provider(ctx, sync.Namespace, cfg.RFC2136)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to build RFC2136 provider: %w", err)
+		}
+		provider = p
+	default:
+		return nil, "", fmt.Errorf("unsupported apex DNS provider type %q", cfg.Type)
+	}
+
+	zoneID, err := provider.ResolveZone(ctx, cfg.ZoneName)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to resolve apex zone %q: %w", cfg.ZoneName, err)
+	}
+	if zoneID == "" {
+		return nil, "", fmt.Errorf("apex zone %q not found", cfg.ZoneName)
+	}
+
+	return provider, zoneID, nil
+}
+
+// newRoute53Provider builds a route53.Provider from cfg, loading AWS
+// credentials from cfg.CredentialsRef if set, otherwise from the
+// controller's own ambient AWS identity (e.g. IRSA).
+func (r *CloudflareDNSSyncReconciler) newRoute53Provider(ctx context.Context, defaultNamespace string, cfg *cfgatev1alpha1.Route53ProviderConfig) (*route53.Provider, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("route53 config is required when apexProvider.type is Route53")
+	}
+
+	region := cfg.Region
+	if region == "" {
+		region = "us-east-1"
+	}
+
+	var optFns []func(*awsconfig.LoadOptions) error
+	optFns = append(optFns, awsconfig.WithRegion(region))
+
+	if cfg.CredentialsRef != nil {
+		secretNamespace := cfg.CredentialsRef.Namespace
+		if secretNamespace == "" {
+			secretNamespace = defaultNamespace
+		}
+		secret := &corev1.Secret{}
+		if err := r.Get(ctx, types.NamespacedName{Name: cfg.CredentialsRef.Name, Namespace: secretNamespace}, secret); err != nil {
+			return nil, fmt.Errorf("failed to get AWS credentials secret: %w", err)
+		}
+		accessKeyID := string(secret.Data["AWS_ACCESS_KEY_ID"])
+		secretAccessKey := string(secret.Data["AWS_SECRET_ACCESS_KEY"])
+		optFns = append(optFns, awsconfig.WithCredentialsProvider(
+			awscreds.NewStaticCredentialsProvider(accessKeyID, secretAccessKey, ""),
+		))
+	}
+
+	awsCfg, err := awsconfig.LoadDefaultConfig(ctx, optFns...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS config: %w", err)
+	}
+
+	return route53.New(awsroute53.NewFromConfig(awsCfg)), nil
+}
+
+// newRFC2136Provider builds an rfc2136.Provider from cfg, reading the TSIG
+// secret/algorithm from cfg.TSIGSecretRef if set.
+func (r *CloudflareDNSSyncReconciler) newRFC2136Provider(ctx context.Context, defaultNamespace string, cfg *cfgatev1alpha1.RFC2136ProviderConfig) (*rfc2136.Provider, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("rfc2136 config is required when apexProvider.type is RFC2136")
+	}
+
+	var tsigSecret, tsigAlgorithm string
+	if cfg.TSIGSecretRef != nil {
+		secretNamespace := cfg.TSIGSecretRef.Namespace
+		if secretNamespace == "" {
+			secretNamespace = defaultNamespace
+		}
+		secret := &corev1.Secret{}
+		if err := r.Get(ctx, types.NamespacedName{Name: cfg.TSIGSecretRef.Name, Namespace: secretNamespace}, secret); err != nil {
+			return nil, fmt.Errorf("failed to get TSIG secret: %w", err)
+		}
+		tsigSecret = string(secret.Data["secret"])
+		tsigAlgorithm = string(secret.Data["algorithm"])
+	}
+
+	return rfc2136.New(cfg.Nameserver, cfg.TSIGKeyName, tsigSecret, tsigAlgorithm), nil
+}
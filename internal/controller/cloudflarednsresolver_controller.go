@@ -0,0 +1,338 @@
+// Package controller contains the reconciliation logic for cfgate CRDs.
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	cfgatev1alpha1 "cfgate.io/cfgate/api/v1alpha1"
+	"cfgate.io/cfgate/internal/cloudflared"
+	"cfgate.io/cfgate/internal/nameserver"
+)
+
+// CloudflareDNSResolverReconciler reconciles a CloudflareDNSResolver object.
+// It runs an in-cluster authoritative nameserver that mirrors the hostnames
+// its referenced CloudflareDNSSync publishes to Cloudflare, so in-cluster
+// callers resolve straight to the backend Service instead of the tunnel.
+type CloudflareDNSResolverReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	// Builder creates the nameserver Deployment/Service/ConfigMaps. Injected
+	// for testing.
+	Builder nameserver.Builder
+}
+
+// +kubebuilder:rbac:groups=cfgate.io,resources=cloudflarednsresolvers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=cfgate.io,resources=cloudflarednsresolvers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=cfgate.io,resources=cloudflarednssyncs;cloudflaretunnels,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=services;configmaps,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups="",resources=endpointslices,verbs=get;list;watch
+// +kubebuilder:rbac:groups=discovery.k8s.io,resources=endpointslices,verbs=get;list;watch
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch
+
+// Reconcile resolves the referenced CloudflareDNSSync's in-cluster-enabled
+// hostnames to backend Service addresses and reconciles the nameserver
+// Deployment, Service, and records/Corefile ConfigMaps.
+func (r *CloudflareDNSResolverReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	logger.Info("reconciling CloudflareDNSResolver", "name", req.Name, "namespace", req.Namespace)
+
+	var resolver cfgatev1alpha1.CloudflareDNSResolver
+	if err := r.Get(ctx, req.NamespacedName, &resolver); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Info("CloudflareDNSResolver not found, ignoring")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("failed to get CloudflareDNSResolver: %w", err)
+	}
+
+	set, err := r.buildRecordSet(ctx, &resolver)
+	if err != nil {
+		logger.Error(err, "failed to build record set")
+		r.setCondition(&resolver, ConditionTypeReady, metav1.ConditionFalse, "RecordSetBuildFailed", err.Error())
+		if err := r.Status().Update(ctx, &resolver); err != nil {
+			logger.Error(err, "failed to update status")
+		}
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
+	if err := r.reconcileWorkload(ctx, &resolver, set); err != nil {
+		logger.Error(err, "failed to reconcile nameserver workload")
+		r.setCondition(&resolver, ConditionTypeReady, metav1.ConditionFalse, "WorkloadReconcileFailed", err.Error())
+		if err := r.Status().Update(ctx, &resolver); err != nil {
+			logger.Error(err, "failed to update status")
+		}
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
+	resolver.Status.Zones = set.Zones
+	resolver.Status.RecordCount = int32(len(set.Records))
+	resolver.Status.ObservedGeneration = resolver.Generation
+	r.setCondition(&resolver, ConditionTypeReady, metav1.ConditionTrue, "Ready", "nameserver is serving the resolved record set")
+	if err := r.Status().Update(ctx, &resolver); err != nil {
+		logger.Error(err, "failed to update status")
+	}
+
+	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+}
+
+// buildRecordSet resolves resolver's referenced CloudflareDNSSync into a
+// nameserver.RecordSet: if that CloudflareDNSSync has
+// InClusterResolution.Enabled set, one record per tunnel ingress hostname
+// inside one of its zones, pointed at its ingress rule's backend
+// Service ClusterIP (or, for a headless Service, every endpoint address for
+// round-robin answers, or a CNAME for an ExternalName Service).
+func (r *CloudflareDNSResolverReconciler) buildRecordSet(ctx context.Context, resolver *cfgatev1alpha1.CloudflareDNSResolver) (*nameserver.RecordSet, error) {
+	syncNamespace := resolver.Spec.DNSSyncRef.Namespace
+	if syncNamespace == "" {
+		syncNamespace = resolver.Namespace
+	}
+
+	var sync cfgatev1alpha1.CloudflareDNSSync
+	if err := r.Get(ctx, types.NamespacedName{Name: resolver.Spec.DNSSyncRef.Name, Namespace: syncNamespace}, &sync); err != nil {
+		return nil, fmt.Errorf("failed to get CloudflareDNSSync %s/%s: %w", syncNamespace, resolver.Spec.DNSSyncRef.Name, err)
+	}
+
+	if !sync.Spec.InClusterResolution.Enabled {
+		return &nameserver.RecordSet{}, nil
+	}
+
+	zones := make([]string, 0, len(sync.Spec.Zones))
+	for _, ref := range sync.Spec.Zones {
+		var zone cfgatev1alpha1.CloudflareManagedZone
+		if err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: sync.Namespace}, &zone); err != nil {
+			return nil, fmt.Errorf("failed to get CloudflareManagedZone %s: %w", ref.Name, err)
+		}
+		zones = append(zones, zone.Spec.ZoneName)
+	}
+
+	tunnelNamespace := sync.Spec.TunnelRef.Namespace
+	if tunnelNamespace == "" {
+		tunnelNamespace = sync.Namespace
+	}
+
+	var tunnelConfigMap corev1.ConfigMap
+	if err := r.Get(ctx, types.NamespacedName{Name: cloudflared.ConfigMapName(sync.Spec.TunnelRef.Name), Namespace: tunnelNamespace}, &tunnelConfigMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			// The tunnel's ingress config hasn't been assembled yet; an
+			// empty record set is correct, not an error, since there's
+			// nothing to resolve.
+			return &nameserver.RecordSet{Zones: zones, Records: map[string]nameserver.Record{}}, nil
+		}
+		return nil, fmt.Errorf("failed to get tunnel config: %w", err)
+	}
+
+	var tunnelConfig cloudflared.TunnelConfig
+	if err := yaml.Unmarshal([]byte(tunnelConfigMap.Data["config.yaml"]), &tunnelConfig); err != nil {
+		return nil, fmt.Errorf("failed to parse tunnel config: %w", err)
+	}
+
+	records := map[string]nameserver.Record{}
+	for _, rule := range tunnelConfig.Ingress {
+		if rule.Hostname == "" || !hostnameInZones(rule.Hostname, zones) {
+			continue
+		}
+		if _, ok := records[rule.Hostname]; ok {
+			continue // first (highest-precedence) ingress rule for a hostname wins
+		}
+
+		namespace, name, ok := parseServiceHostname(rule.Service)
+		if !ok {
+			continue
+		}
+
+		cname, addresses, err := r.resolveServiceTarget(ctx, namespace, name)
+		if err != nil {
+			return nil, fmt.Errorf("resolving backend for %s: %w", rule.Hostname, err)
+		}
+
+		switch {
+		case cname != "":
+			records[rule.Hostname] = nameserver.Record{Name: rule.Hostname, CNAME: cname}
+		case len(addresses) > 0:
+			records[rule.Hostname] = nameserver.Record{Name: rule.Hostname, A: addresses}
+		}
+	}
+
+	return &nameserver.RecordSet{Zones: zones, Records: records}, nil
+}
+
+// resolveServiceTarget returns how a nameserver record for the Service
+// namespace/name should answer: a CNAME target for an ExternalName Service
+// (which has no ClusterIP of its own), or the addresses to round-robin
+// across otherwise - the Service's ClusterIP, or, for a headless Service,
+// every ready IPv4 address from its EndpointSlices. At most one of the two
+// return values is non-empty; both are empty if the Service doesn't exist
+// or a headless Service has no ready IPv4 endpoints.
+func (r *CloudflareDNSResolverReconciler) resolveServiceTarget(ctx context.Context, namespace, name string) (cname string, addresses []string, err error) {
+	var svc corev1.Service
+	if err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, &svc); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", nil, nil
+		}
+		return "", nil, err
+	}
+
+	if svc.Spec.Type == corev1.ServiceTypeExternalName {
+		return svc.Spec.ExternalName, nil, nil
+	}
+
+	if svc.Spec.ClusterIP != "" && svc.Spec.ClusterIP != corev1.ClusterIPNone {
+		return "", []string{svc.Spec.ClusterIP}, nil
+	}
+
+	var slices discoveryv1.EndpointSliceList
+	if err := r.List(ctx, &slices, client.InNamespace(namespace), client.MatchingLabels{discoveryv1.LabelServiceName: name}); err != nil {
+		return "", nil, fmt.Errorf("listing endpointslices for %s/%s: %w", namespace, name, err)
+	}
+
+	for _, slice := range slices.Items {
+		if slice.AddressType != discoveryv1.AddressTypeIPv4 {
+			continue
+		}
+		for _, ep := range slice.Endpoints {
+			if ep.Conditions.Ready != nil && !*ep.Conditions.Ready {
+				continue
+			}
+			addresses = append(addresses, ep.Addresses...)
+		}
+	}
+	return "", addresses, nil
+}
+
+// reconcileWorkload creates or updates the nameserver Deployment, Service,
+// and records (and, if enabled, Corefile) ConfigMaps for resolver.
+func (r *CloudflareDNSResolverReconciler) reconcileWorkload(ctx context.Context, resolver *cfgatev1alpha1.CloudflareDNSResolver, set *nameserver.RecordSet) error {
+	recordsConfigMap, err := r.Builder.BuildRecordsConfigMap(resolver, set)
+	if err != nil {
+		return fmt.Errorf("building records configmap: %w", err)
+	}
+	if err := r.createOrUpdateConfigMap(ctx, resolver, recordsConfigMap); err != nil {
+		return err
+	}
+
+	if resolver.Spec.EmitCorefile {
+		corefileConfigMap := r.Builder.BuildCorefileConfigMap(resolver, set)
+		if err := r.createOrUpdateConfigMap(ctx, resolver, corefileConfigMap); err != nil {
+			return err
+		}
+	}
+
+	if err := r.createOrUpdateDeployment(ctx, resolver, r.Builder.BuildDeployment(resolver)); err != nil {
+		return err
+	}
+
+	return r.createOrUpdateService(ctx, resolver, r.Builder.BuildService(resolver))
+}
+
+// hostnameInZones reports whether hostname falls within one of zones (itself
+// or a subdomain of one), matching nameserver.RecordSet.ManagesZone's rule.
+func hostnameInZones(hostname string, zones []string) bool {
+	hostname = strings.ToLower(strings.TrimSuffix(hostname, "."))
+	for _, zone := range zones {
+		zone = strings.ToLower(strings.TrimSuffix(zone, "."))
+		if hostname == zone || strings.HasSuffix(hostname, "."+zone) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseServiceHostname extracts the namespace and name from a cloudflared
+// ingress rule's Service URL, which buildIngressRules/backendServiceURL
+// render as "http://<name>.<namespace>.svc.cluster.local:<port>". Services
+// not addressed by in-cluster DNS (e.g. a raw IP origin) return ok=false.
+func parseServiceHostname(service string) (namespace, name string, ok bool) {
+	rest := service
+	if idx := strings.Index(rest, "://"); idx != -1 {
+		rest = rest[idx+3:]
+	}
+	if idx := strings.IndexAny(rest, ":/"); idx != -1 {
+		rest = rest[:idx]
+	}
+
+	const suffix = ".svc.cluster.local"
+	if !strings.HasSuffix(rest, suffix) {
+		return "", "", false
+	}
+	rest = strings.TrimSuffix(rest, suffix)
+
+	parts := strings.SplitN(rest, ".", 2)
+	if len(parts) != 2 {
+		return "", "", false
+	}
+	return parts[1], parts[0], true
+}
+
+// createOrUpdateConfigMap reconciles desired against the cluster, fetching
+// into a fresh object first so the Get performed by CreateOrUpdate can't
+// clobber desired's already-computed Data/Labels.
+func (r *CloudflareDNSResolverReconciler) createOrUpdateConfigMap(ctx context.Context, resolver *cfgatev1alpha1.CloudflareDNSResolver, desired *corev1.ConfigMap) error {
+	existing := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: desired.Name, Namespace: desired.Namespace}}
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, existing, func() error {
+		existing.Data = desired.Data
+		existing.Labels = desired.Labels
+		return controllerutil.SetControllerReference(resolver, existing, r.Scheme)
+	})
+	if err != nil {
+		return fmt.Errorf("reconciling configmap %s: %w", desired.Name, err)
+	}
+	return nil
+}
+
+func (r *CloudflareDNSResolverReconciler) createOrUpdateDeployment(ctx context.Context, resolver *cfgatev1alpha1.CloudflareDNSResolver, desired *appsv1.Deployment) error {
+	existing := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: desired.Name, Namespace: desired.Namespace}}
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, existing, func() error {
+		existing.Spec = desired.Spec
+		existing.Labels = desired.Labels
+		return controllerutil.SetControllerReference(resolver, existing, r.Scheme)
+	})
+	if err != nil {
+		return fmt.Errorf("reconciling deployment %s: %w", desired.Name, err)
+	}
+	return nil
+}
+
+func (r *CloudflareDNSResolverReconciler) createOrUpdateService(ctx context.Context, resolver *cfgatev1alpha1.CloudflareDNSResolver, desired *corev1.Service) error {
+	existing := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: desired.Name, Namespace: desired.Namespace}}
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, existing, func() error {
+		existing.Spec.Selector = desired.Spec.Selector
+		existing.Spec.Ports = desired.Spec.Ports
+		existing.Spec.Type = desired.Spec.Type
+		existing.Labels = desired.Labels
+		return controllerutil.SetControllerReference(resolver, existing, r.Scheme)
+	})
+	if err != nil {
+		return fmt.Errorf("reconciling service %s: %w", desired.Name, err)
+	}
+	return nil
+}
+
+// setCondition sets or updates a condition on resolver's status.
+func (r *CloudflareDNSResolverReconciler) setCondition(resolver *cfgatev1alpha1.CloudflareDNSResolver, conditionType string, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&resolver.Status.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: resolver.Generation,
+	})
+}
@@ -4,10 +4,17 @@ package controller
 import (
 	"context"
 	"fmt"
+	"hash/fnv"
 	"reflect"
+	"strconv"
+	stdsync "sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/sync/errgroup"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -23,9 +30,13 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	gateway "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	cfgatev1alpha1 "cfgate.io/cfgate/api/v1alpha1"
+	"cfgate.io/cfgate/internal/backoff"
 	"cfgate.io/cfgate/internal/cloudflare"
+	rcontext "cfgate.io/cfgate/internal/controller/context"
+	cfgatedns "cfgate.io/cfgate/internal/dns"
 )
 
 const (
@@ -40,8 +51,70 @@ const (
 
 	// defaultOwnershipPrefix is the default prefix for TXT ownership records.
 	defaultOwnershipPrefix = "_cfgate"
+
+	// routeKindHTTPRoute etc. are the Gateway API route kinds
+	// Spec.Source.GatewayRoutes.RouteKinds may enable.
+	routeKindHTTPRoute = "HTTPRoute"
+	routeKindGRPCRoute = "GRPCRoute"
+	routeKindTLSRoute  = "TLSRoute"
+	routeKindTCPRoute  = "TCPRoute"
+	routeKindUDPRoute  = "UDPRoute"
+
+	// AnnotationHostname opts a Service into Spec.Source.Service, naming the
+	// hostname it should be synced as.
+	AnnotationHostname = "cfgate.io/hostname"
+
+	// defaultMaxParallel is used when Spec.Concurrency.MaxParallel is unset.
+	defaultMaxParallel = 8
+
+	// recordCacheTTL bounds how long syncRecords' per-zone ZoneRecordCache
+	// entries are served for, long enough to cover one reconcile's batch of
+	// per-hostname lookups without risking a stale read into the next one.
+	recordCacheTTL = 30 * time.Second
+
+	// maxSyncRetries bounds the exponential-backoff retries applied to a
+	// single hostname's SyncRecord call on a transient Cloudflare failure.
+	maxSyncRetries = 3
+
+	// maxConsecutiveFailures short-circuits the rest of a sync batch once
+	// this many hostnames in a row fail against Cloudflare, so a CF outage
+	// doesn't burn through the whole batch (and its rate-limit quota) before
+	// the controller gives up and requeues.
+	maxConsecutiveFailures = 5
+
+	// ConditionTypeRateLimited indicates the reconciler is backing off after
+	// repeated reconcile failures (Cloudflare rate limiting or an outage).
+	ConditionTypeRateLimited = "RateLimited"
+
+	// ConditionTypeDegraded indicates CleanupPolicy.DeletionFailurePolicy=Fail
+	// is blocking finalizer removal on one or more Cloudflare deletion errors.
+	ConditionTypeDegraded = "Degraded"
+
+	// defaultMaxDeletionRetries is used when CleanupPolicy.MaxDeletionRetries
+	// is unset.
+	defaultMaxDeletionRetries = 5
+
+	// defaultDeletionGracePeriod is used when
+	// CleanupPolicy.DeletionGracePeriod is unset.
+	defaultDeletionGracePeriod = 5 * time.Minute
+
+	// defaultRequeueInterval and defaultMaxBackoff apply when
+	// Spec.RequeueInterval/Spec.MaxBackoff aren't set.
+	defaultRequeueInterval = 5 * time.Minute
+	defaultMaxBackoff      = 5 * time.Minute
+
+	// baseRetryInterval is the starting point for the exponential backoff
+	// applied to repeated reconcile failures.
+	baseRetryInterval = 10 * time.Second
+
+	// requeueJitterVariance bounds how far a jittered requeue interval may
+	// drift from its target, as a fraction (0.2 = +/-20%).
+	requeueJitterVariance = 0.2
 )
 
+// defaultRouteKinds is used when Spec.Source.GatewayRoutes.RouteKinds is unset.
+var defaultRouteKinds = []string{routeKindHTTPRoute}
+
 // CloudflareDNSSyncReconciler reconciles a CloudflareDNSSync object.
 // It manages DNS records for CloudflareTunnel resources by watching
 // Gateway API routes and syncing hostnames to Cloudflare DNS.
@@ -55,11 +128,80 @@ type CloudflareDNSSyncReconciler struct {
 
 	// CredentialCache caches validated Cloudflare clients to avoid repeated validations.
 	CredentialCache *cloudflare.CredentialCache
+
+	// RateLimiter throttles Cloudflare API calls across all CloudflareDNSSync
+	// reconciles. Created lazily with Cloudflare's documented defaults if unset.
+	RateLimiter *cloudflare.RateLimiter
+
+	rateLimiterOnce stdsync.Once
+
+	// Backoff tracks per-resource reconcile-failure counts driving the
+	// exponentially increasing, jittered requeue interval. Created lazily if unset.
+	Backoff *backoff.Tracker
+
+	backoffOnce stdsync.Once
+}
+
+// rateLimiter returns r.RateLimiter, creating it with Cloudflare's
+// documented defaults on first use.
+func (r *CloudflareDNSSyncReconciler) rateLimiter() *cloudflare.RateLimiter {
+	r.rateLimiterOnce.Do(func() {
+		if r.RateLimiter == nil {
+			r.RateLimiter = cloudflare.NewRateLimiter()
+		}
+	})
+	return r.RateLimiter
+}
+
+// backoffTracker returns r.Backoff, creating it on first use.
+func (r *CloudflareDNSSyncReconciler) backoffTracker() *backoff.Tracker {
+	r.backoffOnce.Do(func() {
+		if r.Backoff == nil {
+			r.Backoff = backoff.NewTracker()
+		}
+	})
+	return r.Backoff
+}
+
+// requeueAfterFailure records another failed reconcile for sync and returns
+// a jittered, exponentially increasing requeue interval capped at
+// Spec.MaxBackoff, surfacing the backoff state via the RateLimited condition.
+func (r *CloudflareDNSSyncReconciler) requeueAfterFailure(sync *cfgatev1alpha1.CloudflareDNSSync) ctrl.Result {
+	key := sync.Namespace + "/" + sync.Name
+	attempt := r.backoffTracker().Next(key)
+
+	maxBackoff := sync.Spec.MaxBackoff.Duration
+	if maxBackoff <= 0 {
+		maxBackoff = defaultMaxBackoff
+	}
+
+	interval := backoff.Duration(baseRetryInterval, maxBackoff, attempt, requeueJitterVariance)
+	r.setCondition(sync, ConditionTypeRateLimited, metav1.ConditionTrue, "Backoff",
+		fmt.Sprintf("reconcile failed %d time(s) in a row, next attempt in %s", attempt, interval.Round(time.Second)))
+
+	return ctrl.Result{RequeueAfter: interval}
+}
+
+// requeueAfterSuccess resets sync's backoff state and returns a jittered
+// requeue interval around Spec.RequeueInterval.
+func (r *CloudflareDNSSyncReconciler) requeueAfterSuccess(sync *cfgatev1alpha1.CloudflareDNSSync) ctrl.Result {
+	key := sync.Namespace + "/" + sync.Name
+	r.backoffTracker().Reset(key)
+
+	requeueInterval := sync.Spec.RequeueInterval.Duration
+	if requeueInterval <= 0 {
+		requeueInterval = defaultRequeueInterval
+	}
+
+	r.setCondition(sync, ConditionTypeRateLimited, metav1.ConditionFalse, "Synced", "reconcile succeeded")
+	return ctrl.Result{RequeueAfter: backoff.RandomizeDuration(requeueInterval, requeueJitterVariance)}
 }
 
 // +kubebuilder:rbac:groups=cfgate.io,resources=cloudflarednssyncs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=cfgate.io,resources=cloudflarednssyncs/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=cfgate.io,resources=cloudflarednssyncs/finalizers,verbs=update
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch
 
 // Reconcile handles the reconciliation loop for CloudflareDNSSync resources.
 // It collects hostnames from routes, resolves zones, and syncs DNS records.
@@ -96,11 +238,12 @@ func (r *CloudflareDNSSyncReconciler) Reconcile(ctx context.Context, req ctrl.Re
 	tunnel, err := r.resolveTunnel(ctx, &sync)
 	if err != nil {
 		log.Error(err, "failed to resolve tunnel")
+		recordSyncFailure(sync.Namespace, sync.Name, "TunnelNotFound")
 		r.setCondition(&sync, ConditionTypeReady, metav1.ConditionFalse, "TunnelNotFound", err.Error())
 		if err := r.updateStatus(ctx, &sync); err != nil {
 			log.Error(err, "failed to update status")
 		}
-		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+		return r.requeueAfterFailure(&sync), nil
 	}
 
 	if tunnel.Status.TunnelID == "" {
@@ -109,41 +252,63 @@ func (r *CloudflareDNSSyncReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		if err := r.updateStatus(ctx, &sync); err != nil {
 			log.Error(err, "failed to update status")
 		}
-		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+		return r.requeueAfterFailure(&sync), nil
 	}
 
 	// 4. Collect hostnames from routes
 	hostnames, err := r.collectHostnames(ctx, &sync, tunnel)
 	if err != nil {
 		log.Error(err, "failed to collect hostnames")
-		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+		recordSyncFailure(sync.Namespace, sync.Name, "HostnameCollectionFailed")
+		return r.requeueAfterFailure(&sync), nil
+	}
+
+	// 4b. Collect hostname->backend mappings for tunnel ingress sync, if enabled.
+	var routeBackends []cloudflare.TunnelIngressRule
+	if sync.Spec.TunnelConfig.ManageIngress {
+		routeBackends, err = r.collectIngressRules(ctx, &sync, tunnel)
+		if err != nil {
+			log.Error(err, "failed to collect backend refs for ingress sync")
+			return r.requeueAfterFailure(&sync), nil
+		}
 	}
 
 	// 5. Resolve zones
-	zones, err := r.resolveZones(ctx, &sync, tunnel)
+	zones, zoneClients, err := r.resolveZones(ctx, &sync, tunnel)
 	if err != nil {
 		log.Error(err, "failed to resolve zones")
+		recordSyncFailure(sync.Namespace, sync.Name, "ZoneResolutionFailed")
 		r.setCondition(&sync, ConditionTypeZonesResolved, metav1.ConditionFalse, "ZoneResolutionFailed", err.Error())
 		if err := r.updateStatus(ctx, &sync); err != nil {
 			log.Error(err, "failed to update status")
 		}
-		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+		return r.requeueAfterFailure(&sync), nil
 	}
 	r.setCondition(&sync, ConditionTypeZonesResolved, metav1.ConditionTrue, "ZonesResolved", "All zones resolved successfully")
 
 	// 6. Sync records
-	if err := r.syncRecords(ctx, &sync, tunnel, hostnames, zones); err != nil {
+	if err := r.syncRecords(ctx, &sync, tunnel, hostnames, zones, zoneClients, routeBackends); err != nil {
 		log.Error(err, "failed to sync records")
+		recordSyncFailure(sync.Namespace, sync.Name, "SyncFailed")
 		r.setCondition(&sync, ConditionTypeDNSSynced, metav1.ConditionFalse, "SyncFailed", err.Error())
 		if err := r.updateStatus(ctx, &sync); err != nil {
 			log.Error(err, "failed to update status")
 		}
 		r.Recorder.Eventf(&sync, nil, corev1.EventTypeWarning, "SyncFailed", "Sync", "%s", err.Error())
-		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+		return r.requeueAfterFailure(&sync), nil
 	}
 	r.setCondition(&sync, ConditionTypeDNSSynced, metav1.ConditionTrue, "RecordsSynced", "DNS records synced successfully")
 
-	// 7. Update status
+	// 7. Reconcile health checks for hostnames that opt in. Best-effort: a
+	// failure here doesn't fail the overall sync, since the records it
+	// would act on are already synced.
+	if err := r.reconcileHealthChecks(ctx, &sync, zones, zoneClients); err != nil {
+		log.Error(err, "failed to reconcile health checks")
+		r.Recorder.Eventf(&sync, nil, corev1.EventTypeWarning, "HealthCheckReconcileFailed", "HealthCheck", "%s", err.Error())
+	}
+
+	// 8. Update status
+	recordSyncSuccess(sync.Namespace, sync.Name)
 	r.setCondition(&sync, ConditionTypeReady, metav1.ConditionTrue, "Ready", "DNS sync is operational")
 	sync.Status.ObservedGeneration = sync.Generation
 	now := metav1.Now()
@@ -151,11 +316,11 @@ func (r *CloudflareDNSSyncReconciler) Reconcile(ctx context.Context, req ctrl.Re
 
 	if err := r.updateStatus(ctx, &sync); err != nil {
 		log.Error(err, "failed to update status")
-		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+		return r.requeueAfterFailure(&sync), nil
 	}
 
 	r.Recorder.Eventf(&sync, nil, corev1.EventTypeNormal, "Reconciled", "Reconcile", "DNS sync completed successfully")
-	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+	return r.requeueAfterSuccess(&sync), nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -176,6 +341,36 @@ func (r *CloudflareDNSSyncReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			handler.EnqueueRequestsFromMapFunc(r.findAffectedDNSSyncs),
 			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
 		).
+		Watches(
+			&gatewayv1alpha2.GRPCRoute{},
+			handler.EnqueueRequestsFromMapFunc(r.findAffectedDNSSyncs),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Watches(
+			&gatewayv1alpha2.TLSRoute{},
+			handler.EnqueueRequestsFromMapFunc(r.findAffectedDNSSyncs),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Watches(
+			&gatewayv1alpha2.TCPRoute{},
+			handler.EnqueueRequestsFromMapFunc(r.findAffectedDNSSyncs),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Watches(
+			&gatewayv1alpha2.UDPRoute{},
+			handler.EnqueueRequestsFromMapFunc(r.findAffectedDNSSyncs),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Watches(
+			&networkingv1.Ingress{},
+			handler.EnqueueRequestsFromMapFunc(r.findAffectedDNSSyncsForIngress),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Watches(
+			&corev1.Service{},
+			handler.EnqueueRequestsFromMapFunc(r.findAffectedDNSSyncsForService),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
 		Complete(r)
 }
 
@@ -216,6 +411,58 @@ func (r *CloudflareDNSSyncReconciler) findAffectedDNSSyncs(ctx context.Context,
 	return requests
 }
 
+// findAffectedDNSSyncsForIngress finds all CloudflareDNSSync resources with
+// Spec.Source.Ingress enabled, in reaction to a change to an Ingress.
+func (r *CloudflareDNSSyncReconciler) findAffectedDNSSyncsForIngress(ctx context.Context, obj client.Object) []reconcile.Request {
+	log := log.FromContext(ctx)
+
+	var syncList cfgatev1alpha1.CloudflareDNSSyncList
+	if err := r.List(ctx, &syncList); err != nil {
+		log.Error(err, "failed to list CloudflareDNSSync resources")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, sync := range syncList.Items {
+		if sync.Spec.Source.Ingress.Enabled {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      sync.Name,
+					Namespace: sync.Namespace,
+				},
+			})
+		}
+	}
+
+	return requests
+}
+
+// findAffectedDNSSyncsForService finds all CloudflareDNSSync resources with
+// Spec.Source.Service enabled, in reaction to a change to a Service.
+func (r *CloudflareDNSSyncReconciler) findAffectedDNSSyncsForService(ctx context.Context, obj client.Object) []reconcile.Request {
+	log := log.FromContext(ctx)
+
+	var syncList cfgatev1alpha1.CloudflareDNSSyncList
+	if err := r.List(ctx, &syncList); err != nil {
+		log.Error(err, "failed to list CloudflareDNSSync resources")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, sync := range syncList.Items {
+		if sync.Spec.Source.Service.Enabled {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      sync.Name,
+					Namespace: sync.Namespace,
+				},
+			})
+		}
+	}
+
+	return requests
+}
+
 // resolveTunnel resolves the referenced CloudflareTunnel.
 func (r *CloudflareDNSSyncReconciler) resolveTunnel(ctx context.Context, sync *cfgatev1alpha1.CloudflareDNSSync) (*cfgatev1alpha1.CloudflareTunnel, error) {
 	namespace := sync.Spec.TunnelRef.Namespace
@@ -253,6 +500,24 @@ func (r *CloudflareDNSSyncReconciler) collectHostnames(ctx context.Context, sync
 		hostnames = append(hostnames, routeHostnames...)
 	}
 
+	// Collect from Ingress resources if enabled
+	if sync.Spec.Source.Ingress.Enabled {
+		ingressHostnames, err := r.collectHostnamesFromIngress(ctx, sync, tunnel)
+		if err != nil {
+			return nil, err
+		}
+		hostnames = append(hostnames, ingressHostnames...)
+	}
+
+	// Collect from annotated Services if enabled
+	if sync.Spec.Source.Service.Enabled {
+		serviceHostnames, err := r.collectHostnamesFromServices(ctx, sync, tunnel)
+		if err != nil {
+			return nil, err
+		}
+		hostnames = append(hostnames, serviceHostnames...)
+	}
+
 	// Deduplicate
 	seen := make(map[string]bool)
 	var unique []string
@@ -266,177 +531,912 @@ func (r *CloudflareDNSSyncReconciler) collectHostnames(ctx context.Context, sync
 	return unique, nil
 }
 
-// collectHostnamesFromRoutes collects hostnames from HTTPRoutes.
-func (r *CloudflareDNSSyncReconciler) collectHostnamesFromRoutes(ctx context.Context, sync *cfgatev1alpha1.CloudflareDNSSync, tunnel *cfgatev1alpha1.CloudflareTunnel) ([]string, error) {
-	var hostnames []string
+// routeKinds returns the configured Gateway API route kinds to watch and
+// enumerate, defaulting to HTTPRoute only for back-compat.
+func routeKinds(sync *cfgatev1alpha1.CloudflareDNSSync) []string {
+	if len(sync.Spec.Source.GatewayRoutes.RouteKinds) == 0 {
+		return defaultRouteKinds
+	}
+	return sync.Spec.Source.GatewayRoutes.RouteKinds
+}
+
+// routeKindEnabled reports whether kind is in sync's configured route kinds.
+func routeKindEnabled(sync *cfgatev1alpha1.CloudflareDNSSync, kind string) bool {
+	for _, k := range routeKinds(sync) {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// annotationFilterMatches reports whether a route's annotations satisfy
+// Spec.Source.GatewayRoutes.AnnotationFilter (always true if unset).
+func annotationFilterMatches(sync *cfgatev1alpha1.CloudflareDNSSync, annotations map[string]string) bool {
+	filter := sync.Spec.Source.GatewayRoutes.AnnotationFilter
+	if filter == "" {
+		return true
+	}
+	_, ok := annotations[filter]
+	return ok
+}
+
+// matchesGateway reports whether any of a route's parentRefs points at gw.
+func matchesGateway(parentRefs []gateway.ParentReference, routeNamespace string, gw gateway.Gateway) bool {
+	for _, parentRef := range parentRefs {
+		parentNS := routeNamespace
+		if parentRef.Namespace != nil {
+			parentNS = string(*parentRef.Namespace)
+		}
+		if string(parentRef.Name) == gw.Name && parentNS == gw.Namespace {
+			return true
+		}
+	}
+	return false
+}
 
-	// Find Gateways that reference this tunnel
+// relevantGateways finds Gateways annotated to reference tunnel and to have
+// DNS sync enabled.
+func (r *CloudflareDNSSyncReconciler) relevantGateways(ctx context.Context, tunnel *cfgatev1alpha1.CloudflareTunnel) ([]gateway.Gateway, error) {
 	var gateways gateway.GatewayList
 	if err := r.List(ctx, &gateways); err != nil {
 		return nil, fmt.Errorf("failed to list gateways: %w", err)
 	}
 
 	tunnelRef := fmt.Sprintf("%s/%s", tunnel.Namespace, tunnel.Name)
-	var relevantGateways []gateway.Gateway
-
+	var relevant []gateway.Gateway
 	for _, gw := range gateways.Items {
 		if ref, ok := gw.Annotations[AnnotationTunnelRef]; ok && ref == tunnelRef {
-			// Check if DNS sync is enabled on gateway
 			if dnsSync, ok := gw.Annotations[AnnotationDNSSync]; ok && dnsSync == "enabled" {
-				relevantGateways = append(relevantGateways, gw)
+				relevant = append(relevant, gw)
 			}
 		}
 	}
+	return relevant, nil
+}
 
-	// For each Gateway, find HTTPRoutes
-	for _, gw := range relevantGateways {
+// collectHostnamesFromRoutes collects hostnames from the Gateway API route
+// kinds enabled via Spec.Source.GatewayRoutes.RouteKinds (HTTPRoute only by
+// default). GRPCRoute and TLSRoute contribute hostnames the same way
+// HTTPRoute does. TCPRoute and UDPRoute are counted for visibility but never
+// contribute a hostname: neither spec carries a Hostnames field, and
+// Cloudflare Tunnels can't proxy raw TCP/UDP passthrough anyway.
+func (r *CloudflareDNSSyncReconciler) collectHostnamesFromRoutes(ctx context.Context, sync *cfgatev1alpha1.CloudflareDNSSync, tunnel *cfgatev1alpha1.CloudflareTunnel) ([]string, error) {
+	log := log.FromContext(ctx)
+	var hostnames []string
+
+	relevantGateways, err := r.relevantGateways(ctx, tunnel)
+	if err != nil {
+		return nil, err
+	}
+
+	if routeKindEnabled(sync, routeKindHTTPRoute) {
 		var routes gateway.HTTPRouteList
 		if err := r.List(ctx, &routes); err != nil {
 			return nil, fmt.Errorf("failed to list httproutes: %w", err)
 		}
-
 		for _, route := range routes.Items {
-			// Check annotation filter if specified
-			if sync.Spec.Source.GatewayRoutes.AnnotationFilter != "" {
-				if _, ok := route.Annotations[sync.Spec.Source.GatewayRoutes.AnnotationFilter]; !ok {
-					continue
+			if !annotationFilterMatches(sync, route.Annotations) {
+				continue
+			}
+			for _, gw := range relevantGateways {
+				if matchesGateway(route.Spec.ParentRefs, route.Namespace, gw) {
+					for _, h := range route.Spec.Hostnames {
+						hostnames = append(hostnames, string(h))
+					}
+					break
 				}
 			}
+		}
+	}
 
-			// Check if route references this gateway
-			for _, parentRef := range route.Spec.ParentRefs {
-				parentNS := route.Namespace
-				if parentRef.Namespace != nil {
-					parentNS = string(*parentRef.Namespace)
+	if routeKindEnabled(sync, routeKindGRPCRoute) {
+		var routes gatewayv1alpha2.GRPCRouteList
+		if err := r.List(ctx, &routes); err != nil {
+			return nil, fmt.Errorf("failed to list grpcroutes: %w", err)
+		}
+		for _, route := range routes.Items {
+			if !annotationFilterMatches(sync, route.Annotations) {
+				continue
+			}
+			for _, gw := range relevantGateways {
+				if matchesGateway(route.Spec.ParentRefs, route.Namespace, gw) {
+					for _, h := range route.Spec.Hostnames {
+						hostnames = append(hostnames, string(h))
+					}
+					break
 				}
+			}
+		}
+	}
 
-				if string(parentRef.Name) == gw.Name && parentNS == gw.Namespace {
-					// Collect hostnames from route
+	if routeKindEnabled(sync, routeKindTLSRoute) {
+		var routes gatewayv1alpha2.TLSRouteList
+		if err := r.List(ctx, &routes); err != nil {
+			return nil, fmt.Errorf("failed to list tlsroutes: %w", err)
+		}
+		for _, route := range routes.Items {
+			if !annotationFilterMatches(sync, route.Annotations) {
+				continue
+			}
+			for _, gw := range relevantGateways {
+				if matchesGateway(route.Spec.ParentRefs, route.Namespace, gw) {
 					for _, h := range route.Spec.Hostnames {
 						hostnames = append(hostnames, string(h))
 					}
+					break
 				}
 			}
 		}
 	}
 
-	return hostnames, nil
+	if routeKindEnabled(sync, routeKindTCPRoute) {
+		var routes gatewayv1alpha2.TCPRouteList
+		if err := r.List(ctx, &routes); err != nil {
+			return nil, fmt.Errorf("failed to list tcproutes: %w", err)
+		}
+		var matched int
+		for _, route := range routes.Items {
+			if !annotationFilterMatches(sync, route.Annotations) {
+				continue
+			}
+			for _, gw := range relevantGateways {
+				if matchesGateway(route.Spec.ParentRefs, route.Namespace, gw) {
+					matched++
+					break
+				}
+			}
+		}
+		if matched > 0 {
+			log.Info("TCPRoute passthrough is informational only, no DNS record created", "tcpRoutes", matched)
+		}
+	}
+
+	if routeKindEnabled(sync, routeKindUDPRoute) {
+		var routes gatewayv1alpha2.UDPRouteList
+		if err := r.List(ctx, &routes); err != nil {
+			return nil, fmt.Errorf("failed to list udproutes: %w", err)
+		}
+		var matched int
+		for _, route := range routes.Items {
+			if !annotationFilterMatches(sync, route.Annotations) {
+				continue
+			}
+			for _, gw := range relevantGateways {
+				if matchesGateway(route.Spec.ParentRefs, route.Namespace, gw) {
+					matched++
+					break
+				}
+			}
+		}
+		if matched > 0 {
+			log.Info("UDPRoute passthrough is informational only, no DNS record created", "udpRoutes", matched)
+		}
+	}
+
+	r.emitUnsupportedRouteKindEvents(ctx, sync, relevantGateways)
+
+	return hostnames, nil
+}
+
+// emitUnsupportedRouteKindEvents warns when a Gateway managed by this sync
+// has routes of a kind Spec.Source.GatewayRoutes.RouteKinds doesn't enable,
+// so it's visible that those hostnames won't get DNS records.
+func (r *CloudflareDNSSyncReconciler) emitUnsupportedRouteKindEvents(ctx context.Context, sync *cfgatev1alpha1.CloudflareDNSSync, relevantGateways []gateway.Gateway) {
+	log := log.FromContext(ctx)
+
+	warnIfUnsupported := func(kind string, count int, err error) {
+		if err != nil {
+			log.V(1).Info("failed to check for unsupported route kind", "kind", kind, "error", err.Error())
+			return
+		}
+		if routeKindEnabled(sync, kind) || count == 0 {
+			return
+		}
+		r.Recorder.Eventf(sync, nil, corev1.EventTypeWarning, "UnsupportedRouteKind", "Collect",
+			"%d %s route(s) reference a managed Gateway but %q is not in spec.source.gatewayRoutes.routeKinds", count, kind, kind)
+	}
+
+	countMatching := func(annotations map[string]string, parentRefs []gateway.ParentReference, routeNamespace string) bool {
+		if !annotationFilterMatches(sync, annotations) {
+			return false
+		}
+		for _, gw := range relevantGateways {
+			if matchesGateway(parentRefs, routeNamespace, gw) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if !routeKindEnabled(sync, routeKindHTTPRoute) {
+		var routes gateway.HTTPRouteList
+		err := r.List(ctx, &routes)
+		count := 0
+		for _, route := range routes.Items {
+			if countMatching(route.Annotations, route.Spec.ParentRefs, route.Namespace) {
+				count++
+			}
+		}
+		warnIfUnsupported(routeKindHTTPRoute, count, err)
+	}
+
+	if !routeKindEnabled(sync, routeKindGRPCRoute) {
+		var routes gatewayv1alpha2.GRPCRouteList
+		err := r.List(ctx, &routes)
+		count := 0
+		for _, route := range routes.Items {
+			if countMatching(route.Annotations, route.Spec.ParentRefs, route.Namespace) {
+				count++
+			}
+		}
+		warnIfUnsupported(routeKindGRPCRoute, count, err)
+	}
+
+	if !routeKindEnabled(sync, routeKindTLSRoute) {
+		var routes gatewayv1alpha2.TLSRouteList
+		err := r.List(ctx, &routes)
+		count := 0
+		for _, route := range routes.Items {
+			if countMatching(route.Annotations, route.Spec.ParentRefs, route.Namespace) {
+				count++
+			}
+		}
+		warnIfUnsupported(routeKindTLSRoute, count, err)
+	}
+
+	if !routeKindEnabled(sync, routeKindTCPRoute) {
+		var routes gatewayv1alpha2.TCPRouteList
+		err := r.List(ctx, &routes)
+		count := 0
+		for _, route := range routes.Items {
+			if countMatching(route.Annotations, route.Spec.ParentRefs, route.Namespace) {
+				count++
+			}
+		}
+		warnIfUnsupported(routeKindTCPRoute, count, err)
+	}
+
+	if !routeKindEnabled(sync, routeKindUDPRoute) {
+		var routes gatewayv1alpha2.UDPRouteList
+		err := r.List(ctx, &routes)
+		count := 0
+		for _, route := range routes.Items {
+			if countMatching(route.Annotations, route.Spec.ParentRefs, route.Namespace) {
+				count++
+			}
+		}
+		warnIfUnsupported(routeKindUDPRoute, count, err)
+	}
+}
+
+// ingressAnnotationFilterMatches reports whether an Ingress's annotations
+// satisfy Spec.Source.Ingress.AnnotationFilter (always true if unset).
+func ingressAnnotationFilterMatches(sync *cfgatev1alpha1.CloudflareDNSSync, annotations map[string]string) bool {
+	filter := sync.Spec.Source.Ingress.AnnotationFilter
+	if filter == "" {
+		return true
+	}
+	_, ok := annotations[filter]
+	return ok
+}
+
+// serviceAnnotationFilterMatches reports whether a Service's annotations
+// satisfy Spec.Source.Service.AnnotationFilter (always true if unset).
+func serviceAnnotationFilterMatches(sync *cfgatev1alpha1.CloudflareDNSSync, annotations map[string]string) bool {
+	filter := sync.Spec.Source.Service.AnnotationFilter
+	if filter == "" {
+		return true
+	}
+	_, ok := annotations[filter]
+	return ok
+}
+
+// collectHostnamesFromIngress collects hostnames from Ingress resources that
+// opt into tunnel via the same AnnotationTunnelRef Gateways use, restricted
+// to Spec.Source.Ingress.IngressClassName when set.
+func (r *CloudflareDNSSyncReconciler) collectHostnamesFromIngress(ctx context.Context, sync *cfgatev1alpha1.CloudflareDNSSync, tunnel *cfgatev1alpha1.CloudflareTunnel) ([]string, error) {
+	var ingresses networkingv1.IngressList
+	if err := r.List(ctx, &ingresses); err != nil {
+		return nil, fmt.Errorf("failed to list ingresses: %w", err)
+	}
+
+	tunnelRef := fmt.Sprintf("%s/%s", tunnel.Namespace, tunnel.Name)
+	ingressClass := sync.Spec.Source.Ingress.IngressClassName
+
+	var hostnames []string
+	for _, ing := range ingresses.Items {
+		if ref, ok := ing.Annotations[AnnotationTunnelRef]; !ok || ref != tunnelRef {
+			continue
+		}
+		if !ingressAnnotationFilterMatches(sync, ing.Annotations) {
+			continue
+		}
+		if ingressClass != "" {
+			if ing.Spec.IngressClassName == nil || *ing.Spec.IngressClassName != ingressClass {
+				continue
+			}
+		}
+		for _, rule := range ing.Spec.Rules {
+			if rule.Host != "" {
+				hostnames = append(hostnames, rule.Host)
+			}
+		}
+	}
+
+	return hostnames, nil
+}
+
+// collectHostnamesFromServices collects hostnames from Services that opt
+// into tunnel via AnnotationTunnelRef and carry AnnotationHostname, for
+// backends exposed without an Ingress or Gateway route of their own.
+func (r *CloudflareDNSSyncReconciler) collectHostnamesFromServices(ctx context.Context, sync *cfgatev1alpha1.CloudflareDNSSync, tunnel *cfgatev1alpha1.CloudflareTunnel) ([]string, error) {
+	var services corev1.ServiceList
+	if err := r.List(ctx, &services); err != nil {
+		return nil, fmt.Errorf("failed to list services: %w", err)
+	}
+
+	tunnelRef := fmt.Sprintf("%s/%s", tunnel.Namespace, tunnel.Name)
+
+	var hostnames []string
+	for _, svc := range services.Items {
+		if ref, ok := svc.Annotations[AnnotationTunnelRef]; !ok || ref != tunnelRef {
+			continue
+		}
+		if !serviceAnnotationFilterMatches(sync, svc.Annotations) {
+			continue
+		}
+		if hostname, ok := svc.Annotations[AnnotationHostname]; ok && hostname != "" {
+			hostnames = append(hostnames, hostname)
+		}
+	}
+
+	return hostnames, nil
+}
+
+// collectIngressRules walks the same Gateway/HTTPRoute graph as
+// collectHostnamesFromRoutes, but also resolves each route's first backendRef
+// into a cloudflare.TunnelIngressRule so the ingress config can route traffic,
+// not just resolve it in DNS.
+func (r *CloudflareDNSSyncReconciler) collectIngressRules(ctx context.Context, sync *cfgatev1alpha1.CloudflareDNSSync, tunnel *cfgatev1alpha1.CloudflareTunnel) ([]cloudflare.TunnelIngressRule, error) {
+	if !sync.Spec.Source.GatewayRoutes.Enabled || !routeKindEnabled(sync, routeKindHTTPRoute) {
+		return nil, nil
+	}
+
+	relevantGateways, err := r.relevantGateways(ctx, tunnel)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []cloudflare.TunnelIngressRule
+	for _, gw := range relevantGateways {
+		var routes gateway.HTTPRouteList
+		if err := r.List(ctx, &routes); err != nil {
+			return nil, fmt.Errorf("failed to list httproutes: %w", err)
+		}
+
+		for _, route := range routes.Items {
+			if !annotationFilterMatches(sync, route.Annotations) {
+				continue
+			}
+
+			if !matchesGateway(route.Spec.ParentRefs, route.Namespace, gw) || len(route.Spec.Rules) == 0 || len(route.Spec.Rules[0].BackendRefs) == 0 {
+				continue
+			}
+
+			backend := route.Spec.Rules[0].BackendRefs[0]
+			backendNS := route.Namespace
+			if backend.Namespace != nil {
+				backendNS = string(*backend.Namespace)
+			}
+			port := int32(80)
+			if backend.Port != nil {
+				port = int32(*backend.Port)
+			}
+
+			for _, h := range route.Spec.Hostnames {
+				rules = append(rules, cloudflare.BuildIngressRule(string(h), string(backend.Name), backendNS, port, false))
+			}
+		}
+	}
+
+	applyIngressOverrides(sync, rules)
+
+	return rules, nil
+}
+
+// applyIngressOverrides layers Spec.Ingress's path and origin-request
+// settings onto the ingress rules discovered from Gateway routes, matching
+// by hostname. Overrides with no matching rule are ignored: cfgate only
+// knows how to build a rule's Service from a discovered backend, so an
+// override can customize a rule but not originate one on its own.
+func applyIngressOverrides(sync *cfgatev1alpha1.CloudflareDNSSync, rules []cloudflare.TunnelIngressRule) {
+	if len(sync.Spec.Ingress) == 0 {
+		return
+	}
+
+	overridesByHostname := make(map[string]cfgatev1alpha1.IngressOverride, len(sync.Spec.Ingress))
+	for _, o := range sync.Spec.Ingress {
+		overridesByHostname[o.Hostname] = o
+	}
+
+	for i := range rules {
+		override, ok := overridesByHostname[rules[i].Hostname]
+		if !ok {
+			continue
+		}
+		rules[i].Path = override.Path
+		rules[i].OriginRequest = cloudflare.OriginRequestConfig{
+			NoTLSVerify:           override.OriginRequest.NoTLSVerify,
+			ConnectTimeoutSeconds: override.OriginRequest.ConnectTimeoutSeconds,
+			HTTPHostHeader:        override.OriginRequest.HTTPHostHeader,
+		}
+	}
 }
 
-// resolveZones resolves zone names to zone IDs.
-// Uses cached IDs if provided, otherwise looks up via API.
-func (r *CloudflareDNSSyncReconciler) resolveZones(ctx context.Context, sync *cfgatev1alpha1.CloudflareDNSSync, tunnel *cfgatev1alpha1.CloudflareTunnel) (map[string]string, error) {
-	zones := make(map[string]string)
-
-	cfClient, err := r.getCloudflareClient(ctx, tunnel)
+// resolveZones resolves sync.Spec.Zones to zone IDs, keyed by zone name,
+// and to the Cloudflare client each should be synced through: a
+// CloudflareManagedZone's own CredentialsRef when set, falling back to
+// tunnel's credentials otherwise. The zone lookup itself is owned by the
+// CloudflareManagedZone controller, not here — this only reads its cached
+// Status.ZoneID, erroring if a referenced zone hasn't resolved yet.
+func (r *CloudflareDNSSyncReconciler) resolveZones(ctx context.Context, sync *cfgatev1alpha1.CloudflareDNSSync, tunnel *cfgatev1alpha1.CloudflareTunnel) (map[string]string, map[string]cloudflare.Client, error) {
+	zones := make(map[string]string, len(sync.Spec.Zones))
+	zoneClients := make(map[string]cloudflare.Client, len(sync.Spec.Zones))
+
+	tunnelClient, err := r.getCloudflareClient(ctx, tunnel)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create Cloudflare client: %w", err)
+		return nil, nil, fmt.Errorf("failed to create Cloudflare client: %w", err)
 	}
 
-	dnsService := cloudflare.NewDNSService(cfClient)
+	for _, ref := range sync.Spec.Zones {
+		var zone cfgatev1alpha1.CloudflareManagedZone
+		if err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: sync.Namespace}, &zone); err != nil {
+			return nil, nil, fmt.Errorf("failed to get CloudflareManagedZone %s: %w", ref.Name, err)
+		}
+		if zone.Status.ZoneID == "" {
+			return nil, nil, fmt.Errorf("CloudflareManagedZone %s has not resolved a zone ID yet", ref.Name)
+		}
+		zones[zone.Spec.ZoneName] = zone.Status.ZoneID
 
-	for _, zoneConfig := range sync.Spec.Zones {
-		if zoneConfig.ID != "" {
-			// Use cached ID
-			zones[zoneConfig.Name] = zoneConfig.ID
-		} else {
-			// Look up zone
-			zone, err := dnsService.ResolveZone(ctx, zoneConfig.Name)
+		if zone.Spec.CredentialsRef != nil {
+			zoneClient, err := r.getCloudflareClientForSecretRef(ctx, zone.Namespace, zone.Spec.CredentialsRef)
 			if err != nil {
-				return nil, fmt.Errorf("failed to resolve zone %s: %w", zoneConfig.Name, err)
+				return nil, nil, fmt.Errorf("failed to create Cloudflare client for zone %s: %w", zone.Spec.ZoneName, err)
 			}
-			if zone == nil {
-				return nil, fmt.Errorf("zone %s not found", zoneConfig.Name)
-			}
-			zones[zoneConfig.Name] = zone.ID
+			zoneClients[zone.Spec.ZoneName] = zoneClient
+		} else {
+			zoneClients[zone.Spec.ZoneName] = tunnelClient
 		}
 	}
 
-	return zones, nil
+	return zones, zoneClients, nil
+}
+
+// zoneNames returns zones' keys, for passing as SplitHostnameZone's
+// candidateZones so a configured multi-label zone (e.g. a CloudflareManagedZone
+// named "team.example.com") is preferred over the Public Suffix List's
+// shorter eTLD+1 guess.
+func zoneNames(zones map[string]string) []string {
+	names := make([]string, 0, len(zones))
+	for name := range zones {
+		names = append(names, name)
+	}
+	return names
+}
+
+// ingressConfigFingerprint combines tc.Hash() (the tunnel's own DNS-relevant
+// config) with rules and removed - the inputs the tunnel ingress sync step
+// actually sends to Cloudflare - into a single hex-encoded fingerprint.
+// Comparing this against the fingerprint observed on a prior reconcile lets
+// syncRecords skip the GetTunnelConfiguration/UpdateTunnelConfiguration
+// round trip when nothing that round trip would change has moved since.
+func ingressConfigFingerprint(tc *rcontext.TunnelContext, rules []cloudflare.TunnelIngressRule, removed []string) (string, error) {
+	tunnelHash, err := tc.Hash()
+	if err != nil {
+		return "", err
+	}
+
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%d|%+v|%v", tunnelHash, rules, removed)
+	return strconv.FormatUint(h.Sum64(), 16), nil
 }
 
 // syncRecords syncs DNS records to Cloudflare.
-// Compares desired state with actual state and applies changes.
-func (r *CloudflareDNSSyncReconciler) syncRecords(ctx context.Context, sync *cfgatev1alpha1.CloudflareDNSSync, tunnel *cfgatev1alpha1.CloudflareTunnel, hostnames []string, zones map[string]string) error {
+// Compares desired state with actual state and applies changes. zoneClients
+// supplies each zone's Cloudflare client (see resolveZones), so a zone with
+// its own CloudflareManagedZone.Spec.CredentialsRef is synced through that
+// zone's credentials rather than the tunnel's.
+func (r *CloudflareDNSSyncReconciler) syncRecords(ctx context.Context, sync *cfgatev1alpha1.CloudflareDNSSync, tunnel *cfgatev1alpha1.CloudflareTunnel, hostnames []string, zones map[string]string, zoneClients map[string]cloudflare.Client, ingressRules []cloudflare.TunnelIngressRule) error {
 	log := log.FromContext(ctx)
 
-	cfClient, err := r.getCloudflareClient(ctx, tunnel)
+	timer := prometheus.NewTimer(dnsSyncDurationSeconds.WithLabelValues(sync.Namespace, sync.Name))
+	defer timer.ObserveDuration()
+
+	signingKey, err := r.getOwnershipSigningKey(ctx, sync)
+	if err != nil {
+		return fmt.Errorf("failed to resolve ownership signing key: %w", err)
+	}
+
+	apexProvider, apexZoneID, err := r.resolveApexProvider(ctx, sync)
 	if err != nil {
-		return fmt.Errorf("failed to create Cloudflare client: %w", err)
+		return fmt.Errorf("failed to resolve apex DNS provider: %w", err)
 	}
 
-	dnsService := cloudflare.NewDNSService(cfClient)
+	// recordCaches holds one ZoneRecordCache per zone, shared across every
+	// DNSService dnsServiceForZone hands out this reconcile, so the
+	// per-hostname FindRecordByName/SyncRecordSet calls below collapse into
+	// at most one ListDNSRecords call per zone instead of one per hostname.
+	recordCaches := make(map[string]*cloudflare.ZoneRecordCache)
+	recordCacheForZone := func(zoneName string) *cloudflare.ZoneRecordCache {
+		cache, ok := recordCaches[zoneName]
+		if !ok {
+			cache = cloudflare.NewZoneRecordCache(recordCacheTTL)
+			recordCaches[zoneName] = cache
+		}
+		return cache
+	}
+
+	dnsServiceForZone := func(zoneName string) *cloudflare.DNSService {
+		var svc *cloudflare.DNSService
+		if signingKey != nil {
+			svc = cloudflare.NewDNSServiceWithSigningKey(zoneClients[zoneName], signingKey, sync.Spec.Ownership.TXTRecord.AcceptLegacyMarkers)
+		} else {
+			svc = cloudflare.NewDNSService(zoneClients[zoneName])
+		}
+		return svc.WithRecordCache(recordCacheForZone(zoneName))
+	}
 	tunnelDomain := tunnel.Status.TunnelDomain
 
+	tunnelClient, err := r.getCloudflareClient(ctx, tunnel)
+	if err != nil {
+		return fmt.Errorf("failed to create Cloudflare client for tunnel: %w", err)
+	}
+
 	ownershipPrefix := sync.Spec.Ownership.TXTRecord.Prefix
 	if ownershipPrefix == "" {
 		ownershipPrefix = defaultOwnershipPrefix
 	}
 
-	var recordStatuses []cfgatev1alpha1.DNSRecordStatus
-	var syncedCount, pendingCount, failedCount int32
+	ownerID := sync.Spec.Ownership.TXTRecord.OwnerID
+	if ownerID == "" {
+		ownerID = sync.Namespace + "/" + sync.Name
+	}
 
+	// Build the desired record set and, in dry-run mode, diff it against
+	// Cloudflare's actual state to preview the plan without mutating anything.
+	desiredRecords := make([]cloudflare.DesiredRecord, 0, len(hostnames))
 	for _, hostname := range hostnames {
-		// Determine zone for this hostname
-		zoneName := cloudflare.ExtractZoneFromHostname(hostname)
+		if routingForHostname(sync, hostname) != nil {
+			continue // provisioned as a Load Balancer, not previewed as a plain CNAME
+		}
+		zoneName, _ := cloudflare.SplitHostnameZone(hostname, zoneNames(zones))
 		zoneID, ok := zones[zoneName]
 		if !ok {
-			log.Info("Zone not configured for hostname", "hostname", hostname, "zone", zoneName)
-			recordStatuses = append(recordStatuses, cfgatev1alpha1.DNSRecordStatus{
-				Hostname: hostname,
-				Type:     "CNAME",
-				Status:   "Failed",
-				Error:    fmt.Sprintf("zone %s not configured", zoneName),
-			})
-			failedCount++
 			continue
 		}
+		desiredRecords = append(desiredRecords, cloudflare.DesiredRecord{
+			ZoneID:  zoneID,
+			Name:    hostname,
+			Type:    "CNAME",
+			Content: tunnelDomain,
+			Proxied: sync.Spec.Defaults.Proxied,
+			Comment: fmt.Sprintf("managed by cfgate, tunnel=%s", tunnel.Name),
+		})
+	}
 
-		// Build desired record
-		comment := fmt.Sprintf("managed by cfgate, tunnel=%s", tunnel.Name)
-		desired := cloudflare.BuildCNAMERecord(hostname, tunnelDomain, sync.Spec.Defaults.Proxied, comment)
+	if sync.Spec.DryRun {
+		actualByZone := make(map[string][]cloudflare.DNSRecord, len(zones))
+		for zoneName, zoneID := range zones {
+			records, err := cloudflare.FetchActualState(ctx, dnsServiceForZone(zoneName), zoneID, ownershipPrefix)
+			if err != nil {
+				return fmt.Errorf("failed to fetch actual state for dry-run plan: %w", err)
+			}
+			actualByZone[zoneID] = records
+		}
 
-		// Sync record
-		record, modified, err := dnsService.SyncRecord(ctx, zoneID, desired)
-		if err != nil {
-			log.Error(err, "failed to sync DNS record", "hostname", hostname)
-			recordStatuses = append(recordStatuses, cfgatev1alpha1.DNSRecordStatus{
-				Hostname: hostname,
-				Type:     "CNAME",
-				Status:   "Failed",
-				Error:    err.Error(),
+		changes := cloudflare.Plan(cloudflare.DesiredState{Records: desiredRecords}, actualByZone, sync.Spec.PruneOrphans)
+		pending := make([]cfgatev1alpha1.PendingChange, 0, len(changes))
+		for _, change := range changes {
+			pc := cfgatev1alpha1.PendingChange{Action: string(change.Type), Hostname: change.Name, Type: change.RecordType}
+			if change.Existing != nil {
+				pc.From = change.Existing.Content
+			}
+			if change.Desired != nil {
+				pc.Target = change.Desired.Content
+			}
+			switch change.Type {
+			case cloudflare.ChangeCreate:
+				pc.Reason = "no existing record"
+			case cloudflare.ChangeUpdate:
+				pc.Reason = "content differs"
+			case cloudflare.ChangeDelete:
+				pc.Reason = "orphaned, no longer desired"
+			}
+			pending = append(pending, pc)
+			r.Recorder.Eventf(sync, nil, corev1.EventTypeNormal, "PlannedChange", "Plan", "%s", change.String())
+		}
+
+		sync.Status.PendingChanges = pending
+		log.Info("dry-run: computed change plan without applying it", "changes", len(pending))
+		return nil
+	}
+
+	sync.Status.PendingChanges = nil
+
+	var recordStatuses []cfgatev1alpha1.DNSRecordStatus
+	var syncedCount, pendingCount, failedCount int32
+
+	// newlyCreated tracks records created (not merely updated) during this
+	// reconcile, so they can be rolled back if the tunnel ingress sync fails.
+	type newRecord struct {
+		zoneName, zoneID, recordID string
+	}
+	var newlyCreated []newRecord
+
+	// Sync hostnames concurrently, bounded by Spec.Concurrency.MaxParallel and
+	// throttled by a shared per-zone/global rate limiter, so a DNSSync with
+	// hundreds of hostnames doesn't block this worker for minutes or thrash
+	// the Cloudflare API with an unbounded burst of requests.
+	maxParallel := sync.Spec.Concurrency.MaxParallel
+	if maxParallel <= 0 {
+		maxParallel = defaultMaxParallel
+	}
+	limiter := r.rateLimiter()
+
+	// shortCircuitCtx is cancelled once maxConsecutiveFailures hostnames in a
+	// row fail against Cloudflare, so the rest of the batch is abandoned
+	// instead of burning through the remaining rate-limit quota.
+	shortCircuitCtx, cancelShortCircuit := context.WithCancel(ctx)
+	defer cancelShortCircuit()
+	g, gctx := errgroup.WithContext(shortCircuitCtx)
+	g.SetLimit(int(maxParallel))
+
+	type hostnameOutcome struct {
+		attempted     bool
+		status        cfgatev1alpha1.DNSRecordStatus
+		synced        bool
+		newRecord     *newRecord
+		opKind        string // "create" or "update"; empty if unchanged
+		managedRecord *cfgatev1alpha1.ManagedRecordRef
+	}
+	outcomes := make([]hostnameOutcome, len(hostnames))
+	var consecutiveFailures int32
+	var shortCircuited int32
+
+	for i, hostname := range hostnames {
+		i, hostname := i, hostname
+		g.Go(func() error {
+			if gctx.Err() != nil {
+				return nil // batch already short-circuited
+			}
+
+			zoneName, _ := cloudflare.SplitHostnameZone(hostname, zoneNames(zones))
+			zoneID, ok := zones[zoneName]
+			if !ok {
+				log.Info("Zone not configured for hostname", "hostname", hostname, "zone", zoneName)
+				outcomes[i] = hostnameOutcome{
+					attempted: true,
+					status: cfgatev1alpha1.DNSRecordStatus{
+						Hostname: hostname, Type: "CNAME", Status: "Failed",
+						Error: fmt.Sprintf("zone %s not configured", zoneName),
+					},
+				}
+				return nil
+			}
+
+			if err := limiter.Wait(gctx, zoneID); err != nil {
+				return nil // context cancelled/short-circuited while waiting
+			}
+
+			if explicit := routingForHostname(sync, hostname); explicit != nil {
+				lbService := cloudflare.NewLoadBalancerService(zoneClients[zoneName])
+				status := r.syncRoutingHostname(gctx, tunnel, zoneID, hostname, explicit, lbService)
+				outcomes[i] = hostnameOutcome{attempted: true, synced: status.Status == "Synced", status: status}
+				return nil
+			}
+
+			comment := fmt.Sprintf("managed by cfgate, tunnel=%s", tunnel.Name)
+			desired, err := cloudflare.BuildRecordSet(cloudflare.DesiredRecordSpec{
+				Hostname:     hostname,
+				ZoneName:     zoneName,
+				TunnelDomain: tunnelDomain,
+				Proxied:      sync.Spec.Defaults.Proxied,
+				Comment:      comment,
+			})
+			if err != nil {
+				outcomes[i] = hostnameOutcome{
+					attempted: true,
+					status:    cfgatev1alpha1.DNSRecordStatus{Hostname: hostname, Type: "CNAME", Status: "Failed", Error: err.Error()},
+				}
+				return nil
+			}
+
+			dnsService := dnsServiceForZone(zoneName)
+
+			existedBefore, err := dnsService.FindRecordByName(gctx, zoneID, hostname, "CNAME")
+			if err != nil {
+				log.V(1).Info("failed to check for existing record before sync", "hostname", hostname, "error", err.Error())
+			}
+
+			var records []*cloudflare.DNSRecord
+			var modified, conflict bool
+			syncErr := cloudflare.WithBackoff(gctx, maxSyncRetries, func() error {
+				var err error
+				records, modified, conflict, err = dnsService.SyncRecordSet(gctx, zoneID, desired, ownerID, ownershipPrefix, sync.Spec.Ownership.TXTRecord.Enabled)
+				return err
 			})
+			if syncErr != nil {
+				log.Error(syncErr, "failed to sync DNS record", "hostname", hostname)
+				outcomes[i] = hostnameOutcome{
+					attempted: true,
+					status:    cfgatev1alpha1.DNSRecordStatus{Hostname: hostname, Type: "CNAME", Status: "Failed", Error: syncErr.Error()},
+				}
+				if atomic.AddInt32(&consecutiveFailures, 1) >= maxConsecutiveFailures {
+					atomic.StoreInt32(&shortCircuited, 1)
+					cancelShortCircuit()
+				}
+				return nil
+			}
+			atomic.StoreInt32(&consecutiveFailures, 0)
+
+			if conflict {
+				log.Info("DNS record owned by another CloudflareDNSSync, skipping", "hostname", hostname)
+				r.Recorder.Eventf(sync, nil, corev1.EventTypeWarning, "OwnershipConflict", "Sync",
+					"DNS record %s is owned by another CloudflareDNSSync instance, skipping", hostname)
+				outcomes[i] = hostnameOutcome{
+					attempted: true,
+					status:    cfgatev1alpha1.DNSRecordStatus{Hostname: hostname, Type: "CNAME", Status: "Failed", Error: "owned by another CloudflareDNSSync instance"},
+				}
+				return nil
+			}
+
+			record := records[0]
+			if record == nil {
+				// Shouldn't happen once conflict has already been checked
+				// above, but guards against a future desired set whose
+				// first record hits a per-record ownership conflict of its
+				// own (e.g. a multi-record BuildRecordSet result).
+				outcomes[i] = hostnameOutcome{
+					attempted: true,
+					status:    cfgatev1alpha1.DNSRecordStatus{Hostname: hostname, Type: "CNAME", Status: "Failed", Error: "owned by another CloudflareDNSSync instance"},
+				}
+				return nil
+			}
+
+			if apexProvider != nil {
+				apexRecord := cfgatedns.Record{Name: hostname, Type: "CNAME", Content: tunnelDomain}
+				if _, err := apexProvider.SyncRecord(gctx, apexZoneID, apexRecord); err != nil {
+					log.V(1).Info("apex DNS provider sync issue", "hostname", hostname, "error", err.Error())
+				}
+			}
+
+			// Create ownership TXT record if enabled
+			if sync.Spec.Ownership.TXTRecord.Enabled {
+				ownerIdentity, err := dnsService.BuildOwnerIdentity(ownerID, tunnel.Name)
+				if err != nil {
+					// Non-fatal: ownership records are supplementary, don't fail sync
+					log.V(1).Info("ownership record sync issue", "hostname", hostname, "error", err.Error())
+				} else if err := dnsService.CreateOwnershipRecord(gctx, zoneID, hostname, ownerIdentity, ownershipPrefix); err != nil {
+					log.V(1).Info("ownership record sync issue", "hostname", hostname, "error", err.Error())
+				}
+			}
+
+			outcome := hostnameOutcome{
+				attempted: true,
+				synced:    true,
+				status: cfgatev1alpha1.DNSRecordStatus{
+					Hostname: hostname,
+					Type:     record.Type,
+					Target:   record.Content,
+					Proxied:  record.Proxied,
+					Status:   "Synced",
+					RecordID: record.ID,
+				},
+			}
+			if sync.Spec.Ownership.OwnerReference.Enabled {
+				outcome.managedRecord = &cfgatev1alpha1.ManagedRecordRef{
+					ZoneID:     zoneID,
+					Hostname:   hostname,
+					RecordType: record.Type,
+					RecordID:   record.ID,
+					OwnerUID:   string(sync.UID),
+				}
+			}
+			if modified {
+				log.Info("DNS record modified", "hostname", hostname, "recordID", record.ID)
+				r.Recorder.Eventf(sync, nil, corev1.EventTypeNormal, "RecordSynced", "Sync", "DNS record synced: %s", hostname)
+				if existedBefore == nil {
+					outcome.newRecord = &newRecord{zoneName: zoneName, zoneID: zoneID, recordID: record.ID}
+					outcome.opKind = "create"
+				} else {
+					outcome.opKind = "update"
+				}
+			}
+			outcomes[i] = outcome
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	var managedRecords []cfgatev1alpha1.ManagedRecordRef
+	for _, outcome := range outcomes {
+		if !outcome.attempted {
+			continue // skipped once the batch short-circuited
+		}
+		recordStatuses = append(recordStatuses, outcome.status)
+		if outcome.synced {
+			syncedCount++
+		} else {
 			failedCount++
-			continue
 		}
+		if outcome.newRecord != nil {
+			newlyCreated = append(newlyCreated, *outcome.newRecord)
+		}
+		if outcome.opKind != "" {
+			dnsRecordOperationsTotal.WithLabelValues(outcome.opKind).Inc()
+		}
+		if outcome.managedRecord != nil {
+			managedRecords = append(managedRecords, *outcome.managedRecord)
+		}
+	}
 
-		// Create ownership TXT record if enabled
-		if sync.Spec.Ownership.TXTRecord.Enabled {
-			if err := dnsService.CreateOwnershipRecord(ctx, zoneID, hostname, tunnel.Name, ownershipPrefix); err != nil {
-				// Non-fatal: ownership records are supplementary, don't fail sync
-				log.V(1).Info("ownership record sync issue", "hostname", hostname, "error", err.Error())
+	if atomic.LoadInt32(&shortCircuited) == 1 {
+		sync.Status.Records = recordStatuses
+		sync.Status.SyncedRecords = syncedCount
+		sync.Status.PendingRecords = pendingCount
+		sync.Status.FailedRecords = failedCount
+		sync.Status.ManagedRecords = managedRecords
+		return fmt.Errorf("sync short-circuited after %d consecutive Cloudflare API failures (%d/%d hostnames synced)",
+			maxConsecutiveFailures, syncedCount, len(hostnames))
+	}
+
+	// Sync the tunnel's ingress configuration so synced hostnames actually
+	// route to their backends, not just resolve in DNS. If this fails, roll
+	// back the DNS records synced this reconcile so DNS and ingress don't
+	// drift out of consistency.
+	if sync.Spec.TunnelConfig.ManageIngress && len(ingressRules) > 0 {
+		var removedHostnames []string
+		if sync.Spec.PruneOrphans {
+			desiredSet := make(map[string]bool, len(hostnames))
+			for _, h := range hostnames {
+				desiredSet[h] = true
+			}
+			for _, prevRecord := range sync.Status.Records {
+				if !desiredSet[prevRecord.Hostname] {
+					removedHostnames = append(removedHostnames, prevRecord.Hostname)
+				}
 			}
 		}
 
-		status := "Synced"
-		if modified {
-			log.Info("DNS record modified", "hostname", hostname, "recordID", record.ID)
-			r.Recorder.Eventf(sync, nil, corev1.EventTypeNormal, "RecordSynced", "Sync", "DNS record synced: %s", hostname)
+		tc := rcontext.NewTunnelContext(tunnel, tunnel.Spec.Cloudflare.AccountID, tunnelClient, nil)
+		fingerprint, fpErr := ingressConfigFingerprint(tc, ingressRules, removedHostnames)
+		unchanged := fpErr == nil && fingerprint == sync.Status.ObservedIngressConfigHash && sync.Status.ObservedIngressConfigHash != ""
+
+		if !unchanged {
+			tunnelService := cloudflare.NewTunnelService(tunnelClient)
+			if _, err := tunnelService.SyncIngress(ctx, tunnel.Status.TunnelID, ingressRules, removedHostnames); err != nil {
+				log.Error(err, "failed to sync tunnel ingress config, rolling back DNS records synced this reconcile")
+				for _, rec := range newlyCreated {
+					if delErr := dnsServiceForZone(rec.zoneName).DeleteRecord(ctx, rec.zoneID, rec.recordID); delErr != nil {
+						log.Error(delErr, "failed to roll back DNS record after ingress sync failure", "recordID", rec.recordID)
+					}
+				}
+				return fmt.Errorf("failed to sync tunnel ingress configuration: %w", err)
+			}
+			if fpErr == nil {
+				sync.Status.ObservedIngressConfigHash = fingerprint
+			}
+		} else {
+			log.V(1).Info("tunnel ingress config unchanged since last reconcile, skipping sync")
 		}
-
-		recordStatuses = append(recordStatuses, cfgatev1alpha1.DNSRecordStatus{
-			Hostname: hostname,
-			Type:     record.Type,
-			Target:   record.Content,
-			Proxied:  record.Proxied,
-			Status:   status,
-			RecordID: record.ID,
-		})
-		syncedCount++
 	}
 
 	// Delete orphaned records (previously synced but no longer wanted)
 	for _, prevRecord := range sync.Status.Records {
+		if !sync.Spec.PruneOrphans {
+			break
+		}
 		found := false
 		for _, hostname := range hostnames {
 			if prevRecord.Hostname == hostname {
@@ -446,24 +1446,47 @@ func (r *CloudflareDNSSyncReconciler) syncRecords(ctx context.Context, sync *cfg
 		}
 		if !found && prevRecord.RecordID != "" {
 			// This record was previously synced but hostname is no longer wanted
-			zoneName := cloudflare.ExtractZoneFromHostname(prevRecord.Hostname)
+			zoneName, _ := cloudflare.SplitHostnameZone(prevRecord.Hostname, zoneNames(zones))
 			zoneID, ok := zones[zoneName]
 			if ok {
-				// Check ownership before deleting
-				existingRecord, err := dnsService.FindRecordByName(ctx, zoneID, prevRecord.Hostname, prevRecord.Type)
-				if err == nil && existingRecord != nil && cloudflare.IsOwnedByCfgate(existingRecord, "", "") {
-					if err := dnsService.DeleteRecord(ctx, zoneID, prevRecord.RecordID); err != nil {
-						log.Error(err, "failed to delete orphaned DNS record", "hostname", prevRecord.Hostname)
-					} else {
-						log.Info("Deleted orphaned DNS record", "hostname", prevRecord.Hostname)
-						r.Recorder.Eventf(sync, nil, corev1.EventTypeNormal, "RecordDeleted", "Delete", "DNS record deleted: %s", prevRecord.Hostname)
+				dnsService := dnsServiceForZone(zoneName)
+				var owned bool
+				if sync.Spec.Ownership.OwnerReference.Enabled {
+					// Trust the recorded ManagedRecords entry instead of an
+					// extra API call: if it's there with a matching UID, we created it.
+					owned = ownsManagedRecord(sync.Status.ManagedRecords, string(sync.UID), zoneID, prevRecord.Hostname, prevRecord.Type, prevRecord.RecordID)
+				} else {
+					// Check ownership before deleting
+					existingRecord, err := dnsService.FindRecordByName(ctx, zoneID, prevRecord.Hostname, prevRecord.Type)
+					if err != nil || existingRecord == nil {
+						continue
+					}
+
+					owned, err = dnsService.CheckOwnership(ctx, zoneID, prevRecord.Hostname, ownershipPrefix, ownerID, sync.Spec.Ownership.TXTRecord.Enabled, existingRecord)
+					if err != nil {
+						log.Error(err, "failed to check ownership of orphaned DNS record", "hostname", prevRecord.Hostname)
+						continue
 					}
+				}
+				if !owned {
+					log.Info("orphaned DNS record owned by another CloudflareDNSSync, not deleting", "hostname", prevRecord.Hostname)
+					r.Recorder.Eventf(sync, nil, corev1.EventTypeWarning, "OwnershipConflict", "Delete",
+						"DNS record %s is owned by another CloudflareDNSSync instance, not deleting", prevRecord.Hostname)
+					continue
+				}
+
+				if err := dnsService.DeleteRecord(ctx, zoneID, prevRecord.RecordID); err != nil {
+					log.Error(err, "failed to delete orphaned DNS record", "hostname", prevRecord.Hostname)
+				} else {
+					log.Info("Deleted orphaned DNS record", "hostname", prevRecord.Hostname)
+					r.Recorder.Eventf(sync, nil, corev1.EventTypeNormal, "RecordDeleted", "Delete", "DNS record deleted: %s", prevRecord.Hostname)
+					dnsRecordOperationsTotal.WithLabelValues("delete").Inc()
+				}
 
-					// Delete ownership TXT record if enabled
-					if sync.Spec.Ownership.TXTRecord.Enabled {
-						if err := dnsService.DeleteOwnershipRecord(ctx, zoneID, prevRecord.Hostname, ownershipPrefix); err != nil {
-							log.Error(err, "failed to delete ownership record", "hostname", prevRecord.Hostname)
-						}
+				// Delete ownership TXT record if enabled
+				if sync.Spec.Ownership.TXTRecord.Enabled {
+					if err := dnsService.DeleteOwnershipRecord(ctx, zoneID, prevRecord.Hostname, ownershipPrefix); err != nil {
+						log.Error(err, "failed to delete ownership record", "hostname", prevRecord.Hostname)
 					}
 				}
 			}
@@ -475,12 +1498,28 @@ func (r *CloudflareDNSSyncReconciler) syncRecords(ctx context.Context, sync *cfg
 	sync.Status.SyncedRecords = syncedCount
 	sync.Status.PendingRecords = pendingCount
 	sync.Status.FailedRecords = failedCount
+	sync.Status.ManagedRecords = managedRecords
 
 	return nil
 }
 
+// ownsManagedRecord reports whether managed contains an entry matching
+// zoneID/hostname/recordType/recordID with OwnerUID == ownerUID. Used by
+// Ownership.OwnerReference-mode cleanup in place of a live ownership check.
+func ownsManagedRecord(managed []cfgatev1alpha1.ManagedRecordRef, ownerUID, zoneID, hostname, recordType, recordID string) bool {
+	for _, ref := range managed {
+		if ref.ZoneID == zoneID && ref.Hostname == hostname && ref.RecordType == recordType && ref.RecordID == recordID && ref.OwnerUID == ownerUID {
+			return true
+		}
+	}
+	return false
+}
+
 // reconcileDelete handles deletion of CloudflareDNSSync.
 // Uses fallback credentials if the tunnel's credentials are unavailable.
+// Under CleanupPolicy.DeletionFailurePolicy=Fail, a Cloudflare deletion
+// error blocks finalizer removal (keeping the resource Deleting/Degraded)
+// until it's resolved or DeletionGracePeriod elapses, whichever first.
 func (r *CloudflareDNSSyncReconciler) reconcileDelete(ctx context.Context, sync *cfgatev1alpha1.CloudflareDNSSync) (ctrl.Result, error) {
 	log := log.FromContext(ctx)
 	log.Info("handling DNSSync deletion", "name", sync.Name)
@@ -490,8 +1529,11 @@ func (r *CloudflareDNSSyncReconciler) reconcileDelete(ctx context.Context, sync
 	}
 
 	// Cleanup records if policy allows
+	var deletionErrs []cfgatev1alpha1.DeletionError
 	if sync.Spec.CleanupPolicy.DeleteOnResourceRemoval {
-		if err := r.cleanupRecordsWithFallback(ctx, sync); err != nil {
+		var err error
+		deletionErrs, err = r.cleanupRecordsWithFallback(ctx, sync)
+		if err != nil {
 			log.Error(err, "failed to cleanup DNS records, records may be orphaned")
 			r.Recorder.Eventf(sync, nil, corev1.EventTypeWarning, "DNSCleanupFailed", "Cleanup",
 				"DNS cleanup failed, records may be orphaned: %v", err)
@@ -499,6 +1541,32 @@ func (r *CloudflareDNSSyncReconciler) reconcileDelete(ctx context.Context, sync
 		}
 	}
 
+	if len(deletionErrs) > 0 && deletionFailurePolicy(sync) == "Fail" {
+		gracePeriod := defaultDeletionGracePeriod
+		if sync.Spec.CleanupPolicy.DeletionGracePeriod.Duration > 0 {
+			gracePeriod = sync.Spec.CleanupPolicy.DeletionGracePeriod.Duration
+		}
+
+		if sync.DeletionTimestamp != nil && time.Since(sync.DeletionTimestamp.Time) < gracePeriod {
+			sync.Status.DeletionErrors = deletionErrs
+			r.setCondition(sync, ConditionTypeDegraded, metav1.ConditionTrue, "DeletionFailed",
+				fmt.Sprintf("%d DNS record(s) failed to delete, blocking finalizer removal", len(deletionErrs)))
+			if err := r.Status().Update(ctx, sync); err != nil {
+				log.Error(err, "failed to update status with deletion errors")
+			}
+			for _, de := range deletionErrs {
+				r.Recorder.Eventf(sync, nil, corev1.EventTypeWarning, "DNSRecordDeletionFailed", "Cleanup",
+					"failed to delete record for hostname %s (retry %d): %s", de.Hostname, de.RetryCount, de.Message)
+			}
+			return ctrl.Result{RequeueAfter: baseRetryInterval}, nil
+		}
+
+		log.Info("DeletionGracePeriod elapsed with unresolved deletion errors, proceeding with finalizer removal",
+			"name", sync.Name, "failedRecords", len(deletionErrs))
+		r.Recorder.Eventf(sync, nil, corev1.EventTypeWarning, "DeletionGracePeriodExceeded", "Cleanup",
+			"%d DNS record(s) still failing to delete after grace period, removing finalizer anyway", len(deletionErrs))
+	}
+
 	// Remove finalizer using patch to reduce lock contention
 	patch := client.MergeFrom(sync.DeepCopy())
 	controllerutil.RemoveFinalizer(sync, dnsSyncFinalizer)
@@ -509,6 +1577,75 @@ func (r *CloudflareDNSSyncReconciler) reconcileDelete(ctx context.Context, sync
 	return ctrl.Result{}, nil
 }
 
+// deletionFailurePolicy returns sync's configured
+// CleanupPolicy.DeletionFailurePolicy, defaulting to Ignore when unset.
+func deletionFailurePolicy(sync *cfgatev1alpha1.CloudflareDNSSync) string {
+	if sync.Spec.CleanupPolicy.DeletionFailurePolicy == "" {
+		return "Ignore"
+	}
+	return sync.Spec.CleanupPolicy.DeletionFailurePolicy
+}
+
+// maxDeletionRetries returns sync's configured
+// CleanupPolicy.MaxDeletionRetries, defaulting to defaultMaxDeletionRetries
+// when unset.
+func maxDeletionRetries(sync *cfgatev1alpha1.CloudflareDNSSync) int32 {
+	if sync.Spec.CleanupPolicy.MaxDeletionRetries <= 0 {
+		return defaultMaxDeletionRetries
+	}
+	return sync.Spec.CleanupPolicy.MaxDeletionRetries
+}
+
+// deleteRecordForCleanup deletes a single DNS record during resource
+// teardown, honoring CleanupPolicy.DeletionFailurePolicy. A 404 is treated
+// as already-deleted, never as a failure. Under Retry or Fail, the delete
+// is retried up to MaxDeletionRetries with exponential backoff; under Retry
+// the final failure is only logged, so deletion proceeds regardless, while
+// under Fail it's returned for the caller to block finalizer removal on.
+func (r *CloudflareDNSSyncReconciler) deleteRecordForCleanup(ctx context.Context, dnsService *cloudflare.DNSService, sync *cfgatev1alpha1.CloudflareDNSSync, hostname, zoneID, recordID string) *cfgatev1alpha1.DeletionError {
+	log := log.FromContext(ctx)
+	policy := deletionFailurePolicy(sync)
+
+	attempts := int32(1)
+	if policy != "Ignore" {
+		attempts = maxDeletionRetries(sync)
+	}
+
+	var lastErr error
+	var attempt int32
+	for attempt = 1; attempt <= attempts; attempt++ {
+		lastErr = dnsService.DeleteRecord(ctx, zoneID, recordID)
+		if lastErr == nil || cloudflare.IsNotFoundError(lastErr) {
+			return nil
+		}
+		if attempt == attempts || ctx.Err() != nil {
+			break
+		}
+
+		wait := time.Duration(1<<uint(attempt-1)) * time.Second
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+		}
+	}
+
+	log.Error(lastErr, "failed to delete DNS record", "hostname", hostname, "attempts", attempt)
+
+	if policy != "Fail" {
+		return nil
+	}
+
+	return &cfgatev1alpha1.DeletionError{
+		Hostname:        hostname,
+		ZoneID:          zoneID,
+		RecordID:        recordID,
+		Code:            cloudflare.ErrorCode(lastErr),
+		Message:         lastErr.Error(),
+		RetryCount:      attempt,
+		LastAttemptTime: metav1.Now(),
+	}
+}
+
 // updateStatus updates the CloudflareDNSSync status only if it has changed.
 // This avoids unnecessary API calls and prevents watch events from status-only updates.
 func (r *CloudflareDNSSyncReconciler) updateStatus(ctx context.Context, sync *cfgatev1alpha1.CloudflareDNSSync) error {
@@ -566,6 +1703,30 @@ func statusEqual(a, b *cfgatev1alpha1.CloudflareDNSSyncStatus) bool {
 		return false
 	}
 
+	// Compare owner-reference-tracked records
+	if !reflect.DeepEqual(a.ManagedRecords, b.ManagedRecords) {
+		return false
+	}
+
+	// Compare pending change previews (dry-run mode)
+	if !reflect.DeepEqual(a.PendingChanges, b.PendingChanges) {
+		return false
+	}
+
+	// Compare deletion errors (CleanupPolicy.DeletionFailurePolicy)
+	if !reflect.DeepEqual(a.DeletionErrors, b.DeletionErrors) {
+		return false
+	}
+
+	// Compare health check state (consecutive failures carry over between
+	// reconciles through status) and the observed ingress fingerprint
+	if !reflect.DeepEqual(a.HealthCheckProbes, b.HealthCheckProbes) ||
+		a.HealthyRecords != b.HealthyRecords ||
+		a.UnhealthyRecords != b.UnhealthyRecords ||
+		a.ObservedIngressConfigHash != b.ObservedIngressConfigHash {
+		return false
+	}
+
 	return true
 }
 
@@ -601,6 +1762,60 @@ func (r *CloudflareDNSSyncReconciler) getCloudflareClient(ctx context.Context, t
 	return r.createClientFromSecret(secret, tunnel.Spec.Cloudflare.SecretKeys.APIToken)
 }
 
+// getCloudflareClientForSecretRef builds a Cloudflare client from an
+// arbitrary credentials secret reference, defaulting its namespace to
+// defaultNamespace. Used for CloudflareManagedZone.Spec.CredentialsRef,
+// which decouples a zone's API token from its CloudflareDNSSync's tunnel.
+func (r *CloudflareDNSSyncReconciler) getCloudflareClientForSecretRef(ctx context.Context, defaultNamespace string, ref *cfgatev1alpha1.SecretReference) (cloudflare.Client, error) {
+	if r.CFClient != nil {
+		return r.CFClient, nil
+	}
+
+	secretNamespace := ref.Namespace
+	if secretNamespace == "" {
+		secretNamespace = defaultNamespace
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: secretNamespace}, secret); err != nil {
+		return nil, fmt.Errorf("failed to get credentials secret: %w", err)
+	}
+
+	if r.CredentialCache != nil {
+		return r.CredentialCache.GetOrCreate(ctx, secret, func() (cloudflare.Client, error) {
+			return r.createClientFromSecret(secret, "")
+		})
+	}
+	return r.createClientFromSecret(secret, "")
+}
+
+// getOwnershipSigningKey resolves sync.Spec.Ownership.TXTRecord.SigningKeySecretRef
+// into the raw HMAC key DNSServiceWithSigningKey signs and verifies ownership
+// TXT records with. Returns nil, nil if no signing key is configured.
+func (r *CloudflareDNSSyncReconciler) getOwnershipSigningKey(ctx context.Context, sync *cfgatev1alpha1.CloudflareDNSSync) ([]byte, error) {
+	ref := sync.Spec.Ownership.TXTRecord.SigningKeySecretRef
+	if ref == nil {
+		return nil, nil
+	}
+
+	secretNamespace := ref.Namespace
+	if secretNamespace == "" {
+		secretNamespace = sync.Namespace
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: secretNamespace}, secret); err != nil {
+		return nil, fmt.Errorf("failed to get signing key secret: %w", err)
+	}
+
+	key, ok := secret.Data["key"]
+	if !ok {
+		return nil, fmt.Errorf("signing key secret %s/%s has no %q data entry", secretNamespace, ref.Name, "key")
+	}
+
+	return key, nil
+}
+
 // createClientFromSecret creates a Cloudflare client from a secret.
 func (r *CloudflareDNSSyncReconciler) createClientFromSecret(secret *corev1.Secret, tokenKey string) (cloudflare.Client, error) {
 	if tokenKey == "" {
@@ -664,52 +1879,101 @@ func (r *CloudflareDNSSyncReconciler) getCloudflareClientWithFallback(ctx contex
 }
 
 // cleanupRecordsWithFallback deletes managed DNS records using fallback credentials if needed.
-func (r *CloudflareDNSSyncReconciler) cleanupRecordsWithFallback(ctx context.Context, sync *cfgatev1alpha1.CloudflareDNSSync) error {
+func (r *CloudflareDNSSyncReconciler) cleanupRecordsWithFallback(ctx context.Context, sync *cfgatev1alpha1.CloudflareDNSSync) ([]cfgatev1alpha1.DeletionError, error) {
 	log := log.FromContext(ctx)
 
 	// Get Cloudflare client (with fallback)
 	cfClient, err := r.getCloudflareClientWithFallback(ctx, sync)
 	if err != nil {
-		return fmt.Errorf("failed to get Cloudflare client: %w", err)
+		return nil, fmt.Errorf("failed to get Cloudflare client: %w", err)
+	}
+
+	signingKey, err := r.getOwnershipSigningKey(ctx, sync)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve ownership signing key: %w", err)
+	}
+
+	newDNSService := func(client cloudflare.Client) *cloudflare.DNSService {
+		if signingKey != nil {
+			return cloudflare.NewDNSServiceWithSigningKey(client, signingKey, sync.Spec.Ownership.TXTRecord.AcceptLegacyMarkers)
+		}
+		return cloudflare.NewDNSService(client)
 	}
 
-	dnsService := cloudflare.NewDNSService(cfClient)
+	dnsService := newDNSService(cfClient)
+
+	if sync.Spec.Ownership.OwnerReference.Enabled {
+		return r.cleanupManagedRecords(ctx, dnsService, sync), nil
+	}
 
 	ownershipPrefix := sync.Spec.Ownership.TXTRecord.Prefix
 	if ownershipPrefix == "" {
 		ownershipPrefix = defaultOwnershipPrefix
 	}
 
-	// Get tunnel name for ownership check (may not be available)
-	var tunnelName string
-	tunnel, err := r.resolveTunnel(ctx, sync)
-	if err == nil {
-		tunnelName = tunnel.Name
+	ownerID := sync.Spec.Ownership.TXTRecord.OwnerID
+	if ownerID == "" {
+		ownerID = sync.Namespace + "/" + sync.Name
 	}
 
-	// For each zone, find and delete managed records
-	for _, zoneConfig := range sync.Spec.Zones {
-		zoneID := zoneConfig.ID
-		if zoneID == "" {
-			zone, err := dnsService.ResolveZone(ctx, zoneConfig.Name)
-			if err != nil || zone == nil {
-				log.Error(err, "failed to resolve zone for cleanup", "zone", zoneConfig.Name)
+	var deletionErrs []cfgatev1alpha1.DeletionError
+
+	// For each referenced CloudflareManagedZone, find and delete managed records.
+	for _, ref := range sync.Spec.Zones {
+		var zone cfgatev1alpha1.CloudflareManagedZone
+		if err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: sync.Namespace}, &zone); err != nil {
+			log.Error(err, "failed to get CloudflareManagedZone for cleanup", "zone", ref.Name)
+			continue
+		}
+		if zone.Status.ZoneID == "" {
+			log.Info("CloudflareManagedZone has no resolved zone ID, skipping cleanup", "zone", ref.Name)
+			continue
+		}
+		zoneID := zone.Status.ZoneID
+
+		zoneDNSService := dnsService
+		if zone.Spec.CredentialsRef != nil {
+			zoneClient, err := r.getCloudflareClientForSecretRef(ctx, zone.Namespace, zone.Spec.CredentialsRef)
+			if err != nil {
+				log.Error(err, "failed to create Cloudflare client for zone cleanup", "zone", zone.Spec.ZoneName)
 				continue
 			}
-			zoneID = zone.ID
+			zoneDNSService = newDNSService(zoneClient)
 		}
 
 		// List managed records
-		records, err := dnsService.ListManagedRecords(ctx, zoneID, ownershipPrefix)
+		records, err := zoneDNSService.ListManagedRecords(ctx, zoneID, ownershipPrefix)
 		if err != nil {
-			log.Error(err, "failed to list managed records", "zone", zoneConfig.Name)
+			log.Error(err, "failed to list managed records", "zone", zone.Spec.ZoneName)
 			continue
 		}
 
 		for _, record := range records {
-			if cloudflare.IsOwnedByCfgate(&record, "", tunnelName) || !sync.Spec.CleanupPolicy.OnlyManaged {
-				if err := dnsService.DeleteRecord(ctx, zoneID, record.ID); err != nil {
-					log.Error(err, "failed to delete DNS record", "record", record.Name)
+			var owned bool
+			if record.Type == "TXT" {
+				owner, ok := "", false
+				if signingKey != nil {
+					owner, ok = cloudflare.VerifySignedOwnerIdentity(signingKey, record.Content)
+					if !ok && sync.Spec.Ownership.TXTRecord.AcceptLegacyMarkers {
+						owner, ok = cloudflare.ParseOwnerIdentity(record.Content)
+					}
+				} else {
+					owner, ok = cloudflare.ParseOwnerIdentity(record.Content)
+				}
+				if ok {
+					owned = owner == ownerID
+				}
+			} else {
+				owned, err = zoneDNSService.CheckOwnership(ctx, zoneID, record.Name, ownershipPrefix, ownerID, sync.Spec.Ownership.TXTRecord.Enabled, &record)
+				if err != nil {
+					log.Error(err, "failed to check record ownership", "record", record.Name)
+					continue
+				}
+			}
+
+			if owned || !sync.Spec.CleanupPolicy.OnlyManaged {
+				if de := r.deleteRecordForCleanup(ctx, zoneDNSService, sync, record.Name, zoneID, record.ID); de != nil {
+					deletionErrs = append(deletionErrs, *de)
 				} else {
 					log.Info("Deleted DNS record", "record", record.Name)
 				}
@@ -717,7 +1981,44 @@ func (r *CloudflareDNSSyncReconciler) cleanupRecordsWithFallback(ctx context.Con
 		}
 	}
 
-	return nil
+	return deletionErrs, nil
+}
+
+// cleanupManagedRecords deletes exactly the records recorded in
+// Status.ManagedRecords, used when Ownership.OwnerReference.Enabled: no zone
+// listing or TXT/comment filtering needed, since every record this instance
+// created is already known by ID. If TXTRecord ownership is also enabled
+// (Both mode), its sibling TXT record is deleted alongside each entry.
+func (r *CloudflareDNSSyncReconciler) cleanupManagedRecords(ctx context.Context, dnsService *cloudflare.DNSService, sync *cfgatev1alpha1.CloudflareDNSSync) []cfgatev1alpha1.DeletionError {
+	log := log.FromContext(ctx)
+	ownerUID := string(sync.UID)
+
+	txtPrefix := sync.Spec.Ownership.TXTRecord.Prefix
+	if txtPrefix == "" {
+		txtPrefix = defaultOwnershipPrefix
+	}
+
+	var deletionErrs []cfgatev1alpha1.DeletionError
+
+	for _, ref := range sync.Status.ManagedRecords {
+		if ref.OwnerUID != ownerUID {
+			continue
+		}
+
+		if de := r.deleteRecordForCleanup(ctx, dnsService, sync, ref.Hostname, ref.ZoneID, ref.RecordID); de != nil {
+			deletionErrs = append(deletionErrs, *de)
+		} else {
+			log.Info("Deleted managed DNS record", "hostname", ref.Hostname)
+		}
+
+		if sync.Spec.Ownership.TXTRecord.Enabled {
+			if err := dnsService.DeleteOwnershipRecord(ctx, ref.ZoneID, ref.Hostname, txtPrefix); err != nil {
+				log.Error(err, "failed to delete ownership record", "hostname", ref.Hostname)
+			}
+		}
+	}
+
+	return deletionErrs
 }
 
 // setCondition sets a condition on the DNSSync status.
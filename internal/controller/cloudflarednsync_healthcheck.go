@@ -0,0 +1,199 @@
+// Package controller contains the reconciliation logic for cfgate CRDs.
+package controller
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	cfgatev1alpha1 "cfgate.io/cfgate/api/v1alpha1"
+	"cfgate.io/cfgate/internal/cloudflare"
+)
+
+// reconcileHealthChecks ensures a Cloudflare Health Check exists for every
+// hostname with health checking enabled (Spec.HealthCheck or a per-hostname
+// override), records each probe's result into
+// Status.HealthCheckProbes/HealthyRecords/UnhealthyRecords, and applies
+// UnhealthyAction once a hostname crosses its FailureThreshold. Runs after
+// syncRecords so it can read the freshly synced Status.Records. zoneClients
+// supplies each zone's Cloudflare client, matching whichever credentials
+// (tunnel or CloudflareManagedZone.Spec.CredentialsRef) resolveZones picked
+// for it.
+func (r *CloudflareDNSSyncReconciler) reconcileHealthChecks(ctx context.Context, sync *cfgatev1alpha1.CloudflareDNSSync, zones map[string]string, zoneClients map[string]cloudflare.Client) error {
+	logger := log.FromContext(ctx)
+
+	if !anyHealthCheckEnabled(sync) {
+		sync.Status.HealthCheckProbes = nil
+		sync.Status.HealthyRecords = 0
+		sync.Status.UnhealthyRecords = 0
+		return nil
+	}
+
+	previous := make(map[string]cfgatev1alpha1.CloudflareHealthCheckProbe, len(sync.Status.HealthCheckProbes))
+	for _, p := range sync.Status.HealthCheckProbes {
+		previous[p.Hostname] = p
+	}
+
+	var probes []cfgatev1alpha1.CloudflareHealthCheckProbe
+	var healthy, unhealthy int32
+
+	for i := range sync.Status.Records {
+		rec := &sync.Status.Records[i]
+
+		policy := effectiveHealthCheckPolicy(sync, rec.Hostname)
+		if policy == nil || !policy.Enabled {
+			continue
+		}
+
+		zoneName, _ := cloudflare.SplitHostnameZone(rec.Hostname, zoneNames(zones))
+		zoneID, ok := zones[zoneName]
+		if !ok {
+			continue
+		}
+		zoneClient, ok := zoneClients[zoneName]
+		if !ok {
+			continue
+		}
+		hcService := cloudflare.NewHealthCheckService(zoneClient)
+		dnsService := cloudflare.NewDNSService(zoneClient)
+
+		check, err := hcService.EnsureHealthCheck(ctx, zoneID, healthCheckFromPolicy(rec.Hostname, policy))
+		if err != nil {
+			logger.Error(err, "failed to ensure health check", "hostname", rec.Hostname)
+			continue
+		}
+
+		status, err := hcService.GetStatus(ctx, zoneID, check.ID)
+		if err != nil {
+			logger.Error(err, "failed to get health check status", "hostname", rec.Hostname)
+			continue
+		}
+
+		now := metav1.Now()
+		probe := cfgatev1alpha1.CloudflareHealthCheckProbe{
+			Hostname:      rec.Hostname,
+			HealthCheckID: check.ID,
+			Healthy:       status.Healthy,
+			LastProbeTime: &now,
+		}
+		if status.Healthy {
+			probe.ConsecutiveFailures = 0
+		} else {
+			probe.ConsecutiveFailures = previous[rec.Hostname].ConsecutiveFailures + 1
+		}
+
+		if probe.Healthy {
+			healthy++
+		} else {
+			unhealthy++
+		}
+
+		if !probe.Healthy && probe.ConsecutiveFailures >= policy.FailureThreshold {
+			r.handleUnhealthyHostname(ctx, sync, dnsService, zoneID, rec, policy)
+		}
+
+		probes = append(probes, probe)
+	}
+
+	sync.Status.HealthCheckProbes = probes
+	sync.Status.HealthyRecords = healthy
+	sync.Status.UnhealthyRecords = unhealthy
+	return nil
+}
+
+// handleUnhealthyHostname applies policy.UnhealthyAction to a hostname that
+// has crossed its FailureThreshold: Remove deletes the (single-target)
+// CNAME record until the hostname recovers; MarkOnly leaves the record in
+// place and only reflects the unhealthy state on rec.Status. Both emit an
+// Event so the transition is visible without polling status.
+//
+// Remove is rejected for a multi-target record set (a Load-Balancer-backed
+// hostname with more than one Target): deleting the whole record would take
+// every healthy target down with the unhealthy one. Per-target removal
+// would mean disabling just the failing origin in its Load Balancer pool,
+// which this health check (a plain hostname-level probe, independent of
+// the pool's own per-origin monitors) has no basis to pick out, so for now
+// Remove only ever acts on single-target records.
+func (r *CloudflareDNSSyncReconciler) handleUnhealthyHostname(ctx context.Context, sync *cfgatev1alpha1.CloudflareDNSSync, dnsService *cloudflare.DNSService, zoneID string, rec *cfgatev1alpha1.DNSRecordStatus, policy *cfgatev1alpha1.DNSHealthCheckPolicy) {
+	logger := log.FromContext(ctx)
+
+	if policy.UnhealthyAction == "Remove" && len(rec.Targets) > 1 {
+		logger.Info("UnhealthyAction=Remove is unsupported for a multi-target record set, leaving record in place", "hostname", rec.Hostname)
+		if rec.Status != "Unhealthy" {
+			rec.Status = "Unhealthy"
+			r.Recorder.Eventf(sync, nil, corev1.EventTypeWarning, "UnhealthyActionUnsupported", "HealthCheck",
+				"hostname %s failed %d consecutive health checks, but UnhealthyAction=Remove only supports single-target records; record left in place", rec.Hostname, policy.FailureThreshold)
+		}
+		return
+	}
+
+	if policy.UnhealthyAction == "Remove" {
+		existing, err := dnsService.FindRecordByName(ctx, zoneID, rec.Hostname, rec.Type)
+		if err != nil {
+			logger.Error(err, "failed to look up unhealthy record for removal", "hostname", rec.Hostname)
+			return
+		}
+		if existing == nil {
+			return // already removed, e.g. by a previous reconcile
+		}
+		if err := dnsService.DeleteRecord(ctx, zoneID, existing.ID); err != nil {
+			logger.Error(err, "failed to remove unhealthy record", "hostname", rec.Hostname)
+			return
+		}
+		rec.Status = "Unhealthy"
+		r.Recorder.Eventf(sync, nil, corev1.EventTypeWarning, "HostnameUnhealthy", "HealthCheck",
+			"hostname %s failed %d consecutive health checks, record removed", rec.Hostname, policy.FailureThreshold)
+		return
+	}
+
+	// MarkOnly: leave the record in place, just surface the state.
+	if rec.Status != "Unhealthy" {
+		rec.Status = "Unhealthy"
+		r.Recorder.Eventf(sync, nil, corev1.EventTypeWarning, "HostnameUnhealthy", "HealthCheck",
+			"hostname %s failed %d consecutive health checks", rec.Hostname, policy.FailureThreshold)
+	}
+}
+
+// anyHealthCheckEnabled reports whether health checking is enabled for
+// sync's own policy or any per-hostname override, so reconcileHealthChecks
+// can skip entirely (and clear stale status) when nothing opts in.
+func anyHealthCheckEnabled(sync *cfgatev1alpha1.CloudflareDNSSync) bool {
+	if sync.Spec.HealthCheck.Enabled {
+		return true
+	}
+	for _, hostname := range sync.Spec.Source.Explicit {
+		if hostname.HealthCheck != nil && hostname.HealthCheck.Enabled {
+			return true
+		}
+	}
+	return false
+}
+
+// effectiveHealthCheckPolicy resolves the health check policy that applies
+// to hostname: its ExplicitHostname override if one exists, otherwise
+// Spec.HealthCheck.
+func effectiveHealthCheckPolicy(sync *cfgatev1alpha1.CloudflareDNSSync, hostname string) *cfgatev1alpha1.DNSHealthCheckPolicy {
+	for _, explicit := range sync.Spec.Source.Explicit {
+		if explicit.Hostname == hostname && explicit.HealthCheck != nil {
+			return explicit.HealthCheck
+		}
+	}
+	return &sync.Spec.HealthCheck
+}
+
+// healthCheckFromPolicy renders policy into the cloudflare.HealthCheck
+// EnsureHealthCheck expects for hostname.
+func healthCheckFromPolicy(hostname string, policy *cfgatev1alpha1.DNSHealthCheckPolicy) cloudflare.HealthCheck {
+	return cloudflare.HealthCheck{
+		Address:                      hostname,
+		Type:                         policy.Protocol,
+		Path:                         policy.Path,
+		Port:                         policy.Port,
+		ExpectedCodes:                policy.ExpectedResponseCodes,
+		IntervalSeconds:              int32(policy.Interval.Duration.Seconds()),
+		ConsecutiveFailuresThreshold: policy.FailureThreshold,
+		AllowInsecure:                policy.AllowInsecureCertificate,
+	}
+}
@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+
+	cfgatev1alpha1 "cfgate.io/cfgate/api/v1alpha1"
+	"cfgate.io/cfgate/internal/cloudflare"
+	"cfgate.io/cfgate/internal/cloudflare/mocks"
+)
+
+// fakeEventRecorder is a minimal events.EventRecorder that just captures
+// every call, so tests can assert on the reason without a generated mock
+// for client-go's events package.
+type fakeEventRecorder struct {
+	reasons []string
+}
+
+func (f *fakeEventRecorder) Eventf(regarding, related runtime.Object, eventtype, reason, action, note string, args ...interface{}) {
+	f.reasons = append(f.reasons, reason)
+}
+
+func TestHandleUnhealthyHostname_RemoveRejectedForMultiTarget(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	api := mocks.NewMockCloudflareAPI(ctrl)
+	// No DeleteDNSRecord/FindRecordByName calls expected: Remove must be
+	// rejected before any Cloudflare API call for a multi-target record.
+	dnsService := cloudflare.NewDNSService(api)
+
+	recorder := &fakeEventRecorder{}
+	r := &CloudflareDNSSyncReconciler{Recorder: recorder}
+
+	sync := &cfgatev1alpha1.CloudflareDNSSync{}
+	rec := &cfgatev1alpha1.DNSRecordStatus{
+		Hostname: "app.example.com",
+		Type:     "CNAME",
+		Status:   "Synced",
+		Targets: []cfgatev1alpha1.TargetStatus{
+			{Target: "origin-a.internal"},
+			{Target: "origin-b.internal"},
+		},
+	}
+	policy := &cfgatev1alpha1.DNSHealthCheckPolicy{UnhealthyAction: "Remove", FailureThreshold: 3}
+
+	r.handleUnhealthyHostname(context.Background(), sync, dnsService, "zone-1", rec, policy)
+
+	if rec.Status != "Unhealthy" {
+		t.Fatalf("expected rec.Status = Unhealthy, got %q", rec.Status)
+	}
+	if len(recorder.reasons) != 1 || recorder.reasons[0] != "UnhealthyActionUnsupported" {
+		t.Fatalf("expected a single UnhealthyActionUnsupported event, got %v", recorder.reasons)
+	}
+}
+
+func TestHandleUnhealthyHostname_RemoveDeletesSingleTargetRecord(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	api := mocks.NewMockCloudflareAPI(ctrl)
+	dnsService := cloudflare.NewDNSService(api)
+
+	recorder := &fakeEventRecorder{}
+	r := &CloudflareDNSSyncReconciler{Recorder: recorder}
+
+	sync := &cfgatev1alpha1.CloudflareDNSSync{}
+	rec := &cfgatev1alpha1.DNSRecordStatus{
+		Hostname: "app.example.com",
+		Type:     "CNAME",
+		Status:   "Synced",
+		Targets:  []cfgatev1alpha1.TargetStatus{{Target: "origin-a.internal"}},
+	}
+	policy := &cfgatev1alpha1.DNSHealthCheckPolicy{UnhealthyAction: "Remove", FailureThreshold: 3}
+
+	api.EXPECT().ListDNSRecords(gomock.Any(), "zone-1").Return([]cloudflare.DNSRecord{
+		{ID: "record-1", Name: "app.example.com", Type: "CNAME"},
+	}, nil)
+	api.EXPECT().DeleteDNSRecord(gomock.Any(), "zone-1", "record-1").Return(nil)
+
+	r.handleUnhealthyHostname(context.Background(), sync, dnsService, "zone-1", rec, policy)
+
+	if rec.Status != "Unhealthy" {
+		t.Fatalf("expected rec.Status = Unhealthy, got %q", rec.Status)
+	}
+	if len(recorder.reasons) != 1 || recorder.reasons[0] != "HostnameUnhealthy" {
+		t.Fatalf("expected a single HostnameUnhealthy event, got %v", recorder.reasons)
+	}
+}
+
+func TestHandleUnhealthyHostname_MarkOnlyLeavesRecordInPlace(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	api := mocks.NewMockCloudflareAPI(ctrl)
+	// MarkOnly never touches the Cloudflare API: no expectations set.
+	dnsService := cloudflare.NewDNSService(api)
+
+	recorder := &fakeEventRecorder{}
+	r := &CloudflareDNSSyncReconciler{Recorder: recorder}
+
+	sync := &cfgatev1alpha1.CloudflareDNSSync{}
+	rec := &cfgatev1alpha1.DNSRecordStatus{
+		Hostname: "app.example.com",
+		Type:     "CNAME",
+		Status:   "Synced",
+		Targets: []cfgatev1alpha1.TargetStatus{
+			{Target: "origin-a.internal"},
+			{Target: "origin-b.internal"},
+		},
+	}
+	policy := &cfgatev1alpha1.DNSHealthCheckPolicy{UnhealthyAction: "MarkOnly", FailureThreshold: 3}
+
+	r.handleUnhealthyHostname(context.Background(), sync, dnsService, "zone-1", rec, policy)
+
+	if rec.Status != "Unhealthy" {
+		t.Fatalf("expected rec.Status = Unhealthy, got %q", rec.Status)
+	}
+	if len(recorder.reasons) != 1 || recorder.reasons[0] != "HostnameUnhealthy" {
+		t.Fatalf("expected a single HostnameUnhealthy event, got %v", recorder.reasons)
+	}
+
+	recorder.reasons = nil
+	r.handleUnhealthyHostname(context.Background(), sync, dnsService, "zone-1", rec, policy)
+	if len(recorder.reasons) != 0 {
+		t.Fatalf("expected no further event once rec.Status is already Unhealthy, got %v", recorder.reasons)
+	}
+}
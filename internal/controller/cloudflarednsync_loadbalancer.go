@@ -0,0 +1,148 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	cfgatev1alpha1 "cfgate.io/cfgate/api/v1alpha1"
+	"cfgate.io/cfgate/internal/cloudflare"
+)
+
+// routingForHostname returns the ExplicitHostname entry backing hostname
+// when it carries a non-empty Routing set, so syncRecords can provision a
+// Cloudflare Load Balancer instead of a plain CNAME for it.
+func routingForHostname(sync *cfgatev1alpha1.CloudflareDNSSync, hostname string) *cfgatev1alpha1.ExplicitHostname {
+	for i := range sync.Spec.Source.Explicit {
+		explicit := &sync.Spec.Source.Explicit[i]
+		if explicit.Hostname == hostname && len(explicit.Routing) > 0 {
+			return explicit
+		}
+	}
+	return nil
+}
+
+// syncRoutingHostname provisions (or updates) the Cloudflare Load Balancer
+// pool and load balancer backing hostname's Routing targets, and returns
+// the resulting DNSRecordStatus. Called instead of the plain-CNAME path in
+// syncRecords when routingForHostname finds a match.
+func (r *CloudflareDNSSyncReconciler) syncRoutingHostname(ctx context.Context, tunnel *cfgatev1alpha1.CloudflareTunnel, zoneID, hostname string, explicit *cfgatev1alpha1.ExplicitHostname, lbService *cloudflare.LoadBalancerService) cfgatev1alpha1.DNSRecordStatus {
+	accountID := tunnel.Spec.Cloudflare.AccountID
+
+	policy := explicit.RoutingPolicy
+	if policy == "" {
+		policy = cfgatev1alpha1.RoutingPolicyWeighted
+	}
+
+	pool, err := lbService.EnsurePool(ctx, accountID, hostname, cloudflare.LoadBalancerPool{Origins: poolOriginsFor(explicit.Routing)})
+	if err != nil {
+		return cfgatev1alpha1.DNSRecordStatus{Hostname: hostname, Type: "LoadBalancer", Status: "Failed", Error: err.Error()}
+	}
+
+	lb := cloudflare.LoadBalancer{
+		Name:           hostname,
+		DefaultPools:   []string{pool.ID},
+		SteeringPolicy: steeringPolicyFor(policy),
+		Proxied:        explicit.Proxied,
+	}
+	if policy == cfgatev1alpha1.RoutingPolicyGeo {
+		regionPools, err := ensureGeoRegionPools(ctx, accountID, hostname, explicit.Routing, lbService)
+		if err != nil {
+			return cfgatev1alpha1.DNSRecordStatus{Hostname: hostname, Type: "LoadBalancer", Status: "Failed", Error: err.Error()}
+		}
+		lb.RegionPools = regionPools
+	}
+
+	balancer, err := lbService.EnsureLoadBalancer(ctx, zoneID, lb)
+	if err != nil {
+		return cfgatev1alpha1.DNSRecordStatus{Hostname: hostname, Type: "LoadBalancer", Status: "Failed", Error: err.Error()}
+	}
+
+	status := cfgatev1alpha1.DNSRecordStatus{
+		Hostname:       hostname,
+		Type:           "LoadBalancer",
+		Status:         "Synced",
+		PoolID:         pool.ID,
+		LoadBalancerID: balancer.ID,
+	}
+
+	if poolStatus, err := lbService.GetPoolStatus(ctx, accountID, pool.ID); err == nil {
+		status.Targets = make([]cfgatev1alpha1.TargetStatus, 0, len(poolStatus.Origins))
+		for _, origin := range poolStatus.Origins {
+			status.Targets = append(status.Targets, cfgatev1alpha1.TargetStatus{
+				Target:        origin.Address,
+				Healthy:       origin.Healthy,
+				CurrentWeight: origin.Weight,
+			})
+		}
+	}
+
+	return status
+}
+
+// steeringPolicyFor maps a RoutingPolicy to the Cloudflare Load Balancer
+// steering_policy value that implements it.
+func steeringPolicyFor(policy cfgatev1alpha1.RoutingPolicy) string {
+	switch policy {
+	case cfgatev1alpha1.RoutingPolicyGeo:
+		return "geo"
+	case cfgatev1alpha1.RoutingPolicyFailover:
+		return "off"
+	default:
+		return "random"
+	}
+}
+
+// poolOriginsFor converts targets into the PoolOrigin slice EnsurePool
+// expects, normalizing Weight across all of targets.
+func poolOriginsFor(targets []cfgatev1alpha1.TargetEntry) []cloudflare.PoolOrigin {
+	totalWeight := 0
+	for _, target := range targets {
+		weight := target.Weight
+		if weight <= 0 {
+			weight = 1
+		}
+		totalWeight += weight
+	}
+
+	origins := make([]cloudflare.PoolOrigin, 0, len(targets))
+	for _, target := range targets {
+		weight := target.Weight
+		if weight <= 0 {
+			weight = 1
+		}
+		origins = append(origins, cloudflare.PoolOrigin{
+			Name:    target.Target,
+			Address: target.Target,
+			Weight:  float64(weight) / float64(totalWeight),
+			Enabled: target.Enabled,
+		})
+	}
+	return origins
+}
+
+// ensureGeoRegionPools provisions one Load Balancer pool per distinct
+// GeoRegion among targets, each containing only the targets tagged for
+// that region, and returns the RegionPools map the Geo steering policy
+// needs. Pointing every region at the single pool backing all of
+// hostname's targets (as opposed to just the ones assigned to it) would
+// let Cloudflare pick any target for any region.
+func ensureGeoRegionPools(ctx context.Context, accountID, hostname string, targets []cfgatev1alpha1.TargetEntry, lbService *cloudflare.LoadBalancerService) (map[string][]string, error) {
+	targetsByRegion := make(map[string][]cfgatev1alpha1.TargetEntry)
+	for _, target := range targets {
+		for _, region := range target.GeoRegions {
+			targetsByRegion[region] = append(targetsByRegion[region], target)
+		}
+	}
+
+	regionPools := make(map[string][]string, len(targetsByRegion))
+	for region, regionTargets := range targetsByRegion {
+		pool, err := lbService.EnsurePool(ctx, accountID, fmt.Sprintf("%s-geo-%s", hostname, region), cloudflare.LoadBalancerPool{
+			Origins: poolOriginsFor(regionTargets),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to ensure region %s load balancer pool: %w", region, err)
+		}
+		regionPools[region] = []string{pool.ID}
+	}
+	return regionPools, nil
+}
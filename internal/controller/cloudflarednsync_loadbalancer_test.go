@@ -0,0 +1,103 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	cfgatev1alpha1 "cfgate.io/cfgate/api/v1alpha1"
+	"cfgate.io/cfgate/internal/cloudflare"
+)
+
+// fakeLoadBalancerClient embeds a nil cloudflare.Client and implements only
+// the load balancer pool methods EnsurePool needs, keeping the test
+// hermetic without a generated mock for the full Client surface.
+type fakeLoadBalancerClient struct {
+	cloudflare.Client
+
+	pools   []cloudflare.LoadBalancerPool
+	created []cloudflare.LoadBalancerPool
+	nextID  int
+}
+
+func (f *fakeLoadBalancerClient) ListLoadBalancerPools(ctx context.Context, accountID string) ([]cloudflare.LoadBalancerPool, error) {
+	return f.pools, nil
+}
+
+func (f *fakeLoadBalancerClient) CreateLoadBalancerPool(ctx context.Context, accountID string, pool cloudflare.LoadBalancerPool) (*cloudflare.LoadBalancerPool, error) {
+	f.nextID++
+	pool.ID = fmt.Sprintf("pool-%d", f.nextID)
+	f.created = append(f.created, pool)
+	f.pools = append(f.pools, pool)
+	return &pool, nil
+}
+
+func (f *fakeLoadBalancerClient) UpdateLoadBalancerPool(ctx context.Context, accountID, poolID string, pool cloudflare.LoadBalancerPool) (*cloudflare.LoadBalancerPool, error) {
+	pool.ID = poolID
+	for i, existing := range f.pools {
+		if existing.ID == poolID {
+			f.pools[i] = pool
+		}
+	}
+	return &pool, nil
+}
+
+func TestEnsureGeoRegionPools_OnePoolPerRegion(t *testing.T) {
+	client := &fakeLoadBalancerClient{}
+	lbService := cloudflare.NewLoadBalancerService(client)
+
+	targets := []cfgatev1alpha1.TargetEntry{
+		{Target: "wnam.internal", GeoRegions: []string{"WNAM"}},
+		{Target: "eeu.internal", GeoRegions: []string{"EEU"}},
+		{Target: "both.internal", GeoRegions: []string{"WNAM", "EEU"}},
+	}
+
+	regionPools, err := ensureGeoRegionPools(context.Background(), "account-1", "app.example.com", targets, lbService)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(regionPools) != 2 {
+		t.Fatalf("expected 2 region pools, got %d: %v", len(regionPools), regionPools)
+	}
+	for _, region := range []string{"WNAM", "EEU"} {
+		ids, ok := regionPools[region]
+		if !ok || len(ids) != 1 {
+			t.Fatalf("expected exactly one pool ID for region %s, got %v", region, ids)
+		}
+	}
+	if regionPools["WNAM"][0] == regionPools["EEU"][0] {
+		t.Fatalf("expected WNAM and EEU to get distinct pools, both got %s", regionPools["WNAM"][0])
+	}
+
+	// WNAM's pool must only contain the targets assigned to it, not every
+	// target for the hostname - pointing every region at the same pool
+	// would let Cloudflare pick any target for any region.
+	var wnamPool *cloudflare.LoadBalancerPool
+	for i := range client.pools {
+		if client.pools[i].ID == regionPools["WNAM"][0] {
+			wnamPool = &client.pools[i]
+		}
+	}
+	if wnamPool == nil {
+		t.Fatal("could not find the created WNAM pool")
+	}
+	if len(wnamPool.Origins) != 2 {
+		t.Fatalf("expected WNAM pool to contain exactly the 2 targets tagged WNAM, got %d origins", len(wnamPool.Origins))
+	}
+}
+
+func TestEnsureGeoRegionPools_NoRegionsYieldsNoPools(t *testing.T) {
+	client := &fakeLoadBalancerClient{}
+	lbService := cloudflare.NewLoadBalancerService(client)
+
+	targets := []cfgatev1alpha1.TargetEntry{{Target: "origin.internal"}}
+
+	regionPools, err := ensureGeoRegionPools(context.Background(), "account-1", "app.example.com", targets, lbService)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(regionPools) != 0 {
+		t.Fatalf("expected no region pools for a target with no GeoRegions, got %v", regionPools)
+	}
+}
@@ -0,0 +1,325 @@
+// Package controller contains the reconciliation logic for cfgate CRDs.
+package controller
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	cfgatev1alpha1 "cfgate.io/cfgate/api/v1alpha1"
+	"cfgate.io/cfgate/internal/cloudflare"
+)
+
+// ipRouteFinalizer is the finalizer for CloudflareIPRoute resources.
+const ipRouteFinalizer = "cfgate.io/iproute-cleanup"
+
+// CloudflareIPRouteReconciler reconciles a CloudflareIPRoute object.
+//
+// Tunnel-side deletion coordination: this repository's CloudflareTunnel
+// controller isn't present in this tree, so it has no delete path this
+// reconciler could block. In the full system this CR's finalizer should be
+// paired with the tunnel controller refusing deletion while dependent
+// CloudflareIPRoute CRs still reference it; only this side of that
+// coordination can be implemented here.
+type CloudflareIPRouteReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder events.EventRecorder
+
+	// CFClient is the Cloudflare API client. Injected for testing.
+	CFClient cloudflare.Client
+
+	// CredentialCache caches validated Cloudflare clients to avoid repeated validations.
+	CredentialCache *cloudflare.CredentialCache
+}
+
+// +kubebuilder:rbac:groups=cfgate.io,resources=cloudflareiproutes,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=cfgate.io,resources=cloudflareiproutes/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=cfgate.io,resources=cloudflareiproutes/finalizers,verbs=update
+
+// Reconcile resolves route's CloudflareTunnel and optional
+// CloudflareVirtualNetwork, then creates or adopts the matching Teamnet
+// route, identified by the (network, vnet) pair.
+func (r *CloudflareIPRouteReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+	log.Info("reconciling CloudflareIPRoute", "name", req.Name, "namespace", req.Namespace)
+
+	var route cfgatev1alpha1.CloudflareIPRoute
+	if err := r.Get(ctx, req.NamespacedName, &route); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("CloudflareIPRoute not found, ignoring")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("failed to get CloudflareIPRoute: %w", err)
+	}
+
+	if !route.DeletionTimestamp.IsZero() {
+		return r.reconcileIPRouteDelete(ctx, &route)
+	}
+
+	if !controllerutil.ContainsFinalizer(&route, ipRouteFinalizer) {
+		patch := client.MergeFrom(route.DeepCopy())
+		controllerutil.AddFinalizer(&route, ipRouteFinalizer)
+		if err := r.Patch(ctx, &route, patch); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to add finalizer: %w", err)
+		}
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	tunnel, err := r.resolveIPRouteTunnel(ctx, &route)
+	if err != nil {
+		log.Error(err, "failed to resolve tunnel")
+		r.setCondition(&route, ConditionTypeReady, metav1.ConditionFalse, "TunnelNotFound", err.Error())
+		if err := r.updateIPRouteStatus(ctx, &route); err != nil {
+			log.Error(err, "failed to update status")
+		}
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
+	if tunnel.Status.TunnelID == "" {
+		log.Info("tunnel not ready yet", "tunnel", tunnel.Name)
+		r.setCondition(&route, ConditionTypeReady, metav1.ConditionFalse, "TunnelNotReady", "referenced tunnel is not ready")
+		if err := r.updateIPRouteStatus(ctx, &route); err != nil {
+			log.Error(err, "failed to update status")
+		}
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
+	vnetID, err := r.resolveIPRouteVnet(ctx, &route)
+	if err != nil {
+		log.Error(err, "failed to resolve virtual network")
+		r.setCondition(&route, ConditionTypeReady, metav1.ConditionFalse, "VirtualNetworkNotFound", err.Error())
+		if err := r.updateIPRouteStatus(ctx, &route); err != nil {
+			log.Error(err, "failed to update status")
+		}
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
+	cfClient, err := r.getTunnelCloudflareClient(ctx, tunnel)
+	if err != nil {
+		log.Error(err, "failed to create Cloudflare client")
+		r.setCondition(&route, ConditionTypeReady, metav1.ConditionFalse, "CredentialsInvalid", err.Error())
+		if err := r.updateIPRouteStatus(ctx, &route); err != nil {
+			log.Error(err, "failed to update status")
+		}
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
+	routeService := cloudflare.NewIPRouteService(cfClient)
+	resolved, err := routeService.EnsureRoute(ctx, tunnel.Spec.Cloudflare.AccountID, cloudflare.IPRoute{
+		Network:  route.Spec.Network,
+		TunnelID: tunnel.Status.TunnelID,
+		VnetID:   vnetID,
+		Comment:  route.Spec.Comment,
+	})
+	if err != nil {
+		log.Error(err, "failed to ensure IP route")
+		r.setCondition(&route, ConditionTypeReady, metav1.ConditionFalse, "SyncFailed", err.Error())
+		if err := r.updateIPRouteStatus(ctx, &route); err != nil {
+			log.Error(err, "failed to update status")
+		}
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
+	route.Status.RouteID = resolved.ID
+	route.Status.TunnelID = tunnel.Status.TunnelID
+	route.Status.VnetID = vnetID
+	route.Status.ObservedGeneration = route.Generation
+	r.setCondition(&route, ConditionTypeReady, metav1.ConditionTrue, "Ready", "IP route is synced and ready")
+
+	if err := r.updateIPRouteStatus(ctx, &route); err != nil {
+		log.Error(err, "failed to update status")
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
+	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *CloudflareIPRouteReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&cfgatev1alpha1.CloudflareIPRoute{},
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Complete(r)
+}
+
+// resolveIPRouteTunnel resolves the referenced CloudflareTunnel.
+func (r *CloudflareIPRouteReconciler) resolveIPRouteTunnel(ctx context.Context, route *cfgatev1alpha1.CloudflareIPRoute) (*cfgatev1alpha1.CloudflareTunnel, error) {
+	namespace := route.Spec.TunnelRef.Namespace
+	if namespace == "" {
+		namespace = route.Namespace
+	}
+
+	var tunnel cfgatev1alpha1.CloudflareTunnel
+	if err := r.Get(ctx, types.NamespacedName{
+		Name:      route.Spec.TunnelRef.Name,
+		Namespace: namespace,
+	}, &tunnel); err != nil {
+		return nil, fmt.Errorf("failed to get tunnel %s/%s: %w", namespace, route.Spec.TunnelRef.Name, err)
+	}
+
+	return &tunnel, nil
+}
+
+// resolveIPRouteVnet resolves route's optional VirtualNetworkRef to a
+// Cloudflare virtual network ID, returning "" if unset.
+func (r *CloudflareIPRouteReconciler) resolveIPRouteVnet(ctx context.Context, route *cfgatev1alpha1.CloudflareIPRoute) (string, error) {
+	if route.Spec.VirtualNetworkRef == nil {
+		return "", nil
+	}
+
+	var vnet cfgatev1alpha1.CloudflareVirtualNetwork
+	if err := r.Get(ctx, types.NamespacedName{Name: route.Spec.VirtualNetworkRef.Name, Namespace: route.Namespace}, &vnet); err != nil {
+		return "", fmt.Errorf("failed to get CloudflareVirtualNetwork %s: %w", route.Spec.VirtualNetworkRef.Name, err)
+	}
+	if vnet.Status.VnetID == "" {
+		return "", fmt.Errorf("CloudflareVirtualNetwork %s has not resolved a vnet ID yet", route.Spec.VirtualNetworkRef.Name)
+	}
+
+	return vnet.Status.VnetID, nil
+}
+
+// reconcileIPRouteDelete handles deletion of CloudflareIPRoute, deleting the
+// Cloudflare-side route before releasing the finalizer. Honors the
+// "cfgate.io/deletion-policy: orphan" annotation, same as CloudflareTunnel,
+// to leave the route in place instead of deleting it from Cloudflare.
+func (r *CloudflareIPRouteReconciler) reconcileIPRouteDelete(ctx context.Context, route *cfgatev1alpha1.CloudflareIPRoute) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+	log.Info("handling CloudflareIPRoute deletion", "name", route.Name)
+
+	if !controllerutil.ContainsFinalizer(route, ipRouteFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	if route.Status.RouteID != "" && route.Annotations["cfgate.io/deletion-policy"] != "orphan" {
+		tunnel, err := r.resolveIPRouteTunnel(ctx, route)
+		if err != nil {
+			log.Error(err, "failed to resolve tunnel for cleanup, IP route may be orphaned")
+		} else if cfClient, err := r.getTunnelCloudflareClient(ctx, tunnel); err != nil {
+			log.Error(err, "failed to create Cloudflare client for cleanup, IP route may be orphaned")
+		} else {
+			routeService := cloudflare.NewIPRouteService(cfClient)
+			if err := routeService.DeleteRoute(ctx, tunnel.Spec.Cloudflare.AccountID, route.Status.RouteID); err != nil {
+				log.Error(err, "failed to delete IP route, it may be orphaned")
+				r.Recorder.Eventf(route, nil, corev1.EventTypeWarning, "IPRouteDeleteFailed", "Cleanup",
+					"failed to delete IP route, it may be orphaned: %v", err)
+				// Continue with finalizer removal - don't block deletion
+			}
+		}
+	}
+
+	patch := client.MergeFrom(route.DeepCopy())
+	controllerutil.RemoveFinalizer(route, ipRouteFinalizer)
+	if err := r.Patch(ctx, route, patch); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to remove finalizer: %w", err)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// updateIPRouteStatus updates the CloudflareIPRoute status only if it has changed.
+func (r *CloudflareIPRouteReconciler) updateIPRouteStatus(ctx context.Context, route *cfgatev1alpha1.CloudflareIPRoute) error {
+	var current cfgatev1alpha1.CloudflareIPRoute
+	if err := r.Get(ctx, types.NamespacedName{Name: route.Name, Namespace: route.Namespace}, &current); err != nil {
+		return fmt.Errorf("failed to re-fetch CloudflareIPRoute: %w", err)
+	}
+
+	if ipRouteStatusEqual(&current.Status, &route.Status) {
+		return nil
+	}
+
+	current.Status = route.Status
+
+	if err := r.Status().Update(ctx, &current); err != nil {
+		return fmt.Errorf("failed to update status: %w", err)
+	}
+
+	return nil
+}
+
+// ipRouteStatusEqual compares two CloudflareIPRoute statuses for equality.
+// The final reflect.DeepEqual compares every field, condition
+// LastTransitionTime included.
+func ipRouteStatusEqual(a, b *cfgatev1alpha1.CloudflareIPRouteStatus) bool {
+	if a.RouteID != b.RouteID || a.TunnelID != b.TunnelID || a.VnetID != b.VnetID || a.ObservedGeneration != b.ObservedGeneration {
+		return false
+	}
+	if len(a.Conditions) != len(b.Conditions) {
+		return false
+	}
+	for i := range a.Conditions {
+		if a.Conditions[i].Type != b.Conditions[i].Type ||
+			a.Conditions[i].Status != b.Conditions[i].Status ||
+			a.Conditions[i].Reason != b.Conditions[i].Reason ||
+			a.Conditions[i].Message != b.Conditions[i].Message {
+			return false
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// getTunnelCloudflareClient creates or returns the Cloudflare client for
+// tunnel's Spec.Cloudflare.SecretRef. Uses the credential cache to avoid
+// repeated API validations.
+func (r *CloudflareIPRouteReconciler) getTunnelCloudflareClient(ctx context.Context, tunnel *cfgatev1alpha1.CloudflareTunnel) (cloudflare.Client, error) {
+	if r.CFClient != nil {
+		return r.CFClient, nil
+	}
+
+	secretNamespace := tunnel.Spec.Cloudflare.SecretRef.Namespace
+	if secretNamespace == "" {
+		secretNamespace = tunnel.Namespace
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{
+		Name:      tunnel.Spec.Cloudflare.SecretRef.Name,
+		Namespace: secretNamespace,
+	}, secret); err != nil {
+		return nil, fmt.Errorf("failed to get credentials secret: %w", err)
+	}
+
+	createFn := func() (cloudflare.Client, error) {
+		token, ok := secret.Data["CLOUDFLARE_API_TOKEN"]
+		if !ok {
+			return nil, fmt.Errorf("CLOUDFLARE_API_TOKEN not found in secret")
+		}
+		return cloudflare.NewClient(string(token))
+	}
+
+	if r.CredentialCache != nil {
+		return r.CredentialCache.GetOrCreate(ctx, secret, createFn)
+	}
+
+	return createFn()
+}
+
+// setCondition sets a status condition on route.
+func (r *CloudflareIPRouteReconciler) setCondition(route *cfgatev1alpha1.CloudflareIPRoute, conditionType string, status metav1.ConditionStatus, reason, message string) {
+	condition := metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: route.Generation,
+	}
+
+	meta.SetStatusCondition(&route.Status.Conditions, condition)
+}
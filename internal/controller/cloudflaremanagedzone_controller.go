@@ -0,0 +1,319 @@
+// Package controller contains the reconciliation logic for cfgate CRDs.
+package controller
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	cfgatev1alpha1 "cfgate.io/cfgate/api/v1alpha1"
+	"cfgate.io/cfgate/internal/cloudflare"
+)
+
+// managedZoneFinalizer is the finalizer for CloudflareManagedZone resources.
+// It exists so a deleted zone's delegation NS record is cleaned up from its
+// ParentZoneRef's zone instead of left dangling.
+const managedZoneFinalizer = "cfgate.io/managedzone-cleanup"
+
+// CloudflareManagedZoneReconciler reconciles a CloudflareManagedZone object.
+// It owns the Cloudflare zone lookup (resolving Spec.ZoneName/Spec.ID to
+// Status.ZoneID and Status.NameServers) so CloudflareDNSSync can reference a
+// zone by name without repeating that lookup, and keeps a delegated
+// subzone's NS record in sync in its ParentZoneRef's zone.
+type CloudflareManagedZoneReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder events.EventRecorder
+
+	// CFClient is the Cloudflare API client. Injected for testing.
+	CFClient cloudflare.Client
+
+	// CredentialCache caches validated Cloudflare clients to avoid repeated validations.
+	CredentialCache *cloudflare.CredentialCache
+}
+
+// +kubebuilder:rbac:groups=cfgate.io,resources=cloudflaremanagedzones,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=cfgate.io,resources=cloudflaremanagedzones/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=cfgate.io,resources=cloudflaremanagedzones/finalizers,verbs=update
+
+// Reconcile resolves zone's Cloudflare zone ID and nameservers, and, when
+// ParentZoneRef is set, keeps the delegation NS record in the parent zone
+// in sync with them.
+func (r *CloudflareManagedZoneReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+	log.Info("reconciling CloudflareManagedZone", "name", req.Name, "namespace", req.Namespace)
+
+	var zone cfgatev1alpha1.CloudflareManagedZone
+	if err := r.Get(ctx, req.NamespacedName, &zone); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("CloudflareManagedZone not found, ignoring")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("failed to get CloudflareManagedZone: %w", err)
+	}
+
+	if !zone.DeletionTimestamp.IsZero() {
+		return r.reconcileManagedZoneDelete(ctx, &zone)
+	}
+
+	if !controllerutil.ContainsFinalizer(&zone, managedZoneFinalizer) {
+		patch := client.MergeFrom(zone.DeepCopy())
+		controllerutil.AddFinalizer(&zone, managedZoneFinalizer)
+		if err := r.Patch(ctx, &zone, patch); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to add finalizer: %w", err)
+		}
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	if err := r.resolveZone(ctx, &zone); err != nil {
+		log.Error(err, "failed to resolve zone")
+		r.setCondition(&zone, ConditionTypeReady, metav1.ConditionFalse, "ZoneResolutionFailed", err.Error())
+		if err := r.updateManagedZoneStatus(ctx, &zone); err != nil {
+			log.Error(err, "failed to update status")
+		}
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
+	if zone.Spec.ParentZoneRef != nil {
+		if err := r.reconcileDelegation(ctx, &zone); err != nil {
+			log.Error(err, "failed to sync delegation NS record")
+			r.Recorder.Eventf(&zone, nil, corev1.EventTypeWarning, "DelegationSyncFailed", "Sync", "failed to sync delegation NS record in parent zone: %v", err)
+			r.setCondition(&zone, ConditionTypeReady, metav1.ConditionFalse, "DelegationSyncFailed", err.Error())
+			if err := r.updateManagedZoneStatus(ctx, &zone); err != nil {
+				log.Error(err, "failed to update status")
+			}
+			return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+		}
+	}
+
+	zone.Status.ObservedGeneration = zone.Generation
+	r.setCondition(&zone, ConditionTypeReady, metav1.ConditionTrue, "Ready", "zone is resolved and ready")
+
+	if err := r.updateManagedZoneStatus(ctx, &zone); err != nil {
+		log.Error(err, "failed to update status")
+	}
+
+	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *CloudflareManagedZoneReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&cfgatev1alpha1.CloudflareManagedZone{},
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Complete(r)
+}
+
+// resolveZone populates zone.Status.ZoneID and Status.NameServers. When
+// Spec.ID is set and no CredentialsRef is configured to look anything else
+// up, Status.ZoneID is simply the explicit ID and NameServers is left
+// unresolved. Otherwise NameServers is resolved from Spec.ZoneName via the
+// Cloudflare API, and so is ZoneID unless Spec.ID is set.
+func (r *CloudflareManagedZoneReconciler) resolveZone(ctx context.Context, zone *cfgatev1alpha1.CloudflareManagedZone) error {
+	if zone.Spec.ID != "" && zone.Spec.CredentialsRef == nil {
+		zone.Status.ZoneID = zone.Spec.ID
+		return nil
+	}
+
+	cfClient, err := r.getZoneCloudflareClient(ctx, zone)
+	if err != nil {
+		return fmt.Errorf("failed to create Cloudflare client: %w", err)
+	}
+
+	dnsService := cloudflare.NewDNSService(cfClient)
+	resolved, err := dnsService.ResolveZone(ctx, zone.Spec.ZoneName)
+	if err != nil {
+		return fmt.Errorf("failed to resolve zone %s: %w", zone.Spec.ZoneName, err)
+	}
+	if resolved == nil {
+		return fmt.Errorf("zone %s not found", zone.Spec.ZoneName)
+	}
+
+	zoneID := zone.Spec.ID
+	if zoneID == "" {
+		zoneID = resolved.ID
+	}
+
+	zone.Status.ZoneID = zoneID
+	zone.Status.NameServers = resolved.NameServers
+
+	return nil
+}
+
+// reconcileDelegation keeps an NS record in zone.Spec.ParentZoneRef's zone
+// pointing at zone.Status.NameServers, creating or updating it as the
+// nameservers change.
+func (r *CloudflareManagedZoneReconciler) reconcileDelegation(ctx context.Context, zone *cfgatev1alpha1.CloudflareManagedZone) error {
+	var parent cfgatev1alpha1.CloudflareManagedZone
+	if err := r.Get(ctx, types.NamespacedName{Name: zone.Spec.ParentZoneRef.Name, Namespace: zone.Namespace}, &parent); err != nil {
+		return fmt.Errorf("failed to get parent CloudflareManagedZone %s: %w", zone.Spec.ParentZoneRef.Name, err)
+	}
+	if parent.Status.ZoneID == "" {
+		return fmt.Errorf("parent CloudflareManagedZone %s has not resolved a zone ID yet", zone.Spec.ParentZoneRef.Name)
+	}
+
+	parentClient, err := r.getZoneCloudflareClient(ctx, &parent)
+	if err != nil {
+		return fmt.Errorf("failed to create Cloudflare client for parent zone: %w", err)
+	}
+
+	dnsService := cloudflare.NewDNSService(parentClient)
+	changed, err := dnsService.SyncDelegationRecords(ctx, parent.Status.ZoneID, zone.Spec.ZoneName, zone.Status.NameServers)
+	if err != nil {
+		return err
+	}
+	if changed {
+		r.Recorder.Eventf(zone, nil, corev1.EventTypeNormal, "DelegationSynced", "Sync", "synced delegation NS record for %s in zone %s", zone.Spec.ZoneName, parent.Spec.ZoneName)
+	}
+
+	zone.Status.DelegationSynced = true
+	return nil
+}
+
+// reconcileManagedZoneDelete handles deletion of CloudflareManagedZone,
+// removing its delegation NS record from ParentZoneRef's zone if one was
+// ever synced.
+func (r *CloudflareManagedZoneReconciler) reconcileManagedZoneDelete(ctx context.Context, zone *cfgatev1alpha1.CloudflareManagedZone) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+	log.Info("handling CloudflareManagedZone deletion", "name", zone.Name)
+
+	if !controllerutil.ContainsFinalizer(zone, managedZoneFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	if zone.Spec.ParentZoneRef != nil && zone.Status.DelegationSynced {
+		var parent cfgatev1alpha1.CloudflareManagedZone
+		if err := r.Get(ctx, types.NamespacedName{Name: zone.Spec.ParentZoneRef.Name, Namespace: zone.Namespace}, &parent); err != nil {
+			log.Error(err, "failed to get parent CloudflareManagedZone for cleanup, delegation NS record may be orphaned")
+		} else if parentClient, err := r.getZoneCloudflareClient(ctx, &parent); err != nil {
+			log.Error(err, "failed to create Cloudflare client for parent zone cleanup, delegation NS record may be orphaned")
+		} else {
+			dnsService := cloudflare.NewDNSService(parentClient)
+			if _, err := dnsService.SyncDelegationRecords(ctx, parent.Status.ZoneID, zone.Spec.ZoneName, nil); err != nil {
+				log.Error(err, "failed to remove delegation NS record, it may be orphaned")
+				r.Recorder.Eventf(zone, nil, corev1.EventTypeWarning, "DelegationCleanupFailed", "Cleanup",
+					"failed to remove delegation NS record, it may be orphaned: %v", err)
+				// Continue with finalizer removal - don't block deletion
+			}
+		}
+	}
+
+	patch := client.MergeFrom(zone.DeepCopy())
+	controllerutil.RemoveFinalizer(zone, managedZoneFinalizer)
+	if err := r.Patch(ctx, zone, patch); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to remove finalizer: %w", err)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// updateManagedZoneStatus updates the CloudflareManagedZone status only if it has changed.
+func (r *CloudflareManagedZoneReconciler) updateManagedZoneStatus(ctx context.Context, zone *cfgatev1alpha1.CloudflareManagedZone) error {
+	var current cfgatev1alpha1.CloudflareManagedZone
+	if err := r.Get(ctx, types.NamespacedName{Name: zone.Name, Namespace: zone.Namespace}, &current); err != nil {
+		return fmt.Errorf("failed to re-fetch CloudflareManagedZone: %w", err)
+	}
+
+	if managedZoneStatusEqual(&current.Status, &zone.Status) {
+		return nil
+	}
+
+	current.Status = zone.Status
+
+	if err := r.Status().Update(ctx, &current); err != nil {
+		return fmt.Errorf("failed to update status: %w", err)
+	}
+
+	return nil
+}
+
+// managedZoneStatusEqual compares two CloudflareManagedZone statuses for
+// equality. The final reflect.DeepEqual compares every field, NameServers
+// and condition LastTransitionTime included.
+func managedZoneStatusEqual(a, b *cfgatev1alpha1.CloudflareManagedZoneStatus) bool {
+	if a.ZoneID != b.ZoneID || a.DelegationSynced != b.DelegationSynced || a.ObservedGeneration != b.ObservedGeneration {
+		return false
+	}
+	if len(a.Conditions) != len(b.Conditions) {
+		return false
+	}
+	for i := range a.Conditions {
+		if a.Conditions[i].Type != b.Conditions[i].Type ||
+			a.Conditions[i].Status != b.Conditions[i].Status ||
+			a.Conditions[i].Reason != b.Conditions[i].Reason ||
+			a.Conditions[i].Message != b.Conditions[i].Message {
+			return false
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// getZoneCloudflareClient creates or returns the Cloudflare client for
+// zone's own CredentialsRef. Uses the credential cache to avoid repeated
+// API validations.
+func (r *CloudflareManagedZoneReconciler) getZoneCloudflareClient(ctx context.Context, zone *cfgatev1alpha1.CloudflareManagedZone) (cloudflare.Client, error) {
+	if r.CFClient != nil {
+		return r.CFClient, nil
+	}
+
+	if zone.Spec.CredentialsRef == nil {
+		return nil, fmt.Errorf("zone %s has no CredentialsRef and no Cloudflare client is configured", zone.Name)
+	}
+
+	secretNamespace := zone.Spec.CredentialsRef.Namespace
+	if secretNamespace == "" {
+		secretNamespace = zone.Namespace
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{
+		Name:      zone.Spec.CredentialsRef.Name,
+		Namespace: secretNamespace,
+	}, secret); err != nil {
+		return nil, fmt.Errorf("failed to get credentials secret: %w", err)
+	}
+
+	createFn := func() (cloudflare.Client, error) {
+		token, ok := secret.Data["CLOUDFLARE_API_TOKEN"]
+		if !ok {
+			return nil, fmt.Errorf("CLOUDFLARE_API_TOKEN not found in secret")
+		}
+		return cloudflare.NewClient(string(token))
+	}
+
+	if r.CredentialCache != nil {
+		return r.CredentialCache.GetOrCreate(ctx, secret, createFn)
+	}
+
+	return createFn()
+}
+
+// setCondition sets a status condition on zone.
+func (r *CloudflareManagedZoneReconciler) setCondition(zone *cfgatev1alpha1.CloudflareManagedZone, conditionType string, status metav1.ConditionStatus, reason, message string) {
+	condition := metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: zone.Generation,
+	}
+
+	meta.SetStatusCondition(&zone.Status.Conditions, condition)
+}
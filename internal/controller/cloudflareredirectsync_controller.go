@@ -0,0 +1,325 @@
+// Package controller contains the reconciliation logic for cfgate CRDs.
+package controller
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	cfgatev1alpha1 "cfgate.io/cfgate/api/v1alpha1"
+	"cfgate.io/cfgate/internal/cloudflare"
+)
+
+const (
+	// redirectSyncFinalizer is the finalizer for CloudflareRedirectSync resources.
+	redirectSyncFinalizer = "cfgate.io/redirect-cleanup"
+
+	// ConditionTypeRedirectsSynced indicates the zone's redirect rules are synced.
+	ConditionTypeRedirectsSynced = "RedirectsSynced"
+)
+
+// CloudflareRedirectSyncReconciler reconciles a CloudflareRedirectSync object.
+// It manages a zone's Single Redirects (the dynamic-redirect phase ruleset),
+// sharing the credential and client plumbing CloudflareDNSSyncReconciler uses.
+type CloudflareRedirectSyncReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder events.EventRecorder
+
+	// CFClient is the Cloudflare API client. Injected for testing.
+	CFClient cloudflare.Client
+
+	// CredentialCache caches validated Cloudflare clients to avoid repeated validations.
+	CredentialCache *cloudflare.CredentialCache
+}
+
+// +kubebuilder:rbac:groups=cfgate.io,resources=cloudflareredirectsyncs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=cfgate.io,resources=cloudflareredirectsyncs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=cfgate.io,resources=cloudflareredirectsyncs/finalizers,verbs=update
+
+// Reconcile handles the reconciliation loop for CloudflareRedirectSync resources.
+// It resolves the target zone, builds the desired redirect rules, and syncs
+// them into the zone's dynamic-redirect ruleset.
+func (r *CloudflareRedirectSyncReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+	log.Info("reconciling CloudflareRedirectSync", "name", req.Name, "namespace", req.Namespace)
+
+	var redirectSync cfgatev1alpha1.CloudflareRedirectSync
+	if err := r.Get(ctx, req.NamespacedName, &redirectSync); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("CloudflareRedirectSync not found, ignoring")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("failed to get CloudflareRedirectSync: %w", err)
+	}
+
+	if !redirectSync.DeletionTimestamp.IsZero() {
+		return r.reconcileRedirectSyncDelete(ctx, &redirectSync)
+	}
+
+	if !controllerutil.ContainsFinalizer(&redirectSync, redirectSyncFinalizer) {
+		patch := client.MergeFrom(redirectSync.DeepCopy())
+		controllerutil.AddFinalizer(&redirectSync, redirectSyncFinalizer)
+		if err := r.Patch(ctx, &redirectSync, patch); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to add finalizer: %w", err)
+		}
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	cfClient, err := r.getRedirectCloudflareClient(ctx, &redirectSync)
+	if err != nil {
+		log.Error(err, "failed to create Cloudflare client")
+		r.setRedirectCondition(&redirectSync, ConditionTypeReady, metav1.ConditionFalse, "CredentialsUnavailable", err.Error())
+		if err := r.updateRedirectStatus(ctx, &redirectSync); err != nil {
+			log.Error(err, "failed to update status")
+		}
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
+	dnsService := cloudflare.NewDNSService(cfClient)
+	zoneID, err := r.resolveRedirectZone(ctx, dnsService, &redirectSync)
+	if err != nil {
+		log.Error(err, "failed to resolve zone")
+		r.setRedirectCondition(&redirectSync, ConditionTypeReady, metav1.ConditionFalse, "ZoneResolutionFailed", err.Error())
+		if err := r.updateRedirectStatus(ctx, &redirectSync); err != nil {
+			log.Error(err, "failed to update status")
+		}
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
+	ownershipPrefix := redirectSync.Spec.OwnershipPrefix
+	if ownershipPrefix == "" {
+		ownershipPrefix = defaultOwnershipPrefix
+	}
+
+	desired := make([]cloudflare.RedirectRule, 0, len(redirectSync.Spec.Redirects))
+	for _, rule := range redirectSync.Spec.Redirects {
+		desired = append(desired, buildRedirectRule(rule, ownershipPrefix))
+	}
+
+	redirectService := cloudflare.NewRedirectService(cfClient)
+	rulesetID, synced, failed, err := redirectService.SyncRules(ctx, zoneID, ownershipPrefix, desired)
+	if err != nil {
+		log.Error(err, "failed to sync redirect rules")
+		r.Recorder.Eventf(&redirectSync, nil, corev1.EventTypeWarning, "RedirectSyncFailed", "Sync", "redirect rule sync failed: %v", err)
+		r.setRedirectCondition(&redirectSync, ConditionTypeRedirectsSynced, metav1.ConditionFalse, "RedirectSyncFailed", err.Error())
+	} else {
+		r.Recorder.Eventf(&redirectSync, nil, corev1.EventTypeNormal, "RedirectsSynced", "Sync", "synced %d redirect rules to zone %s", synced, zoneID)
+		r.setRedirectCondition(&redirectSync, ConditionTypeRedirectsSynced, metav1.ConditionTrue, "Synced", fmt.Sprintf("%d redirect rules synced", synced))
+	}
+
+	redirectSync.Status.RulesetID = rulesetID
+	redirectSync.Status.SyncedRedirects = int32(synced)
+	redirectSync.Status.FailedRedirects = int32(failed)
+	redirectSync.Status.ObservedGeneration = redirectSync.Generation
+	r.setRedirectCondition(&redirectSync, ConditionTypeReady, metav1.ConditionTrue, "Ready", "redirect sync is operational")
+
+	if err := r.updateRedirectStatus(ctx, &redirectSync); err != nil {
+		log.Error(err, "failed to update status")
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *CloudflareRedirectSyncReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&cfgatev1alpha1.CloudflareRedirectSync{},
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Complete(r)
+}
+
+// buildRedirectRule translates a RedirectRuleSpec into the Cloudflare-level
+// RedirectRule SyncRules operates on, defaulting StatusCode and building
+// Expression from Matcher.Hostname/Path when Matcher.Expression isn't set.
+func buildRedirectRule(rule cfgatev1alpha1.RedirectRuleSpec, ownershipPrefix string) cloudflare.RedirectRule {
+	expression := rule.Matcher.Expression
+	if expression == "" {
+		expression = cloudflare.BuildHostPathExpression(rule.Matcher.Hostname, rule.Matcher.Path)
+	}
+
+	statusCode := rule.StatusCode
+	if statusCode == 0 {
+		statusCode = 301
+	}
+
+	return cloudflare.RedirectRule{
+		Description:         cloudflare.RedirectRuleDescription(rule.Name, ownershipPrefix),
+		Expression:          expression,
+		TargetURL:           rule.TargetURL,
+		StatusCode:          int(statusCode),
+		PreserveQueryString: rule.PreserveQueryString,
+		PreservePath:        rule.PreservePath,
+	}
+}
+
+// resolveRedirectZone resolves redirectSync's ZoneRef to a zone ID, looking
+// it up by name when ID isn't set explicitly.
+func (r *CloudflareRedirectSyncReconciler) resolveRedirectZone(ctx context.Context, dnsService *cloudflare.DNSService, redirectSync *cfgatev1alpha1.CloudflareRedirectSync) (string, error) {
+	if redirectSync.Spec.ZoneRef.ID != "" {
+		return redirectSync.Spec.ZoneRef.ID, nil
+	}
+
+	zone, err := dnsService.ResolveZone(ctx, redirectSync.Spec.ZoneRef.Name)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve zone %s: %w", redirectSync.Spec.ZoneRef.Name, err)
+	}
+	if zone == nil {
+		return "", fmt.Errorf("zone %s not found", redirectSync.Spec.ZoneRef.Name)
+	}
+
+	return zone.ID, nil
+}
+
+// reconcileRedirectSyncDelete handles deletion of CloudflareRedirectSync.
+func (r *CloudflareRedirectSyncReconciler) reconcileRedirectSyncDelete(ctx context.Context, redirectSync *cfgatev1alpha1.CloudflareRedirectSync) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+	log.Info("handling RedirectSync deletion", "name", redirectSync.Name)
+
+	if !controllerutil.ContainsFinalizer(redirectSync, redirectSyncFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	if redirectSync.Spec.CleanupPolicy.DeleteOnResourceRemoval && redirectSync.Status.RulesetID != "" {
+		cfClient, err := r.getRedirectCloudflareClient(ctx, redirectSync)
+		if err != nil {
+			log.Error(err, "failed to create Cloudflare client for cleanup, redirect rules may be orphaned")
+		} else {
+			dnsService := cloudflare.NewDNSService(cfClient)
+			zoneID, err := r.resolveRedirectZone(ctx, dnsService, redirectSync)
+			if err != nil {
+				log.Error(err, "failed to resolve zone for cleanup, redirect rules may be orphaned")
+			} else {
+				ownershipPrefix := redirectSync.Spec.OwnershipPrefix
+				if ownershipPrefix == "" {
+					ownershipPrefix = defaultOwnershipPrefix
+				}
+				redirectService := cloudflare.NewRedirectService(cfClient)
+				if _, _, failed, err := redirectService.SyncRules(ctx, zoneID, ownershipPrefix, nil); err != nil || failed > 0 {
+					log.Error(err, "failed to clean up redirect rules, rules may be orphaned", "failed", failed)
+					r.Recorder.Eventf(redirectSync, nil, corev1.EventTypeWarning, "RedirectCleanupFailed", "Cleanup",
+						"redirect rule cleanup failed, rules may be orphaned: %v", err)
+					// Continue with finalizer removal - don't block deletion
+				}
+			}
+		}
+	}
+
+	patch := client.MergeFrom(redirectSync.DeepCopy())
+	controllerutil.RemoveFinalizer(redirectSync, redirectSyncFinalizer)
+	if err := r.Patch(ctx, redirectSync, patch); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to remove finalizer: %w", err)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// updateRedirectStatus updates the CloudflareRedirectSync status only if it has changed.
+func (r *CloudflareRedirectSyncReconciler) updateRedirectStatus(ctx context.Context, redirectSync *cfgatev1alpha1.CloudflareRedirectSync) error {
+	var current cfgatev1alpha1.CloudflareRedirectSync
+	if err := r.Get(ctx, types.NamespacedName{Name: redirectSync.Name, Namespace: redirectSync.Namespace}, &current); err != nil {
+		return fmt.Errorf("failed to re-fetch RedirectSync: %w", err)
+	}
+
+	if redirectSyncStatusEqual(&current.Status, &redirectSync.Status) {
+		return nil
+	}
+
+	current.Status = redirectSync.Status
+
+	if err := r.Status().Update(ctx, &current); err != nil {
+		return fmt.Errorf("failed to update status: %w", err)
+	}
+
+	return nil
+}
+
+// redirectSyncStatusEqual compares two RedirectSync statuses for equality.
+// The final reflect.DeepEqual compares every field, condition
+// LastTransitionTime included.
+func redirectSyncStatusEqual(a, b *cfgatev1alpha1.CloudflareRedirectSyncStatus) bool {
+	if a.ObservedGeneration != b.ObservedGeneration || a.RulesetID != b.RulesetID {
+		return false
+	}
+	if a.SyncedRedirects != b.SyncedRedirects || a.FailedRedirects != b.FailedRedirects {
+		return false
+	}
+	if len(a.Conditions) != len(b.Conditions) {
+		return false
+	}
+	for i := range a.Conditions {
+		if a.Conditions[i].Type != b.Conditions[i].Type ||
+			a.Conditions[i].Status != b.Conditions[i].Status ||
+			a.Conditions[i].Reason != b.Conditions[i].Reason ||
+			a.Conditions[i].Message != b.Conditions[i].Message {
+			return false
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// getRedirectCloudflareClient creates or returns the Cloudflare client for redirectSync's account.
+// Uses the credential cache to avoid repeated API validations.
+func (r *CloudflareRedirectSyncReconciler) getRedirectCloudflareClient(ctx context.Context, redirectSync *cfgatev1alpha1.CloudflareRedirectSync) (cloudflare.Client, error) {
+	if r.CFClient != nil {
+		return r.CFClient, nil
+	}
+
+	secretNamespace := redirectSync.Spec.CloudflareRef.Namespace
+	if secretNamespace == nil || *secretNamespace == "" {
+		ns := redirectSync.Namespace
+		secretNamespace = &ns
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{
+		Name:      redirectSync.Spec.CloudflareRef.Name,
+		Namespace: *secretNamespace,
+	}, secret); err != nil {
+		return nil, fmt.Errorf("failed to get credentials secret: %w", err)
+	}
+
+	createFn := func() (cloudflare.Client, error) {
+		token, ok := secret.Data["CLOUDFLARE_API_TOKEN"]
+		if !ok {
+			return nil, fmt.Errorf("API token key %q not found in secret", "CLOUDFLARE_API_TOKEN")
+		}
+		return cloudflare.NewClient(string(token))
+	}
+
+	if r.CredentialCache != nil {
+		return r.CredentialCache.GetOrCreate(ctx, secret, createFn)
+	}
+
+	return createFn()
+}
+
+// setRedirectCondition sets a status condition on redirectSync.
+func (r *CloudflareRedirectSyncReconciler) setRedirectCondition(redirectSync *cfgatev1alpha1.CloudflareRedirectSync, conditionType string, status metav1.ConditionStatus, reason, message string) {
+	condition := metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: redirectSync.Generation,
+	}
+
+	meta.SetStatusCondition(&redirectSync.Status.Conditions, condition)
+}
@@ -0,0 +1,286 @@
+// Package controller contains the reconciliation logic for cfgate CRDs.
+package controller
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	cfgatev1alpha1 "cfgate.io/cfgate/api/v1alpha1"
+	"cfgate.io/cfgate/internal/cloudflare"
+)
+
+// virtualNetworkFinalizer is the finalizer for CloudflareVirtualNetwork
+// resources, guarding Cloudflare-side cleanup on delete.
+const virtualNetworkFinalizer = "cfgate.io/virtualnetwork-cleanup"
+
+// CloudflareVirtualNetworkReconciler reconciles a CloudflareVirtualNetwork object.
+//
+// Deletion: a CloudflareIPRoute can reference a vnet by name through
+// Spec.VirtualNetworkRef, but this reconciler does not look for such
+// references. The finalizer below only guards the Cloudflare-side vnet
+// deletion itself, which goes ahead even while a CloudflareIPRoute still
+// references the vnet.
+type CloudflareVirtualNetworkReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder events.EventRecorder
+
+	// CFClient is the Cloudflare API client. Injected for testing.
+	CFClient cloudflare.Client
+
+	// CredentialCache caches validated Cloudflare clients to avoid repeated validations.
+	CredentialCache *cloudflare.CredentialCache
+}
+
+// +kubebuilder:rbac:groups=cfgate.io,resources=cloudflarevirtualnetworks,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=cfgate.io,resources=cloudflarevirtualnetworks/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=cfgate.io,resources=cloudflarevirtualnetworks/finalizers,verbs=update
+
+// Reconcile creates, adopts, or updates vnet's Cloudflare virtual network,
+// including atomically transferring the account's default flag when
+// Spec.IsDefault flips to true.
+func (r *CloudflareVirtualNetworkReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+	log.Info("reconciling CloudflareVirtualNetwork", "name", req.Name, "namespace", req.Namespace)
+
+	var vnet cfgatev1alpha1.CloudflareVirtualNetwork
+	if err := r.Get(ctx, req.NamespacedName, &vnet); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("CloudflareVirtualNetwork not found, ignoring")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("failed to get CloudflareVirtualNetwork: %w", err)
+	}
+
+	if !vnet.DeletionTimestamp.IsZero() {
+		return r.reconcileVirtualNetworkDelete(ctx, &vnet)
+	}
+
+	if !controllerutil.ContainsFinalizer(&vnet, virtualNetworkFinalizer) {
+		patch := client.MergeFrom(vnet.DeepCopy())
+		controllerutil.AddFinalizer(&vnet, virtualNetworkFinalizer)
+		if err := r.Patch(ctx, &vnet, patch); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to add finalizer: %w", err)
+		}
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	cfClient, err := r.getVnetCloudflareClient(ctx, &vnet)
+	if err != nil {
+		log.Error(err, "failed to create Cloudflare client")
+		r.setCondition(&vnet, ConditionTypeReady, metav1.ConditionFalse, "CredentialsInvalid", err.Error())
+		r.setCondition(&vnet, "CredentialsValid", metav1.ConditionFalse, "CredentialsInvalid", err.Error())
+		if err := r.updateVirtualNetworkStatus(ctx, &vnet); err != nil {
+			log.Error(err, "failed to update status")
+		}
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+	r.setCondition(&vnet, "CredentialsValid", metav1.ConditionTrue, "Valid", "Cloudflare credentials are valid")
+
+	vnetService := cloudflare.NewVirtualNetworkService(cfClient)
+
+	resolved, err := vnetService.EnsureVirtualNetwork(ctx, vnet.Spec.AccountID, cloudflare.VirtualNetwork{
+		Name:      vnet.Spec.Name,
+		Comment:   vnet.Spec.Comment,
+		IsDefault: vnet.Spec.IsDefault,
+	})
+	if err != nil {
+		log.Error(err, "failed to ensure virtual network")
+		r.setCondition(&vnet, ConditionTypeReady, metav1.ConditionFalse, "SyncFailed", err.Error())
+		if err := r.updateVirtualNetworkStatus(ctx, &vnet); err != nil {
+			log.Error(err, "failed to update status")
+		}
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
+	if resolved, err = vnetService.UpdateComment(ctx, vnet.Spec.AccountID, resolved, vnet.Spec.Comment); err != nil {
+		log.Error(err, "failed to update virtual network comment")
+		r.setCondition(&vnet, ConditionTypeReady, metav1.ConditionFalse, "SyncFailed", err.Error())
+		if err := r.updateVirtualNetworkStatus(ctx, &vnet); err != nil {
+			log.Error(err, "failed to update status")
+		}
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
+	if resolved.IsDefault != vnet.Spec.IsDefault {
+		updated, err := vnetService.SetDefault(ctx, vnet.Spec.AccountID, resolved, vnet.Spec.IsDefault)
+		if err != nil {
+			log.Error(err, "failed to update virtual network default flag")
+			r.setCondition(&vnet, ConditionTypeReady, metav1.ConditionFalse, "DefaultFlipFailed", err.Error())
+			if err := r.updateVirtualNetworkStatus(ctx, &vnet); err != nil {
+				log.Error(err, "failed to update status")
+			}
+			return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+		}
+		resolved = updated
+		r.Recorder.Eventf(&vnet, nil, corev1.EventTypeNormal, "DefaultFlagChanged", "Sync",
+			"virtual network %s default flag set to %t", vnet.Spec.Name, vnet.Spec.IsDefault)
+	}
+
+	vnet.Status.VnetID = resolved.ID
+	vnet.Status.IsDefault = resolved.IsDefault
+	vnet.Status.ObservedGeneration = vnet.Generation
+	r.setCondition(&vnet, ConditionTypeReady, metav1.ConditionTrue, "Ready", "virtual network is synced and ready")
+
+	if err := r.updateVirtualNetworkStatus(ctx, &vnet); err != nil {
+		log.Error(err, "failed to update status")
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
+	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *CloudflareVirtualNetworkReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&cfgatev1alpha1.CloudflareVirtualNetwork{},
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Complete(r)
+}
+
+// reconcileVirtualNetworkDelete handles deletion of CloudflareVirtualNetwork,
+// deleting the Cloudflare-side vnet before releasing the finalizer.
+func (r *CloudflareVirtualNetworkReconciler) reconcileVirtualNetworkDelete(ctx context.Context, vnet *cfgatev1alpha1.CloudflareVirtualNetwork) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+	log.Info("handling CloudflareVirtualNetwork deletion", "name", vnet.Name)
+
+	if !controllerutil.ContainsFinalizer(vnet, virtualNetworkFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	if vnet.Status.VnetID != "" {
+		cfClient, err := r.getVnetCloudflareClient(ctx, vnet)
+		if err != nil {
+			log.Error(err, "failed to create Cloudflare client for cleanup, virtual network may be orphaned")
+		} else {
+			vnetService := cloudflare.NewVirtualNetworkService(cfClient)
+			if err := vnetService.DeleteVirtualNetwork(ctx, vnet.Spec.AccountID, vnet.Status.VnetID); err != nil {
+				log.Error(err, "failed to delete virtual network, it may be orphaned")
+				r.Recorder.Eventf(vnet, nil, corev1.EventTypeWarning, "VirtualNetworkDeleteFailed", "Cleanup",
+					"failed to delete virtual network, it may be orphaned: %v", err)
+				// Continue with finalizer removal - don't block deletion
+			}
+		}
+	}
+
+	patch := client.MergeFrom(vnet.DeepCopy())
+	controllerutil.RemoveFinalizer(vnet, virtualNetworkFinalizer)
+	if err := r.Patch(ctx, vnet, patch); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to remove finalizer: %w", err)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// updateVirtualNetworkStatus updates the CloudflareVirtualNetwork status
+// only if it has changed.
+func (r *CloudflareVirtualNetworkReconciler) updateVirtualNetworkStatus(ctx context.Context, vnet *cfgatev1alpha1.CloudflareVirtualNetwork) error {
+	var current cfgatev1alpha1.CloudflareVirtualNetwork
+	if err := r.Get(ctx, types.NamespacedName{Name: vnet.Name, Namespace: vnet.Namespace}, &current); err != nil {
+		return fmt.Errorf("failed to re-fetch CloudflareVirtualNetwork: %w", err)
+	}
+
+	if virtualNetworkStatusEqual(&current.Status, &vnet.Status) {
+		return nil
+	}
+
+	current.Status = vnet.Status
+
+	if err := r.Status().Update(ctx, &current); err != nil {
+		return fmt.Errorf("failed to update status: %w", err)
+	}
+
+	return nil
+}
+
+// virtualNetworkStatusEqual compares two CloudflareVirtualNetwork statuses
+// for equality. The final reflect.DeepEqual compares every field, condition
+// LastTransitionTime included.
+func virtualNetworkStatusEqual(a, b *cfgatev1alpha1.CloudflareVirtualNetworkStatus) bool {
+	if a.VnetID != b.VnetID || a.IsDefault != b.IsDefault || a.ObservedGeneration != b.ObservedGeneration {
+		return false
+	}
+	if len(a.Conditions) != len(b.Conditions) {
+		return false
+	}
+	for i := range a.Conditions {
+		if a.Conditions[i].Type != b.Conditions[i].Type ||
+			a.Conditions[i].Status != b.Conditions[i].Status ||
+			a.Conditions[i].Reason != b.Conditions[i].Reason ||
+			a.Conditions[i].Message != b.Conditions[i].Message {
+			return false
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// getVnetCloudflareClient creates or returns the Cloudflare client for
+// vnet's Spec.SecretRef. Uses the credential cache to avoid repeated API
+// validations.
+func (r *CloudflareVirtualNetworkReconciler) getVnetCloudflareClient(ctx context.Context, vnet *cfgatev1alpha1.CloudflareVirtualNetwork) (cloudflare.Client, error) {
+	if r.CFClient != nil {
+		return r.CFClient, nil
+	}
+
+	if vnet.Spec.SecretRef == nil {
+		return nil, fmt.Errorf("virtual network %s has no SecretRef and no Cloudflare client is configured", vnet.Name)
+	}
+
+	secretNamespace := vnet.Spec.SecretRef.Namespace
+	if secretNamespace == "" {
+		secretNamespace = vnet.Namespace
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{
+		Name:      vnet.Spec.SecretRef.Name,
+		Namespace: secretNamespace,
+	}, secret); err != nil {
+		return nil, fmt.Errorf("failed to get credentials secret: %w", err)
+	}
+
+	createFn := func() (cloudflare.Client, error) {
+		token, ok := secret.Data["CLOUDFLARE_API_TOKEN"]
+		if !ok {
+			return nil, fmt.Errorf("CLOUDFLARE_API_TOKEN not found in secret")
+		}
+		return cloudflare.NewClient(string(token))
+	}
+
+	if r.CredentialCache != nil {
+		return r.CredentialCache.GetOrCreate(ctx, secret, createFn)
+	}
+
+	return createFn()
+}
+
+// setCondition sets a status condition on vnet.
+func (r *CloudflareVirtualNetworkReconciler) setCondition(vnet *cfgatev1alpha1.CloudflareVirtualNetwork, conditionType string, status metav1.ConditionStatus, reason, message string) {
+	condition := metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: vnet.Generation,
+	}
+
+	meta.SetStatusCondition(&vnet.Status.Conditions, condition)
+}
@@ -0,0 +1,375 @@
+// Package controller contains the reconciliation logic for cfgate CRDs.
+package controller
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	cfgatev1alpha1 "cfgate.io/cfgate/api/v1alpha1"
+	"cfgate.io/cfgate/internal/cloudflare"
+)
+
+const (
+	// wafListSyncFinalizer is the finalizer for CloudflareWAFListSync resources.
+	wafListSyncFinalizer = "cfgate.io/waflist-cleanup"
+
+	// ConditionTypeItemsSynced indicates the WAF list's items are synced.
+	ConditionTypeItemsSynced = "ItemsSynced"
+
+	// nodeIPsRequeueInterval bounds how stale a NodeIPs-sourced list can get:
+	// cfgate doesn't watch Nodes directly, so a periodic reconcile is what
+	// picks up nodes joining or leaving the cluster.
+	nodeIPsRequeueInterval = 5 * time.Minute
+)
+
+// CloudflareWAFListSyncReconciler reconciles a CloudflareWAFListSync object.
+// It manages account-scoped Cloudflare WAF Lists (IP, ASN, or hostname lists),
+// sharing the credential and client plumbing CloudflareDNSSyncReconciler uses.
+type CloudflareWAFListSyncReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder events.EventRecorder
+
+	// CFClient is the Cloudflare API client. Injected for testing.
+	CFClient cloudflare.Client
+
+	// CredentialCache caches validated Cloudflare clients to avoid repeated validations.
+	CredentialCache *cloudflare.CredentialCache
+}
+
+// +kubebuilder:rbac:groups=cfgate.io,resources=cloudflarewaflistsyncs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=cfgate.io,resources=cloudflarewaflistsyncs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=cfgate.io,resources=cloudflarewaflistsyncs/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=configmaps;secrets,verbs=get;list;watch
+
+// Reconcile handles the reconciliation loop for CloudflareWAFListSync resources.
+// It collects items from the configured sources, ensures the Cloudflare list
+// exists, and syncs items into it.
+func (r *CloudflareWAFListSyncReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+	log.Info("reconciling CloudflareWAFListSync", "name", req.Name, "namespace", req.Namespace)
+
+	var wafSync cfgatev1alpha1.CloudflareWAFListSync
+	if err := r.Get(ctx, req.NamespacedName, &wafSync); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("CloudflareWAFListSync not found, ignoring")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("failed to get CloudflareWAFListSync: %w", err)
+	}
+
+	if !wafSync.DeletionTimestamp.IsZero() {
+		return r.reconcileWAFListDelete(ctx, &wafSync)
+	}
+
+	if !controllerutil.ContainsFinalizer(&wafSync, wafListSyncFinalizer) {
+		patch := client.MergeFrom(wafSync.DeepCopy())
+		controllerutil.AddFinalizer(&wafSync, wafListSyncFinalizer)
+		if err := r.Patch(ctx, &wafSync, patch); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to add finalizer: %w", err)
+		}
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	cfClient, err := r.getWAFCloudflareClient(ctx, &wafSync)
+	if err != nil {
+		log.Error(err, "failed to create Cloudflare client")
+		r.setWAFCondition(&wafSync, ConditionTypeReady, metav1.ConditionFalse, "CredentialsUnavailable", err.Error())
+		if err := r.updateWAFStatus(ctx, &wafSync); err != nil {
+			log.Error(err, "failed to update status")
+		}
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
+	items, err := r.collectWAFItems(ctx, &wafSync)
+	if err != nil {
+		log.Error(err, "failed to collect WAF list items")
+		r.setWAFCondition(&wafSync, ConditionTypeItemsSynced, metav1.ConditionFalse, "ItemCollectionFailed", err.Error())
+		if err := r.updateWAFStatus(ctx, &wafSync); err != nil {
+			log.Error(err, "failed to update status")
+		}
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
+	description := wafSync.Spec.Description
+	if description == "" {
+		description = fmt.Sprintf("managed by cfgate (%s/%s)", wafSync.Namespace, wafSync.Name)
+	}
+
+	listService := cloudflare.NewWAFListService(cfClient)
+	list, err := listService.EnsureList(ctx, wafSync.Spec.AccountRef.AccountID, wafSync.Spec.ListName, wafSync.Spec.Kind, description)
+	if err != nil {
+		log.Error(err, "failed to ensure WAF list")
+		r.setWAFCondition(&wafSync, ConditionTypeReady, metav1.ConditionFalse, "ListCreationFailed", err.Error())
+		if err := r.updateWAFStatus(ctx, &wafSync); err != nil {
+			log.Error(err, "failed to update status")
+		}
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
+	synced, failed, err := listService.SyncItems(ctx, wafSync.Spec.AccountRef.AccountID, list.ID, wafSync.Spec.OwnershipPrefix, items)
+	if err != nil {
+		log.Error(err, "failed to sync WAF list items")
+		r.Recorder.Eventf(&wafSync, nil, corev1.EventTypeWarning, "ItemSyncFailed", "Sync", "WAF list item sync failed: %v", err)
+		r.setWAFCondition(&wafSync, ConditionTypeItemsSynced, metav1.ConditionFalse, "ItemSyncFailed", err.Error())
+	} else {
+		r.Recorder.Eventf(&wafSync, nil, corev1.EventTypeNormal, "ItemsSynced", "Sync", "synced %d items to WAF list %s", synced, list.Name)
+		r.setWAFCondition(&wafSync, ConditionTypeItemsSynced, metav1.ConditionTrue, "Synced", fmt.Sprintf("%d items synced", synced))
+	}
+
+	wafSync.Status.ListID = list.ID
+	wafSync.Status.SyncedItems = int32(synced)
+	wafSync.Status.FailedItems = int32(failed)
+	wafSync.Status.ObservedGeneration = wafSync.Generation
+	r.setWAFCondition(&wafSync, ConditionTypeReady, metav1.ConditionTrue, "Ready", "WAF list sync is operational")
+
+	if err := r.updateWAFStatus(ctx, &wafSync); err != nil {
+		log.Error(err, "failed to update status")
+	}
+
+	if wafSync.Spec.Items.NodeIPs != nil && wafSync.Spec.Items.NodeIPs.Enabled {
+		return ctrl.Result{RequeueAfter: nodeIPsRequeueInterval}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *CloudflareWAFListSyncReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&cfgatev1alpha1.CloudflareWAFListSync{},
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Complete(r)
+}
+
+// collectWAFItems gathers the desired item values from every configured
+// source (inline, ConfigMap, Secret, Node IPs) and dedupes them.
+func (r *CloudflareWAFListSyncReconciler) collectWAFItems(ctx context.Context, wafSync *cfgatev1alpha1.CloudflareWAFListSync) ([]string, error) {
+	seen := make(map[string]struct{})
+	var items []string
+	add := func(value string) {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			return
+		}
+		if _, ok := seen[value]; ok {
+			return
+		}
+		seen[value] = struct{}{}
+		items = append(items, value)
+	}
+
+	for _, v := range wafSync.Spec.Items.Inline {
+		add(v)
+	}
+
+	if ref := wafSync.Spec.Items.ConfigMapRef; ref != nil {
+		namespace := ref.Namespace
+		if namespace == "" {
+			namespace = wafSync.Namespace
+		}
+		var cm corev1.ConfigMap
+		if err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: namespace}, &cm); err != nil {
+			return nil, fmt.Errorf("failed to get ConfigMap %s/%s: %w", namespace, ref.Name, err)
+		}
+		if ref.Key != "" {
+			for _, line := range strings.Split(cm.Data[ref.Key], "\n") {
+				add(line)
+			}
+		} else {
+			for _, value := range cm.Data {
+				for _, line := range strings.Split(value, "\n") {
+					add(line)
+				}
+			}
+		}
+	}
+
+	if ref := wafSync.Spec.Items.SecretRef; ref != nil {
+		namespace := ref.Namespace
+		if namespace == "" {
+			namespace = wafSync.Namespace
+		}
+		var secret corev1.Secret
+		if err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: namespace}, &secret); err != nil {
+			return nil, fmt.Errorf("failed to get Secret %s/%s: %w", namespace, ref.Name, err)
+		}
+		if ref.Key != "" {
+			for _, line := range strings.Split(string(secret.Data[ref.Key]), "\n") {
+				add(line)
+			}
+		} else {
+			for _, value := range secret.Data {
+				for _, line := range strings.Split(string(value), "\n") {
+					add(line)
+				}
+			}
+		}
+	}
+
+	if src := wafSync.Spec.Items.NodeIPs; src != nil && src.Enabled {
+		addressType := corev1.NodeAddressType(src.AddressType)
+		if addressType == "" {
+			addressType = corev1.NodeExternalIP
+		}
+
+		var nodes corev1.NodeList
+		if err := r.List(ctx, &nodes, client.MatchingLabels(src.NodeSelector)); err != nil {
+			return nil, fmt.Errorf("failed to list nodes: %w", err)
+		}
+		for _, node := range nodes.Items {
+			for _, addr := range node.Status.Addresses {
+				if addr.Type == addressType {
+					add(addr.Address)
+				}
+			}
+		}
+	}
+
+	return items, nil
+}
+
+// reconcileWAFListDelete handles deletion of CloudflareWAFListSync.
+func (r *CloudflareWAFListSyncReconciler) reconcileWAFListDelete(ctx context.Context, wafSync *cfgatev1alpha1.CloudflareWAFListSync) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+	log.Info("handling WAFListSync deletion", "name", wafSync.Name)
+
+	if !controllerutil.ContainsFinalizer(wafSync, wafListSyncFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	if wafSync.Spec.CleanupPolicy.DeleteOnResourceRemoval && wafSync.Status.ListID != "" {
+		cfClient, err := r.getWAFCloudflareClient(ctx, wafSync)
+		if err != nil {
+			log.Error(err, "failed to create Cloudflare client for cleanup, items may be orphaned")
+		} else {
+			listService := cloudflare.NewWAFListService(cfClient)
+			if _, failed, err := listService.SyncItems(ctx, wafSync.Spec.AccountRef.AccountID, wafSync.Status.ListID, wafSync.Spec.OwnershipPrefix, nil); err != nil || failed > 0 {
+				log.Error(err, "failed to clean up WAF list items, items may be orphaned", "failed", failed)
+				r.Recorder.Eventf(wafSync, nil, corev1.EventTypeWarning, "WAFCleanupFailed", "Cleanup",
+					"WAF list cleanup failed, items may be orphaned: %v", err)
+				// Continue with finalizer removal - don't block deletion
+			}
+		}
+	}
+
+	patch := client.MergeFrom(wafSync.DeepCopy())
+	controllerutil.RemoveFinalizer(wafSync, wafListSyncFinalizer)
+	if err := r.Patch(ctx, wafSync, patch); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to remove finalizer: %w", err)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// updateWAFStatus updates the CloudflareWAFListSync status only if it has changed.
+func (r *CloudflareWAFListSyncReconciler) updateWAFStatus(ctx context.Context, wafSync *cfgatev1alpha1.CloudflareWAFListSync) error {
+	var current cfgatev1alpha1.CloudflareWAFListSync
+	if err := r.Get(ctx, types.NamespacedName{Name: wafSync.Name, Namespace: wafSync.Namespace}, &current); err != nil {
+		return fmt.Errorf("failed to re-fetch WAFListSync: %w", err)
+	}
+
+	if wafListStatusEqual(&current.Status, &wafSync.Status) {
+		return nil
+	}
+
+	current.Status = wafSync.Status
+
+	if err := r.Status().Update(ctx, &current); err != nil {
+		return fmt.Errorf("failed to update status: %w", err)
+	}
+
+	return nil
+}
+
+// wafListStatusEqual compares two WAFListSync statuses for equality.
+// The final reflect.DeepEqual compares every field, condition
+// LastTransitionTime included.
+func wafListStatusEqual(a, b *cfgatev1alpha1.CloudflareWAFListSyncStatus) bool {
+	if a.ObservedGeneration != b.ObservedGeneration || a.ListID != b.ListID {
+		return false
+	}
+	if a.SyncedItems != b.SyncedItems || a.FailedItems != b.FailedItems {
+		return false
+	}
+	if len(a.Conditions) != len(b.Conditions) {
+		return false
+	}
+	for i := range a.Conditions {
+		if a.Conditions[i].Type != b.Conditions[i].Type ||
+			a.Conditions[i].Status != b.Conditions[i].Status ||
+			a.Conditions[i].Reason != b.Conditions[i].Reason ||
+			a.Conditions[i].Message != b.Conditions[i].Message {
+			return false
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// getWAFCloudflareClient creates or returns the Cloudflare client for wafSync's account.
+// Uses the credential cache to avoid repeated API validations.
+func (r *CloudflareWAFListSyncReconciler) getWAFCloudflareClient(ctx context.Context, wafSync *cfgatev1alpha1.CloudflareWAFListSync) (cloudflare.Client, error) {
+	if r.CFClient != nil {
+		return r.CFClient, nil
+	}
+
+	secretNamespace := wafSync.Spec.AccountRef.Namespace
+	if secretNamespace == nil || *secretNamespace == "" {
+		ns := wafSync.Namespace
+		secretNamespace = &ns
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{
+		Name:      wafSync.Spec.AccountRef.Name,
+		Namespace: *secretNamespace,
+	}, secret); err != nil {
+		return nil, fmt.Errorf("failed to get credentials secret: %w", err)
+	}
+
+	createFn := func() (cloudflare.Client, error) {
+		token, ok := secret.Data["CLOUDFLARE_API_TOKEN"]
+		if !ok {
+			return nil, fmt.Errorf("API token key %q not found in secret", "CLOUDFLARE_API_TOKEN")
+		}
+		return cloudflare.NewClient(string(token))
+	}
+
+	if r.CredentialCache != nil {
+		return r.CredentialCache.GetOrCreate(ctx, secret, createFn)
+	}
+
+	return createFn()
+}
+
+// setWAFCondition sets a status condition on wafSync.
+func (r *CloudflareWAFListSyncReconciler) setWAFCondition(wafSync *cfgatev1alpha1.CloudflareWAFListSync, conditionType string, status metav1.ConditionStatus, reason, message string) {
+	condition := metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: wafSync.Generation,
+	}
+
+	meta.SetStatusCondition(&wafSync.Status.Conditions, condition)
+}
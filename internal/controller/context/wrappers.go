@@ -6,7 +6,10 @@ package context
 import (
 	"context"
 	"fmt"
+	"hash/fnv"
 	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -38,6 +41,10 @@ type TunnelContext struct {
 	tunnelClient      cloudflare.Client
 	dnsClient         *cloudflare.DNSService
 
+	// hash caches Hash's result for the lifetime of this context, since
+	// nothing Hash reads changes after NewTunnelContext returns.
+	hash *uint64
+
 	// Logger for this context
 	log logr.Logger
 }
@@ -136,6 +143,45 @@ func (tc *TunnelContext) DNSClient() *cloudflare.DNSService {
 	return tc.dnsClient
 }
 
+// Hash returns a stable structural hash over the tunnel's semantically
+// meaningful configuration - DNS zones, ownership settings, and policy -
+// excluding transient status and timestamps. The result is cached, so
+// calling Hash repeatedly on the same context is free after the first call.
+func (tc *TunnelContext) Hash() (uint64, error) {
+	if tc.hash != nil {
+		return *tc.hash, nil
+	}
+
+	zones := make([]string, len(tc.GetZones()))
+	for i, z := range tc.GetZones() {
+		zones[i] = fmt.Sprintf("%+v", z)
+	}
+	sort.Strings(zones)
+
+	h := hashParts(
+		strings.Join(zones, ","),
+		tc.GetOwnershipPrefix(),
+		tc.GetOwnerIdentifier(),
+		string(tc.GetDNSPolicy()),
+		strconv.FormatBool(tc.HasDNSEnabled()),
+		strconv.FormatBool(tc.ShouldCreateTXTRecords()),
+		strconv.FormatBool(tc.ShouldDeleteOnRemoval()),
+	)
+	tc.hash = &h
+	return h, nil
+}
+
+// HasChangedSince reports whether tc's current Hash differs from prev, so a
+// reconciler can skip rebuilding tunnel ingress or re-syncing DNS records
+// when nothing meaningful moved since the reconcile that observed prev.
+func (tc *TunnelContext) HasChangedSince(prev uint64) bool {
+	h, err := tc.Hash()
+	if err != nil {
+		return true
+	}
+	return h != prev
+}
+
 // -----------------------------------------------------------------------------
 // AccessPolicyContext
 // -----------------------------------------------------------------------------
@@ -732,6 +778,21 @@ func targetExists(
 	return true, nil
 }
 
+// hashParts computes a stable FNV-1a hash over parts, writing a NUL
+// separator after each so that, e.g., ("a", "bc") and ("ab", "c") never
+// collide. Used by the context types' Hash methods to turn their
+// semantically meaningful fields into a single comparable uint64, without
+// pulling in an external struct-hashing library this repo doesn't otherwise
+// depend on.
+func hashParts(parts ...string) uint64 {
+	h := fnv.New64a()
+	for _, p := range parts {
+		h.Write([]byte(p))
+		h.Write([]byte{0})
+	}
+	return h.Sum64()
+}
+
 // checkReferenceGrant checks if cross-namespace reference is permitted.
 func checkReferenceGrant(
 	ctx context.Context,
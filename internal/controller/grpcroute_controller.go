@@ -0,0 +1,282 @@
+// Package controller contains the reconciliation logic for cfgate CRDs.
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// grpcRouteGatewayIndex indexes GRPCRoutes by the "namespace/name" of each
+// Gateway their parentRefs point at, mirroring httpRouteGatewayIndex.
+const grpcRouteGatewayIndex = "spec.parentRefs.gateway.grpcroute"
+
+// GRPCRouteReconciler reconciles GRPCRoute resources. It shares its
+// Gateway-attachment and tunnel-resolution logic with HTTPRouteReconciler via
+// routeCommon, and lowers each rule's Matches into the h2c://- or
+// grpc://-scheme origin cloudflared expects for gRPC backends.
+type GRPCRouteReconciler struct {
+	routeCommon
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=grpcroutes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=grpcroutes/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=referencegrants,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways,verbs=get;list;watch
+
+// Reconcile handles the reconciliation loop for GRPCRoute resources.
+func (r *GRPCRouteReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+	log.Info("reconciling GRPCRoute", "name", req.Name, "namespace", req.Namespace)
+
+	var route gwapiv1.GRPCRoute
+	if err := r.Get(ctx, req.NamespacedName, &route); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("GRPCRoute not found, ignoring")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("failed to get GRPCRoute: %w", err)
+	}
+
+	var parentStatuses []gwapiv1.RouteParentStatus
+	for _, parentRef := range route.Spec.ParentRefs {
+		accepted, reason, _, err := r.validateParentRef(ctx, route.Namespace, "GRPCRoute", route.Spec.Hostnames, parentRef)
+		if err != nil {
+			log.Error(err, "failed to validate parent ref")
+		}
+
+		parentNS := gwapiv1.Namespace(route.Namespace)
+		if parentRef.Namespace != nil {
+			parentNS = *parentRef.Namespace
+		}
+
+		status := gwapiv1.RouteParentStatus{
+			ParentRef: gwapiv1.ParentReference{
+				Group:       parentRef.Group,
+				Kind:        parentRef.Kind,
+				Namespace:   &parentNS,
+				Name:        parentRef.Name,
+				SectionName: parentRef.SectionName,
+			},
+			ControllerName: GatewayControllerName,
+			Conditions: []metav1.Condition{
+				{
+					Type:               string(gwapiv1.RouteConditionAccepted),
+					Status:             metav1.ConditionTrue,
+					Reason:             "Accepted",
+					Message:            "Route accepted by Gateway",
+					LastTransitionTime: metav1.Now(),
+					ObservedGeneration: route.Generation,
+				},
+				{
+					Type:               string(gwapiv1.RouteConditionResolvedRefs),
+					Status:             metav1.ConditionTrue,
+					Reason:             "ResolvedRefs",
+					Message:            "All references resolved",
+					LastTransitionTime: metav1.Now(),
+					ObservedGeneration: route.Generation,
+				},
+			},
+		}
+
+		if !accepted {
+			status.Conditions[0].Status = metav1.ConditionFalse
+			status.Conditions[0].Reason = reason
+			status.Conditions[0].Message = err.Error()
+		}
+
+		parentStatuses = append(parentStatuses, status)
+	}
+
+	route.Status.Parents = parentStatuses
+	if err := r.Status().Update(ctx, &route); err != nil {
+		log.Error(err, "failed to update route status")
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
+	r.Recorder.Event(&route, corev1.EventTypeNormal, "Reconciled", "GRPCRoute reconciled successfully")
+	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *GRPCRouteReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &gwapiv1.GRPCRoute{}, grpcRouteGatewayIndex, func(obj client.Object) []string {
+		route := obj.(*gwapiv1.GRPCRoute)
+		keys := make([]string, 0, len(route.Spec.ParentRefs))
+		for _, p := range route.Spec.ParentRefs {
+			ns := route.Namespace
+			if p.Namespace != nil {
+				ns = string(*p.Namespace)
+			}
+			keys = append(keys, ns+"/"+string(p.Name))
+		}
+		return keys
+	}); err != nil {
+		return fmt.Errorf("failed to index GRPCRoute by gateway: %w", err)
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&gwapiv1.GRPCRoute{}).
+		Watches(
+			&gwapiv1b1.ReferenceGrant{},
+			handler.EnqueueRequestsFromMapFunc(r.findAffectedGRPCRoutes),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Complete(r)
+}
+
+// findAffectedGRPCRoutes maps a ReferenceGrant change to every GRPCRoute, the
+// same conservative approach findAffectedHTTPRoutes takes.
+func (r *GRPCRouteReconciler) findAffectedGRPCRoutes(ctx context.Context, obj client.Object) []reconcile.Request {
+	log := log.FromContext(ctx)
+
+	var routeList gwapiv1.GRPCRouteList
+	if err := r.List(ctx, &routeList); err != nil {
+		log.Error(err, "failed to list GRPCRoute resources")
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(routeList.Items))
+	for _, route := range routeList.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: route.Name, Namespace: route.Namespace},
+		})
+	}
+
+	if len(requests) > 0 {
+		log.Info("ReferenceGrant change triggering GRPCRoute reconciliation", "count", len(requests))
+	}
+
+	return requests
+}
+
+// buildIngressRules builds the cloudflared-bound IngressRules for a
+// GRPCRoute rule. Unlike HTTPRoute, a GRPCRoute rule carries only a single
+// effective backend (weighted multi-backend gRPC fan-out isn't supported
+// here); Matches are rendered as /pkg.Service/Method path regexes since
+// cloudflared has no gRPC-aware match concept of its own.
+func (r *GRPCRouteReconciler) buildIngressRules(ctx context.Context, route *gwapiv1.GRPCRoute, rule gwapiv1.GRPCRouteRule, acceptedHostnames []string) ([]*IngressRule, error) {
+	if len(rule.BackendRefs) == 0 {
+		return nil, fmt.Errorf("no backends specified")
+	}
+
+	hostnames := acceptedHostnames
+	if len(hostnames) == 0 {
+		hostnames = []string{"*"}
+	}
+
+	backend := rule.BackendRefs[0].BackendRef
+	permitted, err := r.backendRefPermitted(ctx, route, backend)
+	if err != nil {
+		return nil, err
+	}
+	if !permitted {
+		return nil, fmt.Errorf("backend %s/%s not permitted by any ReferenceGrant", backendGRPCNamespace(route, backend), backend.Name)
+	}
+
+	service := grpcServiceURL(route, backend)
+
+	matches := rule.Matches
+	if len(matches) == 0 {
+		matches = []gwapiv1.GRPCRouteMatch{{}}
+	}
+
+	rules := make([]*IngressRule, 0, len(matches)*len(hostnames))
+	for _, hostname := range hostnames {
+		for _, match := range matches {
+			rules = append(rules, &IngressRule{
+				Hostname: hostname,
+				Path:     grpcMethodPath(match.Method),
+				PathType: "RegularExpression",
+				Service:  service,
+			})
+		}
+	}
+
+	return rules, nil
+}
+
+// grpcMethodPath renders a GRPCMethodMatch as the path regex cloudflared
+// evaluates. An unset Service or Method component matches any value in that
+// position; a wholly unset match passes every RPC on the route's hostname.
+func grpcMethodPath(method *gwapiv1.GRPCMethodMatch) string {
+	if method == nil {
+		return ""
+	}
+
+	service := ".+"
+	if method.Service != nil {
+		service = strings.ReplaceAll(*method.Service, ".", `\.`)
+	}
+	name := ".+"
+	if method.Method != nil {
+		name = *method.Method
+	}
+	if method.Service == nil && method.Method == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("^/%s/%s$", service, name)
+}
+
+// backendGRPCNamespace resolves a BackendRef's effective namespace for a GRPCRoute.
+func backendGRPCNamespace(route *gwapiv1.GRPCRoute, backend gwapiv1.BackendRef) string {
+	if backend.Namespace != nil {
+		return string(*backend.Namespace)
+	}
+	return route.Namespace
+}
+
+// backendRefPermitted reports whether backend may be referenced from route,
+// consulting ReferenceGrant for cross-namespace refs.
+func (r *GRPCRouteReconciler) backendRefPermitted(ctx context.Context, route *gwapiv1.GRPCRoute, backend gwapiv1.BackendRef) (bool, error) {
+	namespace := backendGRPCNamespace(route, backend)
+	if namespace == route.Namespace {
+		return true, nil
+	}
+
+	return referenceGrantAllows(ctx, r.Client,
+		"gateway.networking.k8s.io", "GRPCRoute", route.Namespace,
+		"", "Service", namespace, string(backend.Name))
+}
+
+// grpcServiceURL renders the in-cluster origin URL for a GRPCRoute backend.
+// cloudflared needs to know whether the backend speaks cleartext HTTP/2
+// (h2c) or TLS-wrapped HTTP/2 (grpc); the route's origin-server-name
+// annotation, the same one HTTPRoute uses to pin SNI for a TLS origin, is
+// used here as the signal that the backend expects TLS.
+func grpcServiceURL(route *gwapiv1.GRPCRoute, backend gwapiv1.BackendRef) string {
+	namespace := backendGRPCNamespace(route, backend)
+
+	port := int32(80)
+	if backend.Port != nil {
+		port = int32(*backend.Port)
+	}
+
+	scheme := "h2c"
+	if v, ok := route.Annotations[AnnotationOriginServerName]; ok && v != "" {
+		scheme = "grpc"
+	}
+
+	return fmt.Sprintf("%s://%s.%s.svc.cluster.local:%d", scheme, backend.Name, namespace, port)
+}
@@ -4,6 +4,7 @@ package controller
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
 
@@ -12,15 +13,36 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwapiv1a3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
+	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	cfgatev1alpha1 "cfgate.io/cfgate/api/v1alpha1"
+	"cfgate.io/cfgate/internal/cloudflared"
 )
 
+// httpRouteGatewayIndex indexes HTTPRoutes by the "namespace/name" of each
+// Gateway their parentRefs point at, so the attachedRoutes count for a given
+// Gateway can be recomputed without listing every HTTPRoute in the cluster.
+const httpRouteGatewayIndex = "spec.parentRefs.gateway"
+
+// backendTLSPolicyTargetIndex indexes BackendTLSPolicies by the
+// "namespace/name" of each Service their targetRefs point at, so policy
+// resolution for a route's backend doesn't require listing every
+// BackendTLSPolicy in the cluster.
+const backendTLSPolicyTargetIndex = "spec.targetRefs.service"
+
 // Route annotation keys for per-route origin configuration.
 const (
 	AnnotationOriginConnectTimeout = "cfgate.io/origin-connect-timeout"
@@ -36,13 +58,29 @@ const (
 // It validates routes against Gateway configuration and triggers
 // tunnel configuration syncs when routes change.
 type HTTPRouteReconciler struct {
-	client.Client
+	routeCommon
 	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
+
+	// Assembler rebuilds the owning tunnel's TunnelConfig on a debounce
+	// timer whenever this reconciler processes a route that affects it. Nil
+	// is safe to leave unset (e.g. in tests that exercise status logic
+	// only); no TunnelConfig rebuild is triggered in that case.
+	Assembler *TunnelConfigAssembler
 }
 
 // +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes,verbs=get;list;watch
 // +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups="",resources=endpoints,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=referencegrants,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=backendtlspolicies,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=backendtlspolicies/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch
 
 // Reconcile handles the reconciliation loop for HTTPRoute resources.
 // It validates the route against parent Gateways and triggers config sync.
@@ -62,13 +100,20 @@ func (r *HTTPRouteReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 
 	// 2. For each parentRef, validate Gateway exists and accepts route
 	var parentStatuses []gwapiv1.RouteParentStatus
+	gatewayNames := map[types.NamespacedName]struct{}{}
 
 	for _, parentRef := range route.Spec.ParentRefs {
-		accepted, reason, err := r.validateParentRef(ctx, &route, parentRef)
+		accepted, reason, _, err := r.validateParentRef(ctx, route.Namespace, "HTTPRoute", route.Spec.Hostnames, parentRef)
 		if err != nil {
 			log.Error(err, "failed to validate parent ref")
 		}
 
+		gwNamespace := route.Namespace
+		if parentRef.Namespace != nil {
+			gwNamespace = string(*parentRef.Namespace)
+		}
+		gatewayNames[types.NamespacedName{Name: string(parentRef.Name), Namespace: gwNamespace}] = struct{}{}
+
 		// Build parent status
 		parentNS := gwapiv1.Namespace(route.Namespace)
 		if parentRef.Namespace != nil {
@@ -113,15 +158,35 @@ func (r *HTTPRouteReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		parentStatuses = append(parentStatuses, status)
 	}
 
-	// 3. Resolve backend services
-	if err := r.resolveBackends(ctx, &route); err != nil {
+	// 3. Resolve backend services. Issues are reported per-ref rather than
+	// failing the whole route, mirroring how Kong/Traefik handle partially
+	// invalid HTTPRoutes.
+	issues, err := r.resolveBackends(ctx, &route)
+	if err != nil {
 		log.Error(err, "failed to resolve backends")
-		// Update ResolvedRefs condition
 		for i := range parentStatuses {
 			parentStatuses[i].Conditions[1].Status = metav1.ConditionFalse
 			parentStatuses[i].Conditions[1].Reason = "BackendNotFound"
 			parentStatuses[i].Conditions[1].Message = err.Error()
 		}
+	} else if len(issues) > 0 {
+		// RefNotPermitted takes precedence in the reported Reason: a denied
+		// ReferenceGrant is a policy decision, whereas a missing Service may
+		// just not be created yet.
+		reason := "BackendNotFound"
+		parts := make([]string, len(issues))
+		for i, issue := range issues {
+			parts[i] = fmt.Sprintf("%s (%s)", issue.Ref, issue.Reason)
+			if issue.Reason == "RefNotPermitted" {
+				reason = "RefNotPermitted"
+			}
+		}
+		message := fmt.Sprintf("backend(s) not resolved: %s", strings.Join(parts, ", "))
+		for i := range parentStatuses {
+			parentStatuses[i].Conditions[1].Status = metav1.ConditionFalse
+			parentStatuses[i].Conditions[1].Reason = reason
+			parentStatuses[i].Conditions[1].Message = message
+		}
 	}
 
 	// 4. Update route status
@@ -131,88 +196,244 @@ func (r *HTTPRouteReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
 	}
 
+	// 5. Recompute attachedRoutes and conditions on every referenced
+	// Gateway's listener status. Best-effort: a failure here doesn't block
+	// this route's own status from having been recorded above.
+	for gwName := range gatewayNames {
+		if err := r.syncGatewayListenerStatus(ctx, gwName); err != nil {
+			log.Error(err, "failed to sync gateway listener status", "gateway", gwName)
+		}
+	}
+
+	// 6. Trigger the tunnel config assembler (if wired) so this route's
+	// ingress rules land in the next debounced TunnelConfig rebuild rather
+	// than racing other routes' reconciles.
+	if r.Assembler != nil {
+		if tunnel, err := r.findTunnelForRoute(ctx, route.Namespace, route.Spec.ParentRefs); err != nil {
+			log.Error(err, "failed to resolve tunnel for route")
+		} else if tunnel != nil {
+			r.Assembler.Trigger(types.NamespacedName{Name: tunnel.Name, Namespace: tunnel.Namespace})
+		}
+	}
+
 	r.Recorder.Event(&route, corev1.EventTypeNormal, "Reconciled", "HTTPRoute reconciled successfully")
 	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *HTTPRouteReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &gwapiv1.HTTPRoute{}, httpRouteGatewayIndex, func(obj client.Object) []string {
+		route := obj.(*gwapiv1.HTTPRoute)
+		keys := make([]string, 0, len(route.Spec.ParentRefs))
+		for _, p := range route.Spec.ParentRefs {
+			ns := route.Namespace
+			if p.Namespace != nil {
+				ns = string(*p.Namespace)
+			}
+			keys = append(keys, ns+"/"+string(p.Name))
+		}
+		return keys
+	}); err != nil {
+		return fmt.Errorf("failed to index HTTPRoute by gateway: %w", err)
+	}
+
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &gwapiv1a3.BackendTLSPolicy{}, backendTLSPolicyTargetIndex, func(obj client.Object) []string {
+		policy := obj.(*gwapiv1a3.BackendTLSPolicy)
+		keys := make([]string, 0, len(policy.Spec.TargetRefs))
+		for _, ref := range policy.Spec.TargetRefs {
+			if ref.Kind != "Service" {
+				continue
+			}
+			keys = append(keys, policy.Namespace+"/"+string(ref.Name))
+		}
+		return keys
+	}); err != nil {
+		return fmt.Errorf("failed to index BackendTLSPolicy by target service: %w", err)
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&gwapiv1.HTTPRoute{}).
+		Watches(
+			&gwapiv1b1.ReferenceGrant{},
+			handler.EnqueueRequestsFromMapFunc(r.findAffectedHTTPRoutes),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Watches(
+			&gwapiv1a3.BackendTLSPolicy{},
+			handler.EnqueueRequestsFromMapFunc(r.findHTTPRoutesForBackendTLSPolicy),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
 		Complete(r)
 }
 
-// validateParentRef validates that the parent Gateway accepts this route.
-// Returns true if the route is accepted by the Gateway.
-func (r *HTTPRouteReconciler) validateParentRef(ctx context.Context, route *gwapiv1.HTTPRoute, ref gwapiv1.ParentReference) (bool, string, error) {
-	// Get the Gateway
-	gwNamespace := route.Namespace
-	if ref.Namespace != nil {
-		gwNamespace = string(*ref.Namespace)
+// findAffectedHTTPRoutes maps a ReferenceGrant change to the HTTPRoutes that
+// may be affected by it. For simplicity, trigger reconciliation for all
+// HTTPRoutes; the reconciler will re-evaluate grants and filter appropriately.
+func (r *HTTPRouteReconciler) findAffectedHTTPRoutes(ctx context.Context, obj client.Object) []reconcile.Request {
+	log := log.FromContext(ctx)
+
+	var routeList gwapiv1.HTTPRouteList
+	if err := r.List(ctx, &routeList); err != nil {
+		log.Error(err, "failed to list HTTPRoute resources")
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(routeList.Items))
+	for _, route := range routeList.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      route.Name,
+				Namespace: route.Namespace,
+			},
+		})
 	}
 
+	if len(requests) > 0 {
+		log.Info("ReferenceGrant change triggering HTTPRoute reconciliation", "count", len(requests))
+	}
+
+	return requests
+}
+
+// findHTTPRoutesForBackendTLSPolicy maps a BackendTLSPolicy change to the
+// HTTPRoutes in its namespace whose rules have a BackendRef naming one of
+// its target Services, so a policy edit (e.g. a new CACertificateRef)
+// re-renders the affected routes' origin request config.
+func (r *HTTPRouteReconciler) findHTTPRoutesForBackendTLSPolicy(ctx context.Context, obj client.Object) []reconcile.Request {
+	log := log.FromContext(ctx)
+
+	policy, ok := obj.(*gwapiv1a3.BackendTLSPolicy)
+	if !ok {
+		return nil
+	}
+
+	targets := make(map[string]struct{}, len(policy.Spec.TargetRefs))
+	for _, ref := range policy.Spec.TargetRefs {
+		if ref.Kind != "Service" {
+			continue
+		}
+		targets[string(ref.Name)] = struct{}{}
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+
+	var routeList gwapiv1.HTTPRouteList
+	if err := r.List(ctx, &routeList, client.InNamespace(policy.Namespace)); err != nil {
+		log.Error(err, "failed to list HTTPRoutes for BackendTLSPolicy", "policy", policy.Name)
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, route := range routeList.Items {
+		for _, rule := range route.Spec.Rules {
+			for _, backend := range rule.BackendRefs {
+				if backendNamespace(&route, backend.BackendRef) != policy.Namespace {
+					continue
+				}
+				if _, ok := targets[string(backend.Name)]; ok {
+					requests = append(requests, reconcile.Request{
+						NamespacedName: types.NamespacedName{Name: route.Name, Namespace: route.Namespace},
+					})
+				}
+			}
+		}
+	}
+
+	if len(requests) > 0 {
+		log.Info("BackendTLSPolicy change triggering HTTPRoute reconciliation", "policy", policy.Name, "count", len(requests))
+	}
+
+	return requests
+}
+
+// syncGatewayListenerStatus recomputes gatewayName's per-listener
+// attachedRoutes counts and write them back to the Gateway's status, along
+// with a minimal Accepted/ResolvedRefs condition pair per listener. It lists
+// HTTPRoutes via httpRouteGatewayIndex rather than scanning every HTTPRoute
+// in the cluster.
+func (r *HTTPRouteReconciler) syncGatewayListenerStatus(ctx context.Context, gatewayName types.NamespacedName) error {
 	var gateway gwapiv1.Gateway
-	if err := r.Get(ctx, types.NamespacedName{
-		Name:      string(ref.Name),
-		Namespace: gwNamespace,
-	}, &gateway); err != nil {
+	if err := r.Get(ctx, gatewayName, &gateway); err != nil {
 		if apierrors.IsNotFound(err) {
-			return false, "NoMatchingParent", fmt.Errorf("gateway %s/%s not found", gwNamespace, ref.Name)
+			return nil
 		}
-		return false, "Error", err
+		return fmt.Errorf("failed to get gateway %s: %w", gatewayName, err)
 	}
 
-	// Check if Gateway's GatewayClass is ours
-	var gc gwapiv1.GatewayClass
-	if err := r.Get(ctx, types.NamespacedName{Name: string(gateway.Spec.GatewayClassName)}, &gc); err != nil {
-		if apierrors.IsNotFound(err) {
-			return false, "NoMatchingParent", fmt.Errorf("gateway class %s not found", gateway.Spec.GatewayClassName)
-		}
-		return false, "Error", err
-	}
-
-	if string(gc.Spec.ControllerName) != GatewayControllerName {
-		// Not our Gateway, skip
-		return false, "NoMatchingParent", fmt.Errorf("gateway is not managed by cfgate")
-	}
-
-	// Check if Gateway has tunnel reference
-	if _, ok := gateway.Annotations[AnnotationTunnelRef]; !ok {
-		return false, "NoTunnelRef", fmt.Errorf("gateway has no tunnel reference")
-	}
-
-	// Check listener compatibility if section name specified
-	if ref.SectionName != nil {
-		found := false
-		for _, listener := range gateway.Spec.Listeners {
-			if listener.Name == *ref.SectionName {
-				found = true
-				// Check allowed routes
-				if listener.AllowedRoutes != nil {
-					// Check namespace selector
-					if listener.AllowedRoutes.Namespaces != nil {
-						from := listener.AllowedRoutes.Namespaces.From
-						if from != nil && *from == gwapiv1.NamespacesFromSame {
-							if route.Namespace != gateway.Namespace {
-								return false, "NotAllowedByListeners", fmt.Errorf("route namespace not allowed by listener")
-							}
-						}
-					}
+	var routeList gwapiv1.HTTPRouteList
+	if err := r.List(ctx, &routeList, client.MatchingFields{httpRouteGatewayIndex: gatewayName.Namespace + "/" + gatewayName.Name}); err != nil {
+		return fmt.Errorf("failed to list HTTPRoutes for gateway %s: %w", gatewayName, err)
+	}
+
+	listenerStatuses := make([]gwapiv1.ListenerStatus, len(gateway.Spec.Listeners))
+	for i, listener := range gateway.Spec.Listeners {
+		var attached int32
+		for _, route := range routeList.Items {
+			for _, parentRef := range route.Spec.ParentRefs {
+				if !parentRefTargets(route.Namespace, parentRef, gatewayName, listener.Name) {
+					continue
 				}
+				if !listenerAllowsKind(listener, "HTTPRoute") {
+					continue
+				}
+				allowedNS, err := r.listenerAllowsNamespace(ctx, listener, gateway.Namespace, route.Namespace)
+				if err != nil || !allowedNS {
+					continue
+				}
+				if _, ok := intersectHostnames(route.Spec.Hostnames, listener.Hostname); !ok {
+					continue
+				}
+				attached++
 				break
 			}
 		}
-		if !found {
-			return false, "NoMatchingListenerHostname", fmt.Errorf("listener %s not found", *ref.SectionName)
+
+		listenerStatuses[i] = gwapiv1.ListenerStatus{
+			Name:           listener.Name,
+			AttachedRoutes: attached,
+			Conditions: []metav1.Condition{
+				{
+					Type:               string(gwapiv1.ListenerConditionAccepted),
+					Status:             metav1.ConditionTrue,
+					Reason:             "Accepted",
+					Message:            "Listener accepted",
+					LastTransitionTime: metav1.Now(),
+					ObservedGeneration: gateway.Generation,
+				},
+				{
+					Type:               string(gwapiv1.ListenerConditionResolvedRefs),
+					Status:             metav1.ConditionTrue,
+					Reason:             "ResolvedRefs",
+					Message:            "All references resolved",
+					LastTransitionTime: metav1.Now(),
+					ObservedGeneration: gateway.Generation,
+				},
+			},
 		}
 	}
 
-	return true, "", nil
+	gateway.Status.Listeners = listenerStatuses
+	return r.Status().Update(ctx, &gateway)
+}
+
+// BackendResolutionIssue describes one BackendRef that could not be used,
+// either because the Service does not exist (BackendNotFound) or because no
+// ReferenceGrant permits this cross-namespace reference (RefNotPermitted).
+type BackendResolutionIssue struct {
+	Ref    string
+	Reason string
 }
 
 // resolveBackends resolves backend service references to endpoints.
-// Returns an error if any required backend cannot be resolved.
-func (r *HTTPRouteReconciler) resolveBackends(ctx context.Context, route *gwapiv1.HTTPRoute) error {
+// Returns one issue per backend that could not be used, instead of aborting
+// on the first miss, so a rule with some valid and some invalid backends
+// still has its valid backends built into the TunnelConfig. A non-NotFound
+// error (e.g. an API server failure) is still returned as a hard error since
+// it says nothing about whether the backend actually exists.
+func (r *HTTPRouteReconciler) resolveBackends(ctx context.Context, route *gwapiv1.HTTPRoute) ([]BackendResolutionIssue, error) {
+	var issues []BackendResolutionIssue
+
 	for _, rule := range route.Spec.Rules {
 		for _, backend := range rule.BackendRefs {
 			// Skip non-Service backends
@@ -220,11 +441,24 @@ func (r *HTTPRouteReconciler) resolveBackends(ctx context.Context, route *gwapiv
 				continue
 			}
 
-			// Get the service
 			namespace := route.Namespace
 			if backend.Namespace != nil {
 				namespace = string(*backend.Namespace)
 			}
+			ref := fmt.Sprintf("%s/%s", namespace, backend.Name)
+
+			if namespace != route.Namespace {
+				allowed, err := referenceGrantAllows(ctx, r.Client,
+					"gateway.networking.k8s.io", "HTTPRoute", route.Namespace,
+					"", "Service", namespace, string(backend.Name))
+				if err != nil {
+					return issues, fmt.Errorf("checking ReferenceGrant for %s: %w", ref, err)
+				}
+				if !allowed {
+					issues = append(issues, BackendResolutionIssue{Ref: ref, Reason: "RefNotPermitted"})
+					continue
+				}
+			}
 
 			var svc corev1.Service
 			if err := r.Get(ctx, types.NamespacedName{
@@ -232,81 +466,600 @@ func (r *HTTPRouteReconciler) resolveBackends(ctx context.Context, route *gwapiv
 				Namespace: namespace,
 			}, &svc); err != nil {
 				if apierrors.IsNotFound(err) {
-					return fmt.Errorf("service %s/%s not found", namespace, backend.Name)
+					issues = append(issues, BackendResolutionIssue{Ref: ref, Reason: "BackendNotFound"})
+					continue
 				}
-				return fmt.Errorf("failed to get service: %w", err)
+				return issues, fmt.Errorf("failed to get service: %w", err)
 			}
 		}
 	}
 
-	return nil
+	return issues, nil
 }
 
-// buildIngressRule builds a cloudflared ingress rule from an HTTPRoute rule.
-// Includes hostname, path matching, and backend configuration.
-func (r *HTTPRouteReconciler) buildIngressRule(ctx context.Context, route *gwapiv1.HTTPRoute, rule gwapiv1.HTTPRouteRule) (*IngressRule, error) {
+// buildIngressRules builds the cloudflared-bound IngressRules for an
+// HTTPRoute rule. Gateway API ORs across a rule's Matches, so one
+// HTTPRouteRule becomes one IngressRule per match, each carrying the same
+// backend and filters. Path matches are translated into the single path
+// regex cloudflared evaluates (Exact and PathPrefix are compiled to an
+// anchored regex; RegularExpression passes through as-is). Headers,
+// QueryParams, and Method predicates have no cloudflared ingress equivalent
+// (tunnel ingress only ever sees hostname + path), so they are attached to
+// the rule as Match metadata instead of being silently dropped — callers
+// should surface RouteReasonUnsupportedValue on the route status until a
+// Worker-based shim exists to actually enforce them at the edge.
+// acceptedHostnames is the hostname subset validateParentRef computed for
+// the listener(s) this route attached to; one IngressRule is produced per
+// (hostname, match) pair so rejected hostnames never reach the TunnelConfig.
+func (r *HTTPRouteReconciler) buildIngressRules(ctx context.Context, route *gwapiv1.HTTPRoute, ruleIndex int, rule gwapiv1.HTTPRouteRule, acceptedHostnames []string) ([]*IngressRule, error) {
 	if len(rule.BackendRefs) == 0 {
 		return nil, fmt.Errorf("no backends specified")
 	}
 
-	backend := rule.BackendRefs[0] // Use first backend (no weighted routing support)
+	hostnames := acceptedHostnames
+	if len(hostnames) == 0 {
+		hostnames = []string{"*"}
+	}
 
-	namespace := route.Namespace
-	if backend.Namespace != nil {
-		namespace = string(*backend.Namespace)
+	service, err := r.resolveRuleService(ctx, route, ruleIndex, rule.BackendRefs)
+	if err != nil {
+		return nil, err
 	}
 
-	port := int32(80)
-	if backend.Port != nil {
-		port = int32(*backend.Port)
+	originRequest := r.buildOriginRequestConfig(ctx, route, rule.BackendRefs[0].BackendRef)
+
+	var requestHeaderMod, responseHeaderMod *HeaderModifier
+	var redirect *RedirectConfig
+	var rewrite *RewriteConfig
+	for _, filter := range rule.Filters {
+		switch filter.Type {
+		case gwapiv1.HTTPRouteFilterRequestHeaderModifier:
+			requestHeaderMod = convertHeaderModifier(filter.RequestHeaderModifier)
+		case gwapiv1.HTTPRouteFilterResponseHeaderModifier:
+			responseHeaderMod = convertHeaderModifier(filter.ResponseHeaderModifier)
+		case gwapiv1.HTTPRouteFilterRequestRedirect:
+			redirect = convertRedirect(filter.RequestRedirect)
+		case gwapiv1.HTTPRouteFilterURLRewrite:
+			rewrite = convertRewrite(filter.URLRewrite)
+		}
 	}
 
-	service := fmt.Sprintf("http://%s.%s.svc.cluster.local:%d", backend.Name, namespace, port)
+	matches := rule.Matches
+	if len(matches) == 0 {
+		matches = []gwapiv1.HTTPRouteMatch{{}}
+	}
+
+	rules := make([]*IngressRule, 0, len(matches)*len(hostnames))
+	for _, hostname := range hostnames {
+		for _, match := range matches {
+			pathType := gwapiv1.PathMatchPathPrefix
+			pathValue := ""
+			if match.Path != nil {
+				if match.Path.Type != nil {
+					pathType = *match.Path.Type
+				}
+				if match.Path.Value != nil {
+					pathValue = *match.Path.Value
+				}
+			}
 
-	// Get path if specified
-	path := ""
-	pathType := "Prefix"
-	if len(rule.Matches) > 0 && rule.Matches[0].Path != nil {
-		if rule.Matches[0].Path.Value != nil {
-			path = *rule.Matches[0].Path.Value
+			rules = append(rules, &IngressRule{
+				Hostname:               hostname,
+				Path:                   cloudflaredPath(pathType, pathValue),
+				PathType:               string(pathType),
+				Service:                service,
+				OriginRequest:          originRequest,
+				Match:                  unsupportedMatch(match),
+				RequestHeaderModifier:  requestHeaderMod,
+				ResponseHeaderModifier: responseHeaderMod,
+				Redirect:               redirect,
+				Rewrite:                rewrite,
+			})
 		}
-		if rule.Matches[0].Path.Type != nil {
-			pathType = string(*rule.Matches[0].Path.Type)
+	}
+
+	return rules, nil
+}
+
+// cloudflaredPath compiles a Gateway API path match into the value
+// cloudflared's single ingress "path" regex expects. An empty PathPrefix of
+// "/" or "" means "no restriction", which cloudflared expresses as an empty
+// path field.
+func cloudflaredPath(pathType gwapiv1.PathMatchType, value string) string {
+	switch pathType {
+	case gwapiv1.PathMatchExact:
+		return fmt.Sprintf("^%s$", regexp.QuoteMeta(value))
+	case gwapiv1.PathMatchRegularExpression:
+		return value
+	default: // PathMatchPathPrefix
+		trimmed := strings.TrimSuffix(value, "/")
+		if trimmed == "" {
+			return ""
 		}
+		return fmt.Sprintf("^%s(/.*)?$", regexp.QuoteMeta(trimmed))
 	}
+}
 
-	ingressRule := &IngressRule{
-		Path:     path,
-		PathType: pathType,
-		Service:  service,
+// unsupportedMatch captures the predicates of an HTTPRouteMatch that
+// cloudflared's tunnel ingress has no way to evaluate. Returns nil when the
+// match is fully expressible as a path regex.
+func unsupportedMatch(match gwapiv1.HTTPRouteMatch) *RouteMatch {
+	if match.Method == nil && len(match.Headers) == 0 && len(match.QueryParams) == 0 {
+		return nil
 	}
 
-	// Build origin config from annotations
-	ingressRule.OriginRequest = &OriginRequestConfig{}
+	rm := &RouteMatch{}
+	if match.Method != nil {
+		rm.Method = string(*match.Method)
+	}
+	for _, h := range match.Headers {
+		rm.Headers = append(rm.Headers, HeaderMatch{Name: string(h.Name), Value: h.Value})
+	}
+	for _, q := range match.QueryParams {
+		rm.QueryParams = append(rm.QueryParams, QueryParamMatch{Name: string(q.Name), Value: q.Value})
+	}
+	return rm
+}
+
+// convertHeaderModifier translates an HTTPHeaderFilter (used by both
+// RequestHeaderModifier and ResponseHeaderModifier) into cfgate's internal
+// representation.
+func convertHeaderModifier(filter *gwapiv1.HTTPHeaderFilter) *HeaderModifier {
+	if filter == nil {
+		return nil
+	}
+
+	hm := &HeaderModifier{Remove: filter.Remove}
+	for _, h := range filter.Set {
+		if hm.Set == nil {
+			hm.Set = map[string]string{}
+		}
+		hm.Set[string(h.Name)] = h.Value
+	}
+	for _, h := range filter.Add {
+		if hm.Add == nil {
+			hm.Add = map[string]string{}
+		}
+		hm.Add[string(h.Name)] = h.Value
+	}
+	return hm
+}
+
+// convertRedirect translates an HTTPRequestRedirectFilter.
+func convertRedirect(filter *gwapiv1.HTTPRequestRedirectFilter) *RedirectConfig {
+	if filter == nil {
+		return nil
+	}
+
+	rc := &RedirectConfig{StatusCode: 302}
+	if filter.Scheme != nil {
+		rc.Scheme = *filter.Scheme
+	}
+	if filter.Hostname != nil {
+		rc.Hostname = string(*filter.Hostname)
+	}
+	if filter.Path != nil && filter.Path.ReplaceFullPath != nil {
+		rc.Path = *filter.Path.ReplaceFullPath
+	}
+	if filter.StatusCode != nil {
+		rc.StatusCode = *filter.StatusCode
+	}
+	return rc
+}
+
+// convertRewrite translates an HTTPURLRewriteFilter. Path prefix rewrites
+// are carried as PathPrefixReplace so a lowering step can compose them with
+// cloudflared's path regex plus origin request rewriting once one exists.
+func convertRewrite(filter *gwapiv1.HTTPURLRewriteFilter) *RewriteConfig {
+	if filter == nil {
+		return nil
+	}
+
+	rc := &RewriteConfig{}
+	if filter.Hostname != nil {
+		rc.Hostname = string(*filter.Hostname)
+	}
+	if filter.Path != nil {
+		switch filter.Path.Type {
+		case gwapiv1.FullPathHTTPPathModifier:
+			if filter.Path.ReplaceFullPath != nil {
+				rc.PathFullReplace = *filter.Path.ReplaceFullPath
+			}
+		case gwapiv1.PrefixMatchHTTPPathModifier:
+			if filter.Path.ReplacePrefixMatch != nil {
+				rc.PathPrefixReplace = *filter.Path.ReplacePrefixMatch
+			}
+		}
+	}
+	return rc
+}
+
+// buildOriginRequestConfig builds an OriginRequestConfig for one rule,
+// layering three sources from lowest to highest precedence: the route's
+// tunnel's spec.originDefaults, this route's cfgate.io/origin-* annotations,
+// and a BackendTLSPolicy targeting the rule's primary backend Service. A
+// BackendTLSPolicy's CACertificateRefs and Hostname, when present, always
+// win over whatever the annotations or tunnel defaults set for CAPool and
+// OriginServerName.
+func (r *HTTPRouteReconciler) buildOriginRequestConfig(ctx context.Context, route *gwapiv1.HTTPRoute, backend gwapiv1.BackendRef) *OriginRequestConfig {
+	log := log.FromContext(ctx)
+	originRequest := &OriginRequestConfig{}
+
+	if tunnel, err := r.findTunnelForRoute(ctx, route.Namespace, route.Spec.ParentRefs); err == nil {
+		originRequest.ConnectTimeout = tunnel.Spec.OriginDefaults.ConnectTimeout
+		originRequest.NoTLSVerify = tunnel.Spec.OriginDefaults.NoTLSVerify
+		originRequest.HTTP2Origin = tunnel.Spec.OriginDefaults.HTTP2Origin
+	}
 
 	if v, ok := route.Annotations[AnnotationOriginConnectTimeout]; ok {
-		ingressRule.OriginRequest.ConnectTimeout = v
+		originRequest.ConnectTimeout = v
 	}
 	if v, ok := route.Annotations[AnnotationOriginNoTLSVerify]; ok && strings.ToLower(v) == "true" {
-		ingressRule.OriginRequest.NoTLSVerify = true
+		originRequest.NoTLSVerify = true
 	}
 	if v, ok := route.Annotations[AnnotationOriginHTTPHostHeader]; ok {
-		ingressRule.OriginRequest.HTTPHostHeader = v
+		originRequest.HTTPHostHeader = v
 	}
 	if v, ok := route.Annotations[AnnotationOriginServerName]; ok {
-		ingressRule.OriginRequest.OriginServerName = v
+		originRequest.OriginServerName = v
 	}
 	if v, ok := route.Annotations[AnnotationOriginCAPool]; ok {
-		ingressRule.OriginRequest.CAPool = v
+		originRequest.CAPool = v
 	}
 	if v, ok := route.Annotations[AnnotationOriginHTTP2]; ok && strings.ToLower(v) == "true" {
-		ingressRule.OriginRequest.HTTP2Origin = true
+		originRequest.HTTP2Origin = true
 	}
 	if v, ok := route.Annotations[AnnotationOriginMatchSNIToHost]; ok && strings.ToLower(v) == "true" {
-		ingressRule.OriginRequest.MatchSNIToHost = true
+		originRequest.MatchSNIToHost = true
 	}
 
-	return ingressRule, nil
+	if err := r.applyBackendTLSPolicy(ctx, originRequest, route, backend); err != nil {
+		log.Error(err, "failed to apply BackendTLSPolicy", "backend", backend.Name)
+	}
+
+	return originRequest
+}
+
+// applyBackendTLSPolicy resolves the BackendTLSPolicy (if any) targeting
+// backend's Service and overlays its trust settings onto originRequest.
+// Resolution failures (a missing ConfigMap, an unreadable CA bundle) are
+// recorded on the policy's Accepted condition rather than failing route
+// reconciliation, the same best-effort treatment syncGatewayListenerStatus
+// gives Gateway status updates.
+func (r *HTTPRouteReconciler) applyBackendTLSPolicy(ctx context.Context, originRequest *OriginRequestConfig, route *gwapiv1.HTTPRoute, backend gwapiv1.BackendRef) error {
+	namespace := backendNamespace(route, backend)
+
+	var policies gwapiv1a3.BackendTLSPolicyList
+	if err := r.List(ctx, &policies, client.InNamespace(namespace), client.MatchingFields{backendTLSPolicyTargetIndex: namespace + "/" + string(backend.Name)}); err != nil {
+		return fmt.Errorf("failed to list BackendTLSPolicy for %s/%s: %w", namespace, backend.Name, err)
+	}
+	if len(policies.Items) == 0 {
+		return nil
+	}
+
+	policy := &policies.Items[0]
+
+	bundle, err := r.resolveCABundle(ctx, policy)
+	if err != nil {
+		r.setBackendTLSPolicyAccepted(ctx, policy, route, metav1.ConditionFalse, "InvalidCACertificateRef", err.Error())
+		return err
+	}
+
+	tunnel, err := r.findTunnelForRoute(ctx, route.Namespace, route.Spec.ParentRefs)
+	if err != nil {
+		r.setBackendTLSPolicyAccepted(ctx, policy, route, metav1.ConditionFalse, "NoTunnel", err.Error())
+		return err
+	}
+
+	if len(bundle) > 0 {
+		filename := policy.Namespace + "-" + policy.Name + ".pem"
+		if err := r.ensureCABundleSecret(ctx, tunnel, filename, bundle); err != nil {
+			r.setBackendTLSPolicyAccepted(ctx, policy, route, metav1.ConditionFalse, "SecretSyncFailed", err.Error())
+			return err
+		}
+		originRequest.CAPool = cloudflared.CABundleMountPath + "/" + filename
+	}
+
+	if policy.Spec.Validation.Hostname != "" {
+		originRequest.OriginServerName = string(policy.Spec.Validation.Hostname)
+	}
+
+	r.setBackendTLSPolicyAccepted(ctx, policy, route, metav1.ConditionTrue, "Accepted",
+		fmt.Sprintf("resolved by HTTPRoute %s/%s", route.Namespace, route.Name))
+	return nil
+}
+
+// resolveCABundle fetches and concatenates the PEM data referenced by
+// policy's Validation.CACertificateRefs. Each ref is a ConfigMap in the
+// policy's namespace carrying the CA certificate under the conventional
+// "ca.crt" key.
+func (r *HTTPRouteReconciler) resolveCABundle(ctx context.Context, policy *gwapiv1a3.BackendTLSPolicy) ([]byte, error) {
+	var bundle []byte
+	for _, ref := range policy.Spec.Validation.CACertificateRefs {
+		if ref.Kind != "" && ref.Kind != "ConfigMap" {
+			continue
+		}
+
+		var cm corev1.ConfigMap
+		if err := r.Get(ctx, types.NamespacedName{Name: string(ref.Name), Namespace: policy.Namespace}, &cm); err != nil {
+			return nil, fmt.Errorf("configmap %s/%s: %w", policy.Namespace, ref.Name, err)
+		}
+
+		pem, ok := cm.Data["ca.crt"]
+		if !ok {
+			return nil, fmt.Errorf("configmap %s/%s has no ca.crt key", policy.Namespace, ref.Name)
+		}
+		bundle = append(bundle, []byte(pem)...)
+	}
+	return bundle, nil
+}
+
+// ensureCABundleSecret merges filename/data into the tunnel's CA bundle
+// Secret, creating it if necessary. Multiple BackendTLSPolicies share one
+// Secret, each keyed by its own filename, so the cloudflared Deployment only
+// ever mounts a single volume.
+func (r *HTTPRouteReconciler) ensureCABundleSecret(ctx context.Context, tunnel *cfgatev1alpha1.CloudflareTunnel, filename string, data []byte) error {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+		Name:      cloudflared.CABundleSecretName(tunnel.Name),
+		Namespace: tunnel.Namespace,
+	}}
+
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		if secret.Data == nil {
+			secret.Data = map[string][]byte{}
+		}
+		secret.Data[filename] = data
+		secret.Labels = cloudflared.Labels(tunnel.Name)
+		return controllerutil.SetControllerReference(tunnel, secret, r.Scheme)
+	}); err != nil {
+		return fmt.Errorf("failed to reconcile CA bundle secret %s/%s: %w", tunnel.Namespace, secret.Name, err)
+	}
+
+	return nil
+}
+
+// setBackendTLSPolicyAccepted records an Accepted condition on policy,
+// scoped to the HTTPRoute ancestor that triggered resolution, mirroring the
+// Gateway API policy status convention of one ancestor entry per route that
+// attaches the policy's target. Best-effort: a failed status update is
+// logged here and never escalated into a route reconciliation failure.
+func (r *HTTPRouteReconciler) setBackendTLSPolicyAccepted(ctx context.Context, policy *gwapiv1a3.BackendTLSPolicy, route *gwapiv1.HTTPRoute, status metav1.ConditionStatus, reason, message string) {
+	log := log.FromContext(ctx)
+
+	group := gwapiv1.Group("gateway.networking.k8s.io")
+	kind := gwapiv1.Kind("HTTPRoute")
+	namespace := gwapiv1.Namespace(route.Namespace)
+	ancestorRef := gwapiv1a2.ParentReference{
+		Group:     &group,
+		Kind:      &kind,
+		Namespace: &namespace,
+		Name:      gwapiv1a2.ObjectName(route.Name),
+	}
+
+	condition := metav1.Condition{
+		Type:               "Accepted",
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: policy.Generation,
+	}
+
+	for i, ancestor := range policy.Status.Ancestors {
+		if ancestor.AncestorRef.Name == ancestorRef.Name &&
+			ancestor.AncestorRef.Namespace != nil && *ancestor.AncestorRef.Namespace == *ancestorRef.Namespace {
+			policy.Status.Ancestors[i].Conditions = []metav1.Condition{condition}
+			if err := r.Status().Update(ctx, policy); err != nil {
+				log.Error(err, "failed to update BackendTLSPolicy status", "policy", policy.Name)
+			}
+			return
+		}
+	}
+
+	policy.Status.Ancestors = append(policy.Status.Ancestors, gwapiv1a2.PolicyAncestorStatus{
+		AncestorRef:    ancestorRef,
+		ControllerName: GatewayControllerName,
+		Conditions:     []metav1.Condition{condition},
+	})
+	if err := r.Status().Update(ctx, policy); err != nil {
+		log.Error(err, "failed to update BackendTLSPolicy status", "policy", policy.Name)
+	}
+}
+
+// resolveRuleService returns the origin service URL for a rule's BackendRefs.
+// A single backend is addressed directly. Multiple backends are fronted by a
+// synthetic headless Service (see ensureWeightedBackendService) whose
+// Endpoints addresses replicate each backend's ClusterIP proportionally to
+// its normalized weight, so cloudflared's single "service" field still
+// distributes requests proportionally across them.
+func (r *HTTPRouteReconciler) resolveRuleService(ctx context.Context, route *gwapiv1.HTTPRoute, ruleIndex int, backends []gwapiv1.HTTPBackendRef) (string, error) {
+	if len(backends) == 1 {
+		backend := backends[0].BackendRef
+		permitted, err := r.backendRefPermitted(ctx, route, backend)
+		if err != nil {
+			return "", err
+		}
+		if !permitted {
+			return "", fmt.Errorf("rule %d: backend %s/%s not permitted by any ReferenceGrant", ruleIndex, backendNamespace(route, backend), backend.Name)
+		}
+		return backendServiceURL(route, backend), nil
+	}
+
+	weights := make([]int32, len(backends))
+	for i, b := range backends {
+		weights[i] = backendWeight(b)
+	}
+	weights = normalizeWeights(weights)
+
+	// port is pinned to whichever backend resolves first; the synthetic
+	// Service below exposes exactly one port, so every replicated address
+	// in it is dialed on that same port. A backend resolving to a
+	// different port can't be folded in silently (that would wire some
+	// addresses to the wrong origin port), so it's a hard error instead.
+	port := int32(-1)
+	var addresses []corev1.EndpointAddress
+	for i, b := range backends {
+		if weights[i] <= 0 {
+			continue // weight 0: drain, accepted but never selected
+		}
+
+		permitted, err := r.backendRefPermitted(ctx, route, b.BackendRef)
+		if err != nil {
+			return "", err
+		}
+		if !permitted {
+			continue // already reported by resolveBackends as RefNotPermitted
+		}
+
+		namespace := backendNamespace(route, b.BackendRef)
+
+		var svc corev1.Service
+		if err := r.Get(ctx, types.NamespacedName{Name: string(b.Name), Namespace: namespace}, &svc); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue // already reported by resolveBackends
+			}
+			return "", fmt.Errorf("failed to get service %s/%s: %w", namespace, b.Name, err)
+		}
+		if svc.Spec.ClusterIP == "" || svc.Spec.ClusterIP == corev1.ClusterIPNone {
+			continue
+		}
+
+		backendPort := int32(80)
+		if b.Port != nil {
+			backendPort = int32(*b.Port)
+		}
+		switch {
+		case port < 0:
+			port = backendPort
+		case backendPort != port:
+			return "", fmt.Errorf("rule %d: backend %s/%s targets port %d, but other backends in this rule target port %d; weighted backends within one rule must share a single port", ruleIndex, namespace, b.Name, backendPort, port)
+		}
+
+		for n := int32(0); n < weights[i]; n++ {
+			addresses = append(addresses, corev1.EndpointAddress{IP: svc.Spec.ClusterIP})
+		}
+	}
+
+	if len(addresses) == 0 {
+		return "", fmt.Errorf("rule %d: all backends are drained, unresolved, or not permitted", ruleIndex)
+	}
+
+	lbName := fmt.Sprintf("%s-rule%d-lb", route.Name, ruleIndex)
+	if err := r.ensureWeightedBackendService(ctx, route, lbName, port, addresses); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s.%s.svc.cluster.local:%d", lbName, route.Namespace, port), nil
+}
+
+// backendNamespace resolves a BackendRef's effective namespace.
+func backendNamespace(route *gwapiv1.HTTPRoute, backend gwapiv1.BackendRef) string {
+	if backend.Namespace != nil {
+		return string(*backend.Namespace)
+	}
+	return route.Namespace
+}
+
+// backendRefPermitted reports whether backend may be referenced from route,
+// consulting ReferenceGrant for cross-namespace refs. Same-namespace refs are
+// always permitted.
+func (r *HTTPRouteReconciler) backendRefPermitted(ctx context.Context, route *gwapiv1.HTTPRoute, backend gwapiv1.BackendRef) (bool, error) {
+	namespace := backendNamespace(route, backend)
+	if namespace == route.Namespace {
+		return true, nil
+	}
+
+	return referenceGrantAllows(ctx, r.Client,
+		"gateway.networking.k8s.io", "HTTPRoute", route.Namespace,
+		"", "Service", namespace, string(backend.Name))
+}
+
+// backendServiceURL renders the in-cluster DNS URL for a single BackendRef.
+func backendServiceURL(route *gwapiv1.HTTPRoute, backend gwapiv1.BackendRef) string {
+	namespace := route.Namespace
+	if backend.Namespace != nil {
+		namespace = string(*backend.Namespace)
+	}
+
+	port := int32(80)
+	if backend.Port != nil {
+		port = int32(*backend.Port)
+	}
+
+	return fmt.Sprintf("http://%s.%s.svc.cluster.local:%d", backend.Name, namespace, port)
+}
+
+// backendWeight returns a BackendRef's effective weight, defaulting to 1 per
+// the Gateway API spec (an omitted weight means equal standing with siblings).
+func backendWeight(backend gwapiv1.HTTPBackendRef) int32 {
+	if backend.Weight == nil {
+		return 1
+	}
+	return *backend.Weight
+}
+
+// normalizeWeights reduces a set of weights to the smallest integers with the
+// same ratios (via their GCD), so the rendered TunnelConfig stays stable
+// across reconciles regardless of the raw weight values the route specified.
+// A weight of 0 is preserved as 0 (drain).
+func normalizeWeights(weights []int32) []int32 {
+	gcd := int32(0)
+	for _, w := range weights {
+		if w <= 0 {
+			continue
+		}
+		gcd = gcdInt32(gcd, w)
+	}
+	if gcd == 0 {
+		return weights
+	}
+
+	normalized := make([]int32, len(weights))
+	for i, w := range weights {
+		if w <= 0 {
+			continue
+		}
+		normalized[i] = w / gcd
+	}
+	return normalized
+}
+
+func gcdInt32(a, b int32) int32 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// ensureWeightedBackendService creates or updates the headless Service and
+// Endpoints that front a weighted backend set for one HTTPRoute rule. Both
+// are owned by the HTTPRoute so they are garbage-collected when it is
+// deleted; when the rule's backends change they are updated in place.
+func (r *HTTPRouteReconciler) ensureWeightedBackendService(ctx context.Context, route *gwapiv1.HTTPRoute, name string, port int32, addresses []corev1.EndpointAddress) error {
+	svc := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: route.Namespace}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, svc, func() error {
+		svc.Spec.ClusterIP = corev1.ClusterIPNone
+		svc.Spec.Selector = nil
+		svc.Spec.Ports = []corev1.ServicePort{{Port: port, TargetPort: intstr.FromInt32(port)}}
+		return controllerutil.SetControllerReference(route, svc, r.Scheme)
+	}); err != nil {
+		return fmt.Errorf("failed to reconcile weighted backend service %s/%s: %w", route.Namespace, name, err)
+	}
+
+	endpoints := &corev1.Endpoints{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: route.Namespace}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, endpoints, func() error {
+		endpoints.Subsets = []corev1.EndpointSubset{
+			{
+				Addresses: addresses,
+				Ports:     []corev1.EndpointPort{{Port: port}},
+			},
+		}
+		return controllerutil.SetControllerReference(route, endpoints, r.Scheme)
+	}); err != nil {
+		return fmt.Errorf("failed to reconcile weighted backend endpoints %s/%s: %w", route.Namespace, name, err)
+	}
+
+	return nil
 }
 
 // updateRouteStatus updates the HTTPRoute status for a specific parent.
@@ -369,47 +1122,6 @@ func (r *HTTPRouteReconciler) updateRouteStatus(ctx context.Context, route *gwap
 	return r.Status().Update(ctx, route)
 }
 
-// findTunnelForRoute finds the CloudflareTunnel associated with an HTTPRoute.
-// Traverses parentRef -> Gateway -> tunnel-ref annotation.
-func (r *HTTPRouteReconciler) findTunnelForRoute(ctx context.Context, route *gwapiv1.HTTPRoute) (*cfgatev1alpha1.CloudflareTunnel, error) {
-	for _, parentRef := range route.Spec.ParentRefs {
-		gwNamespace := route.Namespace
-		if parentRef.Namespace != nil {
-			gwNamespace = string(*parentRef.Namespace)
-		}
-
-		var gateway gwapiv1.Gateway
-		if err := r.Get(ctx, types.NamespacedName{
-			Name:      string(parentRef.Name),
-			Namespace: gwNamespace,
-		}, &gateway); err != nil {
-			continue
-		}
-
-		tunnelRef, ok := gateway.Annotations[AnnotationTunnelRef]
-		if !ok {
-			continue
-		}
-
-		parts := strings.Split(tunnelRef, "/")
-		if len(parts) != 2 {
-			continue
-		}
-
-		var tunnel cfgatev1alpha1.CloudflareTunnel
-		if err := r.Get(ctx, types.NamespacedName{
-			Name:      parts[1],
-			Namespace: parts[0],
-		}, &tunnel); err != nil {
-			continue
-		}
-
-		return &tunnel, nil
-	}
-
-	return nil, fmt.Errorf("no tunnel found for route")
-}
-
 // IngressRule represents a cloudflared ingress rule derived from an HTTPRoute.
 type IngressRule struct {
 	// Hostname is the hostname to match.
@@ -426,6 +1138,78 @@ type IngressRule struct {
 
 	// OriginRequest contains per-rule origin configuration.
 	OriginRequest *OriginRequestConfig
+
+	// Match carries predicates (Headers, QueryParams, Method) that
+	// cloudflared's tunnel ingress cannot evaluate itself. Nil when Path
+	// alone fully expresses the match.
+	Match *RouteMatch
+
+	// RequestHeaderModifier mutates headers on the request reaching the
+	// origin, from the HTTPRoute's RequestHeaderModifier filter.
+	RequestHeaderModifier *HeaderModifier
+
+	// ResponseHeaderModifier mutates headers on the response leaving the
+	// origin, from the HTTPRoute's ResponseHeaderModifier filter.
+	ResponseHeaderModifier *HeaderModifier
+
+	// Redirect short-circuits the rule with an HTTP redirect, from the
+	// HTTPRoute's RequestRedirect filter. Nil unless set.
+	Redirect *RedirectConfig
+
+	// Rewrite rewrites the hostname and/or path sent to the origin, from the
+	// HTTPRoute's URLRewrite filter. Nil unless set.
+	Rewrite *RewriteConfig
+}
+
+// RouteMatch captures HTTPRouteMatch predicates that have no cloudflared
+// ingress equivalent: tunnel ingress rules only ever evaluate hostname and
+// path, never headers, query parameters, or the HTTP method.
+type RouteMatch struct {
+	// Method is the required HTTP method, if any.
+	Method string
+
+	// Headers are the required request header matches.
+	Headers []HeaderMatch
+
+	// QueryParams are the required query parameter matches.
+	QueryParams []QueryParamMatch
+}
+
+// HeaderMatch is a single required request header name/value pair.
+type HeaderMatch struct {
+	Name  string
+	Value string
+}
+
+// QueryParamMatch is a single required query parameter name/value pair.
+type QueryParamMatch struct {
+	Name  string
+	Value string
+}
+
+// HeaderModifier captures a RequestHeaderModifier or ResponseHeaderModifier
+// filter: headers to overwrite, headers to append, and headers to strip.
+type HeaderModifier struct {
+	Set    map[string]string
+	Add    map[string]string
+	Remove []string
+}
+
+// RedirectConfig captures a RequestRedirect filter.
+type RedirectConfig struct {
+	Scheme     string
+	Hostname   string
+	Path       string
+	StatusCode int
+}
+
+// RewriteConfig captures a URLRewrite filter. Only one of PathPrefixReplace
+// or PathFullReplace is set, matching the mutually exclusive path modifier
+// types in the Gateway API.
+type RewriteConfig struct {
+	Hostname          string
+	PathPrefixReplace string
+	PathFullReplace   string
 }
 
 // OriginRequestConfig contains origin-specific settings for a rule.
@@ -0,0 +1,151 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+func newHTTPRouteTestScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add client-go types to scheme: %v", err)
+	}
+	if err := gwapiv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add gateway-api v1 types to scheme: %v", err)
+	}
+	if err := gwapiv1b1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add gateway-api v1beta1 types to scheme: %v", err)
+	}
+	return scheme
+}
+
+func portNumber(p int32) *gwapiv1.PortNumber {
+	pn := gwapiv1.PortNumber(p)
+	return &pn
+}
+
+func TestResolveRuleService_SingleBackend(t *testing.T) {
+	scheme := newHTTPRouteTestScheme(t)
+	route := &gwapiv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Name: "route-a", Namespace: "default"}}
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "svc-a", Namespace: "default"},
+		Spec:       corev1.ServiceSpec{ClusterIP: "10.0.0.1"},
+	}
+
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(route, svc).Build()
+	r := &HTTPRouteReconciler{routeCommon: routeCommon{Client: client}, Scheme: scheme}
+
+	backends := []gwapiv1.HTTPBackendRef{
+		{BackendRef: gwapiv1.BackendRef{BackendObjectReference: gwapiv1.BackendObjectReference{Name: "svc-a", Port: portNumber(8080)}}},
+	}
+
+	url, err := r.resolveRuleService(context.Background(), route, 0, backends)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "http://svc-a.default.svc.cluster.local:8080"
+	if url != want {
+		t.Fatalf("resolveRuleService() = %q, want %q", url, want)
+	}
+}
+
+func TestResolveRuleService_SingleBackendNotPermittedCrossNamespace(t *testing.T) {
+	scheme := newHTTPRouteTestScheme(t)
+	route := &gwapiv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Name: "route-a", Namespace: "default"}}
+
+	// No ReferenceGrant in "other" permitting this HTTPRoute's namespace.
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(route).Build()
+	r := &HTTPRouteReconciler{routeCommon: routeCommon{Client: client}, Scheme: scheme}
+
+	otherNS := gwapiv1.Namespace("other")
+	backends := []gwapiv1.HTTPBackendRef{
+		{BackendRef: gwapiv1.BackendRef{BackendObjectReference: gwapiv1.BackendObjectReference{Name: "svc-a", Namespace: &otherNS, Port: portNumber(8080)}}},
+	}
+
+	_, err := r.resolveRuleService(context.Background(), route, 0, backends)
+	if err == nil {
+		t.Fatal("expected an error for a cross-namespace backend with no ReferenceGrant, got nil")
+	}
+	if !strings.Contains(err.Error(), "not permitted by any ReferenceGrant") {
+		t.Fatalf("expected a ReferenceGrant error, got: %v", err)
+	}
+}
+
+func TestResolveRuleService_SingleBackendPermittedByReferenceGrant(t *testing.T) {
+	scheme := newHTTPRouteTestScheme(t)
+	route := &gwapiv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Name: "route-a", Namespace: "default"}}
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "svc-a", Namespace: "other"},
+		Spec:       corev1.ServiceSpec{ClusterIP: "10.0.0.1"},
+	}
+	grantName := gwapiv1b1.ObjectName("svc-a")
+	grant := &gwapiv1b1.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{Name: "allow-default", Namespace: "other"},
+		Spec: gwapiv1b1.ReferenceGrantSpec{
+			From: []gwapiv1b1.ReferenceGrantFrom{
+				{Group: "gateway.networking.k8s.io", Kind: "HTTPRoute", Namespace: gwapiv1b1.Namespace("default")},
+			},
+			To: []gwapiv1b1.ReferenceGrantTo{
+				{Group: "", Kind: "Service", Name: &grantName},
+			},
+		},
+	}
+
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(route, svc, grant).Build()
+	r := &HTTPRouteReconciler{routeCommon: routeCommon{Client: client}, Scheme: scheme}
+
+	otherNS := gwapiv1.Namespace("other")
+	backends := []gwapiv1.HTTPBackendRef{
+		{BackendRef: gwapiv1.BackendRef{BackendObjectReference: gwapiv1.BackendObjectReference{Name: "svc-a", Namespace: &otherNS, Port: portNumber(8080)}}},
+	}
+
+	url, err := r.resolveRuleService(context.Background(), route, 0, backends)
+	if err != nil {
+		t.Fatalf("unexpected error with a matching ReferenceGrant in place: %v", err)
+	}
+	want := "http://svc-a.other.svc.cluster.local:8080"
+	if url != want {
+		t.Fatalf("resolveRuleService() = %q, want %q", url, want)
+	}
+}
+
+func TestResolveRuleService_WeightedBackendsMismatchedPortsError(t *testing.T) {
+	scheme := newHTTPRouteTestScheme(t)
+	route := &gwapiv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Name: "route-a", Namespace: "default"}}
+	svcA := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "svc-a", Namespace: "default"},
+		Spec:       corev1.ServiceSpec{ClusterIP: "10.0.0.1"},
+	}
+	svcB := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "svc-b", Namespace: "default"},
+		Spec:       corev1.ServiceSpec{ClusterIP: "10.0.0.2"},
+	}
+
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(route, svcA, svcB).Build()
+	r := &HTTPRouteReconciler{routeCommon: routeCommon{Client: client}, Scheme: scheme}
+
+	backends := []gwapiv1.HTTPBackendRef{
+		{BackendRef: gwapiv1.BackendRef{BackendObjectReference: gwapiv1.BackendObjectReference{Name: "svc-a", Port: portNumber(8080)}}},
+		{BackendRef: gwapiv1.BackendRef{BackendObjectReference: gwapiv1.BackendObjectReference{Name: "svc-b", Port: portNumber(9090)}}},
+	}
+
+	// Two backends in the same rule, so resolveRuleService takes the
+	// weighted path - mismatched ports must be a hard error, not silently
+	// routed to whichever backend resolved first (the chunk3-1 regression).
+	_, err := r.resolveRuleService(context.Background(), route, 0, backends)
+	if err == nil {
+		t.Fatal("expected an error for weighted backends targeting different ports, got nil")
+	}
+	if !strings.Contains(err.Error(), "must share a single port") {
+		t.Fatalf("expected a port-mismatch error, got: %v", err)
+	}
+}
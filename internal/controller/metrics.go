@@ -0,0 +1,84 @@
+// Package controller contains the reconciliation logic for cfgate CRDs.
+package controller
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	// dnsSyncSuccessTotal counts reconciles that completed without error,
+	// per CloudflareDNSSync.
+	dnsSyncSuccessTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cfgate_dns_sync_success_total",
+			Help: "Total number of successful CloudflareDNSSync reconciles.",
+		},
+		[]string{"namespace", "name"},
+	)
+
+	// dnsSyncFailureTotal counts reconciles that failed, labeled by the
+	// stage that failed (reason).
+	dnsSyncFailureTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cfgate_dns_sync_failure_total",
+			Help: "Total number of failed CloudflareDNSSync reconciles, by reason.",
+		},
+		[]string{"namespace", "name", "reason"},
+	)
+
+	// dnsRecordOperationsTotal counts individual DNS record mutations applied
+	// to Cloudflare, labeled by operation.
+	dnsRecordOperationsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cfgate_dns_record_operations_total",
+			Help: "Total number of DNS record operations applied to Cloudflare.",
+		},
+		[]string{"op"},
+	)
+
+	// dnsSyncDurationSeconds observes how long syncRecords takes per call.
+	dnsSyncDurationSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "cfgate_dns_sync_duration_seconds",
+			Help:    "Duration of CloudflareDNSSync syncRecords calls, in seconds.",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"namespace", "name"},
+	)
+
+	// dnsSyncAccountFailures counts consecutive reconcile failures per
+	// CloudflareDNSSync object, so alerts can fire on stalled reconciliations.
+	// Reset to zero on the next successful reconcile.
+	dnsSyncAccountFailures = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "cfgate_dns_sync_account_failure_count",
+			Help: "Consecutive reconcile failures for a CloudflareDNSSync, reset on success.",
+		},
+		[]string{"namespace", "name"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		dnsSyncSuccessTotal,
+		dnsSyncFailureTotal,
+		dnsRecordOperationsTotal,
+		dnsSyncDurationSeconds,
+		dnsSyncAccountFailures,
+	)
+}
+
+// recordSyncFailure increments the failure counter/gauge for a DNSSync and
+// records the reason for alerting.
+func recordSyncFailure(namespace, name, reason string) {
+	dnsSyncFailureTotal.WithLabelValues(namespace, name, reason).Inc()
+	dnsSyncAccountFailures.WithLabelValues(namespace, name).Inc()
+}
+
+// recordSyncSuccess increments the success counter and resets the
+// consecutive-failure gauge for a DNSSync.
+func recordSyncSuccess(namespace, name string) {
+	dnsSyncSuccessTotal.WithLabelValues(namespace, name).Inc()
+	dnsSyncAccountFailures.WithLabelValues(namespace, name).Set(0)
+}
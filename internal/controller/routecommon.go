@@ -0,0 +1,419 @@
+// Package controller contains the reconciliation logic for cfgate CRDs.
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	cfgatev1alpha1 "cfgate.io/cfgate/api/v1alpha1"
+)
+
+// routeCommon holds the Gateway-attachment logic shared by every route kind
+// cfgate reconciles (HTTPRoute, GRPCRoute, TCPRoute, TLSRoute): resolving a
+// parentRef against the Gateway and listener it names, and resolving the
+// CloudflareTunnel a Gateway is bound to. Each *RouteReconciler embeds
+// routeCommon instead of client.Client directly, so r.Get/r.List/r.Status
+// keep working via promotion while the attachment logic lives in one place.
+type routeCommon struct {
+	client.Client
+}
+
+// validateParentRef validates that the parent Gateway accepts a route of
+// kind routeKind, named routeNamespace/*, carrying routeHostnames (nil for
+// route kinds with no hostname concept, e.g. TCPRoute). Returns whether the
+// route is accepted, the Reason to report if not, and the hostname subset it
+// was accepted for.
+func (rc *routeCommon) validateParentRef(ctx context.Context, routeNamespace string, routeKind gwapiv1.Kind, routeHostnames []gwapiv1.Hostname, ref gwapiv1.ParentReference) (bool, string, []string, error) {
+	// Get the Gateway
+	gwNamespace := routeNamespace
+	if ref.Namespace != nil {
+		gwNamespace = string(*ref.Namespace)
+	}
+
+	// Cross-namespace attachment requires a ReferenceGrant in the Gateway's
+	// namespace permitting this route kind from the route's namespace.
+	if gwNamespace != routeNamespace {
+		allowed, err := referenceGrantAllows(ctx, rc.Client,
+			"gateway.networking.k8s.io", string(routeKind), routeNamespace,
+			"gateway.networking.k8s.io", "Gateway", gwNamespace, string(ref.Name))
+		if err != nil {
+			return false, "Error", nil, fmt.Errorf("checking ReferenceGrant: %w", err)
+		}
+		if !allowed {
+			return false, "NotAllowedByListeners", nil, fmt.Errorf(
+				"cross-namespace parentRef to gateway %s/%s not permitted by any ReferenceGrant", gwNamespace, ref.Name)
+		}
+	}
+
+	var gateway gwapiv1.Gateway
+	if err := rc.Get(ctx, types.NamespacedName{
+		Name:      string(ref.Name),
+		Namespace: gwNamespace,
+	}, &gateway); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, "NoMatchingParent", nil, fmt.Errorf("gateway %s/%s not found", gwNamespace, ref.Name)
+		}
+		return false, "Error", nil, err
+	}
+
+	// Check if Gateway's GatewayClass is ours
+	var gc gwapiv1.GatewayClass
+	if err := rc.Get(ctx, types.NamespacedName{Name: string(gateway.Spec.GatewayClassName)}, &gc); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, "NoMatchingParent", nil, fmt.Errorf("gateway class %s not found", gateway.Spec.GatewayClassName)
+		}
+		return false, "Error", nil, err
+	}
+
+	if string(gc.Spec.ControllerName) != GatewayControllerName {
+		// Not our Gateway, skip
+		return false, "NoMatchingParent", nil, fmt.Errorf("gateway is not managed by cfgate")
+	}
+
+	// Check if Gateway has tunnel reference
+	if _, ok := gateway.Annotations[AnnotationTunnelRef]; !ok {
+		return false, "NoTunnelRef", nil, fmt.Errorf("gateway has no tunnel reference")
+	}
+
+	// Candidate listeners: just the named one if SectionName is set,
+	// otherwise every listener the Gateway exposes.
+	var candidates []gwapiv1.Listener
+	if ref.SectionName != nil {
+		for _, listener := range gateway.Spec.Listeners {
+			if listener.Name == *ref.SectionName {
+				candidates = append(candidates, listener)
+				break
+			}
+		}
+		if len(candidates) == 0 {
+			return false, "NoMatchingParent", nil, fmt.Errorf("listener %s not found", *ref.SectionName)
+		}
+	} else {
+		candidates = gateway.Spec.Listeners
+	}
+
+	// A route attaches if it passes protocol, kind, namespace, and hostname
+	// checks against at least one candidate listener. Track the most
+	// specific failure reason seen across all candidates, in priority
+	// order, for the case where none match.
+	var acceptedHostnames []string
+	reason := "NoMatchingParent"
+	reasonErr := fmt.Errorf("no listener on gateway %s/%s accepts this route", gateway.Namespace, gateway.Name)
+
+	for _, listener := range candidates {
+		if !protocolAllowsRouteKind(listener.Protocol, routeKind) {
+			if reason == "NoMatchingParent" {
+				reason, reasonErr = "NotAllowedByListeners", fmt.Errorf("listener %s protocol %s does not carry %s", listener.Name, listener.Protocol, routeKind)
+			}
+			continue
+		}
+
+		if !listenerAllowsKind(listener, routeKind) {
+			if reason == "NoMatchingParent" {
+				reason, reasonErr = "NotAllowedByListeners", fmt.Errorf("listener %s does not allow %s", listener.Name, routeKind)
+			}
+			continue
+		}
+
+		allowedNS, err := rc.listenerAllowsNamespace(ctx, listener, gateway.Namespace, routeNamespace)
+		if err != nil {
+			return false, "Error", nil, err
+		}
+		if !allowedNS {
+			if reason == "NoMatchingParent" {
+				reason, reasonErr = "NotAllowedByListeners", fmt.Errorf("listener %s does not allow routes from namespace %s", listener.Name, routeNamespace)
+			}
+			continue
+		}
+
+		hostnames, ok := intersectHostnames(routeHostnames, listener.Hostname)
+		if !ok {
+			if reason == "NoMatchingParent" || reason == "NotAllowedByListeners" {
+				reason, reasonErr = "NoMatchingListenerHostname", fmt.Errorf("route hostnames do not intersect listener %s hostname", listener.Name)
+			}
+			continue
+		}
+
+		acceptedHostnames = appendUniqueStrings(acceptedHostnames, hostnames)
+	}
+
+	if len(acceptedHostnames) == 0 {
+		return false, reason, nil, reasonErr
+	}
+
+	return true, "", acceptedHostnames, nil
+}
+
+// protocolAllowsRouteKind reports whether a listener speaking protocol may
+// ever carry routeKind, independent of its AllowedRoutes configuration.
+// TCPRoute and TLSRoute are L4 route kinds tied to exactly one listener
+// protocol each; HTTPRoute and GRPCRoute both ride HTTP/HTTPS listeners, so
+// the finer-grained choice between them is left to listenerAllowsKind.
+func protocolAllowsRouteKind(protocol gwapiv1.ProtocolType, routeKind gwapiv1.Kind) bool {
+	switch routeKind {
+	case "TCPRoute":
+		return protocol == gwapiv1.TCPProtocolType
+	case "TLSRoute":
+		return protocol == gwapiv1.TLSProtocolType
+	default:
+		return true
+	}
+}
+
+// listenerAllowsKind reports whether listener's AllowedRoutes permits the
+// given route kind. A listener with no AllowedRoutes.Kinds set falls back to
+// its protocol's default: HTTPRoute for HTTP/HTTPS, TCPRoute for TCP, and
+// TLSRoute for TLS. GRPCRoute has no default for any protocol and must
+// always be named explicitly in AllowedRoutes.Kinds.
+func listenerAllowsKind(listener gwapiv1.Listener, kind gwapiv1.Kind) bool {
+	if listener.AllowedRoutes == nil || len(listener.AllowedRoutes.Kinds) == 0 {
+		return kind == defaultRouteKindForProtocol(listener.Protocol)
+	}
+	for _, k := range listener.AllowedRoutes.Kinds {
+		if k.Kind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// defaultRouteKindForProtocol returns the route kind a listener accepts when
+// it sets no explicit AllowedRoutes.Kinds.
+func defaultRouteKindForProtocol(protocol gwapiv1.ProtocolType) gwapiv1.Kind {
+	switch protocol {
+	case gwapiv1.TCPProtocolType:
+		return "TCPRoute"
+	case gwapiv1.TLSProtocolType:
+		return "TLSRoute"
+	case gwapiv1.UDPProtocolType:
+		return "UDPRoute"
+	default: // HTTPProtocolType, HTTPSProtocolType
+		return "HTTPRoute"
+	}
+}
+
+// listenerAllowsNamespace reports whether listener's AllowedRoutes permits
+// routes from routeNamespace, resolving a NamespacesFromSelector selector
+// against the route's namespace labels when configured. The default, absent
+// any AllowedRoutes.Namespaces, is NamespacesFromSame.
+func (rc *routeCommon) listenerAllowsNamespace(ctx context.Context, listener gwapiv1.Listener, gatewayNamespace, routeNamespace string) (bool, error) {
+	if listener.AllowedRoutes == nil || listener.AllowedRoutes.Namespaces == nil {
+		return routeNamespace == gatewayNamespace, nil
+	}
+
+	from := gwapiv1.NamespacesFromSame
+	if listener.AllowedRoutes.Namespaces.From != nil {
+		from = *listener.AllowedRoutes.Namespaces.From
+	}
+
+	switch from {
+	case gwapiv1.NamespacesFromAll:
+		return true, nil
+	case gwapiv1.NamespacesFromSelector:
+		selector := listener.AllowedRoutes.Namespaces.Selector
+		if selector == nil {
+			return false, nil
+		}
+		labelSelector, err := metav1.LabelSelectorAsSelector(selector)
+		if err != nil {
+			return false, fmt.Errorf("invalid namespace selector on listener: %w", err)
+		}
+		var namespace corev1.Namespace
+		if err := rc.Get(ctx, types.NamespacedName{Name: routeNamespace}, &namespace); err != nil {
+			return false, fmt.Errorf("failed to get namespace %s: %w", routeNamespace, err)
+		}
+		return labelSelector.Matches(labels.Set(namespace.Labels)), nil
+	default: // NamespacesFromSame
+		return routeNamespace == gatewayNamespace, nil
+	}
+}
+
+// intersectHostnames computes the Gateway API hostname intersection between
+// a route's hostnames and a single listener's Hostname. An absent hostname
+// on either side matches anything on that side. Returns the concrete
+// hostnames the route was accepted for and false if the two sets don't
+// overlap at all.
+func intersectHostnames(routeHostnames []gwapiv1.Hostname, listenerHostname *gwapiv1.Hostname) ([]string, bool) {
+	if listenerHostname == nil || *listenerHostname == "" {
+		if len(routeHostnames) == 0 {
+			return []string{"*"}, true
+		}
+		out := make([]string, len(routeHostnames))
+		for i, h := range routeHostnames {
+			out[i] = string(h)
+		}
+		return out, true
+	}
+
+	lh := string(*listenerHostname)
+	if len(routeHostnames) == 0 {
+		return []string{lh}, true
+	}
+
+	var out []string
+	for _, h := range routeHostnames {
+		if narrowed, ok := hostnameIntersect(string(h), lh); ok {
+			out = append(out, narrowed)
+		}
+	}
+	return out, len(out) > 0
+}
+
+// hostnameIntersect returns the more specific of two hostnames if they
+// overlap. A wildcard hostname (e.g. "*.example.com") matches exactly one
+// DNS label, so "*.example.com" matches "foo.example.com" but neither
+// "example.com" nor "a.foo.example.com".
+func hostnameIntersect(a, b string) (string, bool) {
+	if a == b {
+		return a, true
+	}
+
+	aWild := strings.HasPrefix(a, "*.")
+	bWild := strings.HasPrefix(b, "*.")
+	switch {
+	case aWild && !bWild:
+		if hostnameMatchesWildcard(a, b) {
+			return b, true
+		}
+	case bWild && !aWild:
+		if hostnameMatchesWildcard(b, a) {
+			return a, true
+		}
+	}
+	return "", false
+}
+
+// hostnameMatchesWildcard reports whether host matches a single-label
+// wildcard pattern like "*.example.com".
+func hostnameMatchesWildcard(pattern, host string) bool {
+	patternLabels := strings.Split(pattern, ".")
+	hostLabels := strings.Split(host, ".")
+	if len(patternLabels) != len(hostLabels) {
+		return false
+	}
+	for i := 1; i < len(patternLabels); i++ {
+		if patternLabels[i] != hostLabels[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// appendUniqueStrings appends the members of add to base that aren't
+// already present, preserving base's existing order.
+func appendUniqueStrings(base []string, add []string) []string {
+	for _, v := range add {
+		found := false
+		for _, existing := range base {
+			if existing == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			base = append(base, v)
+		}
+	}
+	return base
+}
+
+// findTunnelForRoute finds the CloudflareTunnel associated with a route,
+// traversing parentRef -> Gateway -> tunnel-ref annotation.
+func (rc *routeCommon) findTunnelForRoute(ctx context.Context, routeNamespace string, parentRefs []gwapiv1.ParentReference) (*cfgatev1alpha1.CloudflareTunnel, error) {
+	for _, parentRef := range parentRefs {
+		gwNamespace := routeNamespace
+		if parentRef.Namespace != nil {
+			gwNamespace = string(*parentRef.Namespace)
+		}
+
+		var gateway gwapiv1.Gateway
+		if err := rc.Get(ctx, types.NamespacedName{
+			Name:      string(parentRef.Name),
+			Namespace: gwNamespace,
+		}, &gateway); err != nil {
+			continue
+		}
+
+		tunnelRef, ok := gateway.Annotations[AnnotationTunnelRef]
+		if !ok {
+			continue
+		}
+
+		parts := strings.Split(tunnelRef, "/")
+		if len(parts) != 2 {
+			continue
+		}
+
+		var tunnel cfgatev1alpha1.CloudflareTunnel
+		if err := rc.Get(ctx, types.NamespacedName{
+			Name:      parts[1],
+			Namespace: parts[0],
+		}, &tunnel); err != nil {
+			continue
+		}
+
+		return &tunnel, nil
+	}
+
+	return nil, fmt.Errorf("no tunnel found for route")
+}
+
+// referenceGrantAllows reports whether some ReferenceGrant in toNamespace
+// permits a fromGroup/fromKind resource in fromNamespace to reference a
+// toGroup/toKind resource (optionally narrowed to toName) in toNamespace.
+// Core API group resources such as Service use fromGroup/toGroup "".
+func referenceGrantAllows(ctx context.Context, c client.Client, fromGroup, fromKind, fromNamespace, toGroup, toKind, toNamespace, toName string) (bool, error) {
+	var grants gwapiv1b1.ReferenceGrantList
+	if err := c.List(ctx, &grants, client.InNamespace(toNamespace)); err != nil {
+		return false, err
+	}
+
+	for _, grant := range grants.Items {
+		fromMatches := false
+		for _, from := range grant.Spec.From {
+			if string(from.Group) == fromGroup && string(from.Kind) == fromKind && string(from.Namespace) == fromNamespace {
+				fromMatches = true
+				break
+			}
+		}
+		if !fromMatches {
+			continue
+		}
+
+		for _, to := range grant.Spec.To {
+			if string(to.Group) != toGroup || string(to.Kind) != toKind {
+				continue
+			}
+			if to.Name != nil && string(*to.Name) != toName {
+				continue
+			}
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// parentRefTargets reports whether parentRef, evaluated from a route in
+// routeNamespace, targets gatewayName's listener. An unset SectionName
+// matches every listener.
+func parentRefTargets(routeNamespace string, parentRef gwapiv1.ParentReference, gatewayName types.NamespacedName, listenerName gwapiv1.SectionName) bool {
+	ns := routeNamespace
+	if parentRef.Namespace != nil {
+		ns = string(*parentRef.Namespace)
+	}
+	if ns != gatewayName.Namespace || string(parentRef.Name) != gatewayName.Name {
+		return false
+	}
+	return parentRef.SectionName == nil || *parentRef.SectionName == listenerName
+}
@@ -0,0 +1,245 @@
+// Package controller contains the reconciliation logic for cfgate CRDs.
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// tcpRouteGatewayIndex indexes TCPRoutes by the "namespace/name" of each
+// Gateway their parentRefs point at, mirroring httpRouteGatewayIndex.
+const tcpRouteGatewayIndex = "spec.parentRefs.gateway.tcproute"
+
+// TCPRouteReconciler reconciles TCPRoute resources. TCPRoute has no
+// hostname concept, so attachment turns entirely on the listener's protocol
+// (must be TCP, enforced by routeCommon's protocolAllowsRouteKind) and on
+// port conflicts between TCPRoutes sharing the same listener: cloudflared's
+// ingress has one service per tunnel, not per listener, so only the oldest
+// TCPRoute attached to a given listener may actually claim it.
+type TCPRouteReconciler struct {
+	routeCommon
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=tcproutes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=tcproutes/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways,verbs=get;list;watch
+
+// Reconcile handles the reconciliation loop for TCPRoute resources.
+func (r *TCPRouteReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+	log.Info("reconciling TCPRoute", "name", req.Name, "namespace", req.Namespace)
+
+	var route gwapiv1a2.TCPRoute
+	if err := r.Get(ctx, req.NamespacedName, &route); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("TCPRoute not found, ignoring")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("failed to get TCPRoute: %w", err)
+	}
+
+	var parentStatuses []gwapiv1.RouteParentStatus
+	for _, parentRef := range route.Spec.ParentRefs {
+		accepted, reason, _, err := r.validateParentRef(ctx, route.Namespace, "TCPRoute", nil, parentRef)
+		if accepted {
+			conflicted, conflictErr := r.conflictsWithOlderTCPRoute(ctx, &route, parentRef)
+			if conflicted {
+				accepted, reason, err = false, "Conflicted", fmt.Errorf("listener port already claimed by an older TCPRoute")
+			} else if conflictErr != nil {
+				log.Error(conflictErr, "failed to check TCPRoute port conflicts")
+			}
+		}
+		if err != nil && !accepted {
+			log.Error(err, "failed to validate parent ref")
+		}
+
+		parentNS := gwapiv1.Namespace(route.Namespace)
+		if parentRef.Namespace != nil {
+			parentNS = *parentRef.Namespace
+		}
+
+		status := gwapiv1.RouteParentStatus{
+			ParentRef: gwapiv1.ParentReference{
+				Group:       parentRef.Group,
+				Kind:        parentRef.Kind,
+				Namespace:   &parentNS,
+				Name:        parentRef.Name,
+				SectionName: parentRef.SectionName,
+			},
+			ControllerName: GatewayControllerName,
+			Conditions: []metav1.Condition{
+				{
+					Type:               string(gwapiv1.RouteConditionAccepted),
+					Status:             metav1.ConditionTrue,
+					Reason:             "Accepted",
+					Message:            "Route accepted by Gateway",
+					LastTransitionTime: metav1.Now(),
+					ObservedGeneration: route.Generation,
+				},
+			},
+		}
+
+		if !accepted {
+			status.Conditions[0].Status = metav1.ConditionFalse
+			status.Conditions[0].Reason = reason
+			status.Conditions[0].Message = err.Error()
+		}
+
+		parentStatuses = append(parentStatuses, status)
+	}
+
+	route.Status.Parents = parentStatuses
+	if err := r.Status().Update(ctx, &route); err != nil {
+		log.Error(err, "failed to update route status")
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
+	r.Recorder.Event(&route, corev1.EventTypeNormal, "Reconciled", "TCPRoute reconciled successfully")
+	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *TCPRouteReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &gwapiv1a2.TCPRoute{}, tcpRouteGatewayIndex, func(obj client.Object) []string {
+		route := obj.(*gwapiv1a2.TCPRoute)
+		keys := make([]string, 0, len(route.Spec.ParentRefs))
+		for _, p := range route.Spec.ParentRefs {
+			ns := route.Namespace
+			if p.Namespace != nil {
+				ns = string(*p.Namespace)
+			}
+			keys = append(keys, ns+"/"+string(p.Name))
+		}
+		return keys
+	}); err != nil {
+		return fmt.Errorf("failed to index TCPRoute by gateway: %w", err)
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&gwapiv1a2.TCPRoute{}).
+		Complete(r)
+}
+
+// buildIngressRules builds the cloudflared-bound IngressRules for a TCPRoute
+// rule. TCPRoute has no hostname or path to match on, so each rule becomes a
+// single catch-all-for-this-listener IngressRule carrying a tcp:// service
+// URL.
+func (r *TCPRouteReconciler) buildIngressRules(ctx context.Context, route *gwapiv1a2.TCPRoute, rule gwapiv1a2.TCPRouteRule) (*IngressRule, error) {
+	if len(rule.BackendRefs) == 0 {
+		return nil, fmt.Errorf("no backends specified")
+	}
+
+	backend := rule.BackendRefs[0].BackendRef
+	permitted, err := r.backendRefPermitted(ctx, route, backend)
+	if err != nil {
+		return nil, err
+	}
+	if !permitted {
+		return nil, fmt.Errorf("backend %s/%s not permitted by any ReferenceGrant", backendTCPRouteNamespace(route, backend), backend.Name)
+	}
+
+	return &IngressRule{Service: tcpServiceURL(route, backend)}, nil
+}
+
+// backendTCPRouteNamespace resolves a BackendRef's effective namespace for a TCPRoute.
+func backendTCPRouteNamespace(route *gwapiv1a2.TCPRoute, backend gwapiv1.BackendRef) string {
+	if backend.Namespace != nil {
+		return string(*backend.Namespace)
+	}
+	return route.Namespace
+}
+
+// backendRefPermitted reports whether backend may be referenced from route,
+// consulting ReferenceGrant for cross-namespace refs.
+func (r *TCPRouteReconciler) backendRefPermitted(ctx context.Context, route *gwapiv1a2.TCPRoute, backend gwapiv1.BackendRef) (bool, error) {
+	namespace := backendTCPRouteNamespace(route, backend)
+	if namespace == route.Namespace {
+		return true, nil
+	}
+
+	return referenceGrantAllows(ctx, r.Client,
+		"gateway.networking.k8s.io", "TCPRoute", route.Namespace,
+		"", "Service", namespace, string(backend.Name))
+}
+
+// tcpServiceURL renders the in-cluster origin URL for a TCPRoute backend.
+func tcpServiceURL(route *gwapiv1a2.TCPRoute, backend gwapiv1.BackendRef) string {
+	namespace := backendTCPRouteNamespace(route, backend)
+
+	port := int32(0)
+	if backend.Port != nil {
+		port = int32(*backend.Port)
+	}
+
+	return fmt.Sprintf("tcp://%s.%s.svc.cluster.local:%d", backend.Name, namespace, port)
+}
+
+// conflictsWithOlderTCPRoute reports whether some other TCPRoute attached to
+// the same gateway and listener as parentRef was created earlier than route
+// (ties broken by namespace/name), per the Conflicted tiebreaker Consul and
+// Traefik both use for overlapping L4 listeners.
+func (r *TCPRouteReconciler) conflictsWithOlderTCPRoute(ctx context.Context, route *gwapiv1a2.TCPRoute, parentRef gwapiv1.ParentReference) (bool, error) {
+	gwNamespace := route.Namespace
+	if parentRef.Namespace != nil {
+		gwNamespace = string(*parentRef.Namespace)
+	}
+	gatewayName := types.NamespacedName{Name: string(parentRef.Name), Namespace: gwNamespace}
+
+	var candidates gwapiv1a2.TCPRouteList
+	if err := r.List(ctx, &candidates, client.MatchingFields{tcpRouteGatewayIndex: gatewayName.Namespace + "/" + gatewayName.Name}); err != nil {
+		return false, fmt.Errorf("failed to list TCPRoutes for gateway %s: %w", gatewayName, err)
+	}
+
+	for _, other := range candidates.Items {
+		if other.Namespace == route.Namespace && other.Name == route.Name {
+			continue
+		}
+		for _, otherRef := range other.Spec.ParentRefs {
+			if !parentRefTargets(other.Namespace, otherRef, gatewayName, sectionNameOf(parentRef)) {
+				continue
+			}
+			if olderTCPRoute(other, *route) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// sectionNameOf returns parentRef's SectionName, or "" (matching every
+// listener) when unset.
+func sectionNameOf(parentRef gwapiv1.ParentReference) gwapiv1.SectionName {
+	if parentRef.SectionName == nil {
+		return ""
+	}
+	return *parentRef.SectionName
+}
+
+// olderTCPRoute reports whether a was created before b, breaking exact ties
+// by namespace/name so the comparison is a strict, consistent ordering.
+func olderTCPRoute(a, b gwapiv1a2.TCPRoute) bool {
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+	if a.Namespace != b.Namespace {
+		return a.Namespace < b.Namespace
+	}
+	return a.Name < b.Name
+}
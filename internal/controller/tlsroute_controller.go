@@ -0,0 +1,195 @@
+// Package controller contains the reconciliation logic for cfgate CRDs.
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// tlsRouteGatewayIndex indexes TLSRoutes by the "namespace/name" of each
+// Gateway their parentRefs point at, mirroring httpRouteGatewayIndex.
+const tlsRouteGatewayIndex = "spec.parentRefs.gateway.tlsroute"
+
+// TLSRouteReconciler reconciles TLSRoute resources, attaching to TLS
+// listeners for SNI-passthrough backends that terminate their own TLS.
+type TLSRouteReconciler struct {
+	routeCommon
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=tlsroutes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=tlsroutes/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways,verbs=get;list;watch
+
+// Reconcile handles the reconciliation loop for TLSRoute resources.
+func (r *TLSRouteReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+	log.Info("reconciling TLSRoute", "name", req.Name, "namespace", req.Namespace)
+
+	var route gwapiv1a2.TLSRoute
+	if err := r.Get(ctx, req.NamespacedName, &route); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("TLSRoute not found, ignoring")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("failed to get TLSRoute: %w", err)
+	}
+
+	var parentStatuses []gwapiv1.RouteParentStatus
+	for _, parentRef := range route.Spec.ParentRefs {
+		accepted, reason, _, err := r.validateParentRef(ctx, route.Namespace, "TLSRoute", route.Spec.Hostnames, parentRef)
+		if err != nil {
+			log.Error(err, "failed to validate parent ref")
+		}
+
+		parentNS := gwapiv1.Namespace(route.Namespace)
+		if parentRef.Namespace != nil {
+			parentNS = *parentRef.Namespace
+		}
+
+		status := gwapiv1.RouteParentStatus{
+			ParentRef: gwapiv1.ParentReference{
+				Group:       parentRef.Group,
+				Kind:        parentRef.Kind,
+				Namespace:   &parentNS,
+				Name:        parentRef.Name,
+				SectionName: parentRef.SectionName,
+			},
+			ControllerName: GatewayControllerName,
+			Conditions: []metav1.Condition{
+				{
+					Type:               string(gwapiv1.RouteConditionAccepted),
+					Status:             metav1.ConditionTrue,
+					Reason:             "Accepted",
+					Message:            "Route accepted by Gateway",
+					LastTransitionTime: metav1.Now(),
+					ObservedGeneration: route.Generation,
+				},
+			},
+		}
+
+		if !accepted {
+			status.Conditions[0].Status = metav1.ConditionFalse
+			status.Conditions[0].Reason = reason
+			status.Conditions[0].Message = err.Error()
+		}
+
+		parentStatuses = append(parentStatuses, status)
+	}
+
+	route.Status.Parents = parentStatuses
+	if err := r.Status().Update(ctx, &route); err != nil {
+		log.Error(err, "failed to update route status")
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
+	r.Recorder.Event(&route, corev1.EventTypeNormal, "Reconciled", "TLSRoute reconciled successfully")
+	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *TLSRouteReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &gwapiv1a2.TLSRoute{}, tlsRouteGatewayIndex, func(obj client.Object) []string {
+		route := obj.(*gwapiv1a2.TLSRoute)
+		keys := make([]string, 0, len(route.Spec.ParentRefs))
+		for _, p := range route.Spec.ParentRefs {
+			ns := route.Namespace
+			if p.Namespace != nil {
+				ns = string(*p.Namespace)
+			}
+			keys = append(keys, ns+"/"+string(p.Name))
+		}
+		return keys
+	}); err != nil {
+		return fmt.Errorf("failed to index TLSRoute by gateway: %w", err)
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&gwapiv1a2.TLSRoute{}).
+		Complete(r)
+}
+
+// buildIngressRules builds the cloudflared-bound IngressRules for a TLSRoute
+// rule. TLSRoute backends terminate TLS themselves, so cloudflared passes
+// the connection through by SNI rather than decrypting it: the service URL
+// uses the tls:// scheme and OriginServerName is set for SNI passthrough
+// rather than origin certificate verification.
+func (r *TLSRouteReconciler) buildIngressRules(ctx context.Context, route *gwapiv1a2.TLSRoute, rule gwapiv1a2.TLSRouteRule, acceptedHostnames []string) ([]*IngressRule, error) {
+	if len(rule.BackendRefs) == 0 {
+		return nil, fmt.Errorf("no backends specified")
+	}
+
+	hostnames := acceptedHostnames
+	if len(hostnames) == 0 {
+		hostnames = []string{"*"}
+	}
+
+	backend := rule.BackendRefs[0].BackendRef
+	permitted, err := r.backendRefPermitted(ctx, route, backend)
+	if err != nil {
+		return nil, err
+	}
+	if !permitted {
+		return nil, fmt.Errorf("backend %s/%s not permitted by any ReferenceGrant", backendTLSRouteNamespace(route, backend), backend.Name)
+	}
+
+	rules := make([]*IngressRule, 0, len(hostnames))
+	for _, hostname := range hostnames {
+		rules = append(rules, &IngressRule{
+			Hostname: hostname,
+			Service:  tlsServiceURL(route, backend),
+			OriginRequest: &OriginRequestConfig{
+				OriginServerName: hostname,
+			},
+		})
+	}
+
+	return rules, nil
+}
+
+// backendTLSRouteNamespace resolves a BackendRef's effective namespace for a TLSRoute.
+func backendTLSRouteNamespace(route *gwapiv1a2.TLSRoute, backend gwapiv1.BackendRef) string {
+	if backend.Namespace != nil {
+		return string(*backend.Namespace)
+	}
+	return route.Namespace
+}
+
+// backendRefPermitted reports whether backend may be referenced from route,
+// consulting ReferenceGrant for cross-namespace refs.
+func (r *TLSRouteReconciler) backendRefPermitted(ctx context.Context, route *gwapiv1a2.TLSRoute, backend gwapiv1.BackendRef) (bool, error) {
+	namespace := backendTLSRouteNamespace(route, backend)
+	if namespace == route.Namespace {
+		return true, nil
+	}
+
+	return referenceGrantAllows(ctx, r.Client,
+		"gateway.networking.k8s.io", "TLSRoute", route.Namespace,
+		"", "Service", namespace, string(backend.Name))
+}
+
+// tlsServiceURL renders the in-cluster origin URL for a TLSRoute backend.
+func tlsServiceURL(route *gwapiv1a2.TLSRoute, backend gwapiv1.BackendRef) string {
+	namespace := backendTLSRouteNamespace(route, backend)
+
+	port := int32(443)
+	if backend.Port != nil {
+		port = int32(*backend.Port)
+	}
+
+	return fmt.Sprintf("tls://%s.%s.svc.cluster.local:%d", backend.Name, namespace, port)
+}
@@ -0,0 +1,88 @@
+// Package dns defines the provider-agnostic DNS backend cfgate's sync
+// reconcilers write records through, so a CloudflareDNSSync can manage
+// records in a zone hosted somewhere other than Cloudflare (a common split
+// where only the tunnel lives on Cloudflare but apex DNS is delegated
+// elsewhere). internal/cloudflare remains the Cloudflare implementation;
+// internal/dns/providers holds the others.
+package dns
+
+import "context"
+
+// Record is a provider-agnostic DNS record. Not every provider supports
+// every field - Capabilities tells a caller which ones a given Provider
+// will actually honor.
+type Record struct {
+	// Name is the record's fully-qualified hostname.
+	Name string
+
+	// Type is the DNS record type, e.g. "CNAME", "A", "TXT".
+	Type string
+
+	// Content is the record's value (target hostname, IP, or TXT content).
+	Content string
+
+	// TTL is the record's time-to-live in seconds. Ignored by providers
+	// whose Capabilities.TTL is false (e.g. Cloudflare's proxied "auto" TTL).
+	TTL int
+
+	// Proxied requests the provider route traffic through its edge/proxy
+	// rather than resolving directly to Content. Ignored by providers whose
+	// Capabilities.Proxying is false.
+	Proxied bool
+
+	// Comment is a human-readable annotation stored alongside the record,
+	// used as a fallback ownership marker. Ignored by providers whose
+	// Capabilities.Comments is false - those providers rely solely on a TXT
+	// ownership record instead.
+	Comment string
+}
+
+// Capabilities describes which Record fields and ownership mechanisms a
+// Provider actually supports. Each Provider reports it, but no caller reads
+// it: internal/cloudflare's recordsMatch and BuildCNAMERecord, and its
+// ownership TXT emission, compare and build records the same way whatever
+// the provider supports.
+type Capabilities struct {
+	// Proxying is true if the provider can route traffic through its own
+	// edge/proxy instead of returning Content directly to resolvers.
+	Proxying bool
+
+	// TTL is true if the provider honors a caller-supplied TTL rather than
+	// always using its own default.
+	TTL bool
+
+	// Comments is true if the provider can store a comment alongside a
+	// record, usable as a fallback ownership marker. Providers without
+	// comment support (e.g. RFC2136) must rely on a TXT ownership record.
+	Comments bool
+}
+
+// Provider is the DNS backend a sync reconciler writes records through.
+// internal/cloudflare's DNSService is adapted to this interface by
+// internal/dns/providers/cloudflareprovider; internal/dns/providers/route53
+// and internal/dns/providers/rfc2136 are standalone implementations for
+// zones hosted outside Cloudflare.
+type Provider interface {
+	// Capabilities reports which Record fields and ownership mechanisms
+	// this provider supports.
+	Capabilities() Capabilities
+
+	// ResolveZone resolves a zone name to a provider-specific zone
+	// identifier a caller can pass back as zoneID to the other methods.
+	// Returns "" if the zone doesn't exist or isn't accessible.
+	ResolveZone(ctx context.Context, zoneName string) (zoneID string, err error)
+
+	// SyncRecord ensures a record exists in zoneID with the desired
+	// configuration, creating or updating it as needed. Returns whether it
+	// was modified.
+	SyncRecord(ctx context.Context, zoneID string, desired Record) (modified bool, err error)
+
+	// DeleteRecord deletes the record named name of the given recordType in
+	// zoneID. Not an error if no such record exists.
+	DeleteRecord(ctx context.Context, zoneID, name, recordType string) error
+
+	// ListManagedRecords lists every record in zoneID that carries a cfgate
+	// ownership marker (TXT record or, where Capabilities.Comments is true,
+	// comment) with the given ownership prefix.
+	ListManagedRecords(ctx context.Context, zoneID, ownershipPrefix string) ([]Record, error)
+}
@@ -0,0 +1,97 @@
+// Package cloudflareprovider adapts internal/cloudflare's DNSService to the
+// provider-agnostic dns.Provider interface, so Cloudflare is just one of
+// several interchangeable DNS backends rather than cfgate's only option.
+package cloudflareprovider
+
+import (
+	"context"
+	"fmt"
+
+	"cfgate.io/cfgate/internal/cloudflare"
+	"cfgate.io/cfgate/internal/dns"
+)
+
+// Provider adapts a *cloudflare.DNSService to dns.Provider.
+type Provider struct {
+	service *cloudflare.DNSService
+}
+
+// New wraps service as a dns.Provider.
+func New(service *cloudflare.DNSService) *Provider {
+	return &Provider{service: service}
+}
+
+// Capabilities reports that Cloudflare supports proxying and comments but
+// ignores caller-supplied TTL for proxied records (it always uses "auto").
+func (p *Provider) Capabilities() dns.Capabilities {
+	return dns.Capabilities{Proxying: true, TTL: false, Comments: true}
+}
+
+// ResolveZone resolves zoneName to its Cloudflare zone ID.
+func (p *Provider) ResolveZone(ctx context.Context, zoneName string) (string, error) {
+	zone, err := p.service.ResolveZone(ctx, zoneName)
+	if err != nil {
+		return "", err
+	}
+	if zone == nil {
+		return "", nil
+	}
+	return zone.ID, nil
+}
+
+// SyncRecord creates or updates desired in zoneID through
+// cloudflare.DNSService.SyncRecord with TXT ownership disabled, so an
+// existing record is updated only if its comment carries cfgate's marker.
+// A record left alone for that reason is reported as unmodified with no
+// error; callers that need to see such a conflict should use
+// DNSService.SyncRecord directly.
+func (p *Provider) SyncRecord(ctx context.Context, zoneID string, desired dns.Record) (bool, error) {
+	record := cloudflare.DNSRecord{
+		Type:    desired.Type,
+		Name:    desired.Name,
+		Content: desired.Content,
+		TTL:     desired.TTL,
+		Proxied: desired.Proxied,
+		Comment: desired.Comment,
+	}
+	if record.TTL == 0 {
+		record.TTL = 1 // Auto TTL
+	}
+
+	_, modified, _, err := p.service.SyncRecord(ctx, zoneID, record, "", "", false)
+	return modified, err
+}
+
+// DeleteRecord deletes the record named name of recordType in zoneID, if one
+// exists.
+func (p *Provider) DeleteRecord(ctx context.Context, zoneID, name, recordType string) error {
+	existing, err := p.service.FindRecordByName(ctx, zoneID, name, recordType)
+	if err != nil {
+		return fmt.Errorf("failed to find record to delete: %w", err)
+	}
+	if existing == nil {
+		return nil
+	}
+	return p.service.DeleteRecord(ctx, zoneID, existing.ID)
+}
+
+// ListManagedRecords lists every cfgate-managed record in zoneID.
+func (p *Provider) ListManagedRecords(ctx context.Context, zoneID, ownershipPrefix string) ([]dns.Record, error) {
+	records, err := p.service.ListManagedRecords(ctx, zoneID, ownershipPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]dns.Record, 0, len(records))
+	for _, r := range records {
+		out = append(out, dns.Record{
+			Name:    r.Name,
+			Type:    r.Type,
+			Content: r.Content,
+			TTL:     r.TTL,
+			Proxied: r.Proxied,
+			Comment: r.Comment,
+		})
+	}
+	return out, nil
+}
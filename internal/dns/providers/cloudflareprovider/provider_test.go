@@ -0,0 +1,38 @@
+package cloudflareprovider_test
+
+import (
+	"context"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+
+	"cfgate.io/cfgate/internal/cloudflare"
+	"cfgate.io/cfgate/internal/cloudflare/mocks"
+	"cfgate.io/cfgate/internal/dns"
+	"cfgate.io/cfgate/internal/dns/providers/cloudflareprovider"
+)
+
+func TestProvider_Capabilities(t *testing.T) {
+	p := cloudflareprovider.New(cloudflare.NewDNSService(nil))
+	caps := p.Capabilities()
+	if !caps.Proxying || caps.TTL || !caps.Comments {
+		t.Fatalf("unexpected capabilities: %+v", caps)
+	}
+}
+
+func TestProvider_SyncRecord_CreatesWhenMissing(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	api := mocks.NewMockCloudflareAPI(ctrl)
+	p := cloudflareprovider.New(cloudflare.NewDNSService(api))
+
+	api.EXPECT().ListDNSRecords(gomock.Any(), "zone-1").Return(nil, nil)
+	api.EXPECT().CreateDNSRecord(gomock.Any(), "zone-1", gomock.Any()).Return(&cloudflare.DNSRecord{ID: "rec-1"}, nil)
+
+	modified, err := p.SyncRecord(context.Background(), "zone-1", dns.Record{Name: "app.example.com", Type: "CNAME", Content: "tunnel.cfargotunnel.com"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !modified {
+		t.Fatal("expected record creation to report modified=true")
+	}
+}
@@ -0,0 +1,214 @@
+// Package rfc2136 implements dns.Provider against a zone's authoritative
+// nameserver directly via RFC 2136 Dynamic Update, for users whose DNS
+// isn't hosted by any cloud provider cfgate has a dedicated integration
+// for.
+package rfc2136
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+
+	cfgatedns "cfgate.io/cfgate/internal/dns"
+)
+
+// Provider implements dns.Provider by sending RFC 2136 Dynamic Updates
+// directly to a zone's authoritative nameserver, optionally TSIG-signed.
+// RFC 2136 has no concept of proxying or comments, so Capabilities reports
+// both false; ownership is tracked solely via TXT records.
+type Provider struct {
+	// Nameserver is the authoritative nameserver's "host:port" address
+	// Dynamic Updates and zone transfers are sent to.
+	Nameserver string
+
+	// TSIGKeyName and TSIGSecret authenticate updates via TSIG, when set.
+	// TSIGSecret is base64-encoded, matching miekg/dns's convention.
+	TSIGKeyName string
+	TSIGSecret  string
+
+	// TSIGAlgorithm is the TSIG algorithm, e.g. dns.HmacSHA256. Defaults to
+	// dns.HmacSHA256 if unset.
+	TSIGAlgorithm string
+
+	client *dns.Client
+}
+
+// New creates a Provider. Call it once and reuse it; it holds no
+// per-request state.
+func New(nameserver, tsigKeyName, tsigSecret, tsigAlgorithm string) *Provider {
+	return &Provider{
+		Nameserver:    nameserver,
+		TSIGKeyName:   tsigKeyName,
+		TSIGSecret:    tsigSecret,
+		TSIGAlgorithm: tsigAlgorithm,
+		client:        &dns.Client{},
+	}
+}
+
+// Capabilities reports that RFC 2136 honors TTL but has no proxying or
+// comment support.
+func (p *Provider) Capabilities() cfgatedns.Capabilities {
+	return cfgatedns.Capabilities{Proxying: false, TTL: true, Comments: false}
+}
+
+// ResolveZone confirms zoneName has an authoritative SOA record at
+// Nameserver and returns zoneName itself as the zoneID - RFC 2136 has no
+// separate zone identifier the way a hosted-zone API does.
+func (p *Provider) ResolveZone(ctx context.Context, zoneName string) (string, error) {
+	zone := dns.Fqdn(zoneName)
+
+	msg := new(dns.Msg)
+	msg.SetQuestion(zone, dns.TypeSOA)
+
+	reply, _, err := p.client.ExchangeContext(ctx, msg, p.Nameserver)
+	if err != nil {
+		return "", fmt.Errorf("failed to query SOA for zone %q: %w", zoneName, err)
+	}
+	if reply.Rcode != dns.RcodeSuccess || len(reply.Answer) == 0 {
+		return "", nil
+	}
+
+	return zoneName, nil
+}
+
+// SyncRecord sends an RFC 2136 Dynamic Update that replaces any existing
+// record set named desired.Name of desired.Type with desired's content.
+func (p *Provider) SyncRecord(ctx context.Context, zoneID string, desired cfgatedns.Record) (bool, error) {
+	rr, err := p.buildRR(desired)
+	if err != nil {
+		return false, err
+	}
+
+	msg := new(dns.Msg)
+	msg.SetUpdate(dns.Fqdn(zoneID))
+	msg.RemoveRRset([]dns.RR{rrsetPlaceholder(desired.Name, desired.Type)})
+	msg.Insert([]dns.RR{rr})
+
+	if err := p.send(ctx, zoneID, msg); err != nil {
+		return false, fmt.Errorf("failed to update record %s: %w", desired.Name, err)
+	}
+	return true, nil
+}
+
+// DeleteRecord sends an RFC 2136 Dynamic Update removing the record set
+// named name of recordType.
+func (p *Provider) DeleteRecord(ctx context.Context, zoneID, name, recordType string) error {
+	msg := new(dns.Msg)
+	msg.SetUpdate(dns.Fqdn(zoneID))
+	msg.RemoveRRset([]dns.RR{rrsetPlaceholder(name, recordType)})
+
+	if err := p.send(ctx, zoneID, msg); err != nil {
+		return fmt.Errorf("failed to delete record %s: %w", name, err)
+	}
+	return nil
+}
+
+// ListManagedRecords zone-transfers zoneID and returns every TXT record
+// whose name carries ownershipPrefix, the only ownership marker RFC 2136
+// supports. Requires the nameserver to permit AXFR from cfgate.
+func (p *Provider) ListManagedRecords(ctx context.Context, zoneID, ownershipPrefix string) ([]cfgatedns.Record, error) {
+	transfer := &dns.Transfer{}
+	if p.tsigConfigured() {
+		transfer.TsigSecret = map[string]string{dns.Fqdn(p.TSIGKeyName): p.TSIGSecret}
+	}
+
+	msg := new(dns.Msg)
+	msg.SetAxfr(dns.Fqdn(zoneID))
+	if p.tsigConfigured() {
+		msg.SetTsig(dns.Fqdn(p.TSIGKeyName), p.algorithm(), 300, uint64(time.Now().Unix()))
+	}
+
+	envelopes, err := transfer.In(msg, p.Nameserver)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start zone transfer for %q: %w", zoneID, err)
+	}
+
+	var managed []cfgatedns.Record
+	for envelope := range envelopes {
+		if envelope.Error != nil {
+			return nil, fmt.Errorf("zone transfer for %q failed: %w", zoneID, envelope.Error)
+		}
+		for _, rr := range envelope.RR {
+			txt, ok := rr.(*dns.TXT)
+			if !ok {
+				continue
+			}
+			name := strings.TrimSuffix(txt.Hdr.Name, ".")
+			if !strings.HasPrefix(name, ownershipPrefix+"-") {
+				continue
+			}
+			managed = append(managed, cfgatedns.Record{
+				Name:    name,
+				Type:    "TXT",
+				Content: strings.Join(txt.Txt, ""),
+				TTL:     int(txt.Hdr.Ttl),
+			})
+		}
+	}
+
+	return managed, nil
+}
+
+// send signs msg with TSIG when configured and exchanges it with
+// Nameserver, treating any non-success Rcode as an error.
+func (p *Provider) send(ctx context.Context, zoneID string, msg *dns.Msg) error {
+	client := p.client
+	if p.tsigConfigured() {
+		client = &dns.Client{TsigSecret: map[string]string{dns.Fqdn(p.TSIGKeyName): p.TSIGSecret}}
+		msg.SetTsig(dns.Fqdn(p.TSIGKeyName), p.algorithm(), 300, uint64(time.Now().Unix()))
+	}
+
+	reply, _, err := client.ExchangeContext(ctx, msg, p.Nameserver)
+	if err != nil {
+		return err
+	}
+	if reply.Rcode != dns.RcodeSuccess {
+		return fmt.Errorf("nameserver rejected update with rcode %s", dns.RcodeToString[reply.Rcode])
+	}
+	return nil
+}
+
+// tsigConfigured reports whether TSIG signing is configured.
+func (p *Provider) tsigConfigured() bool {
+	return p.TSIGKeyName != "" && p.TSIGSecret != ""
+}
+
+// algorithm returns TSIGAlgorithm, defaulting to HMAC-SHA256.
+func (p *Provider) algorithm() string {
+	if p.TSIGAlgorithm == "" {
+		return dns.HmacSHA256
+	}
+	return p.TSIGAlgorithm
+}
+
+// buildRR renders desired as the miekg/dns resource record SyncRecord
+// inserts.
+func (p *Provider) buildRR(desired cfgatedns.Record) (dns.RR, error) {
+	ttl := uint32(desired.TTL)
+	if ttl == 0 {
+		ttl = 300
+	}
+
+	rrString := fmt.Sprintf("%s %d IN %s %s", dns.Fqdn(desired.Name), ttl, desired.Type, desired.Content)
+	rr, err := dns.NewRR(rrString)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build resource record for %s: %w", desired.Name, err)
+	}
+	return rr, nil
+}
+
+// rrsetPlaceholder builds the ANY-class, zero-TTL RR RemoveRRset expects to
+// identify the record set to delete, per RFC 2136's delete-rrset format.
+func rrsetPlaceholder(name, recordType string) dns.RR {
+	rr := new(dns.ANY)
+	rr.Hdr = dns.RR_Header{
+		Name:   dns.Fqdn(name),
+		Rrtype: dns.StringToType[recordType],
+		Class:  dns.ClassANY,
+		Ttl:    0,
+	}
+	return rr
+}
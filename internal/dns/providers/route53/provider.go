@@ -0,0 +1,194 @@
+// Package route53 implements dns.Provider against AWS Route 53, for users
+// whose tunnel lives on Cloudflare but whose authoritative apex DNS is
+// delegated to Route 53.
+package route53
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	awsroute53 "github.com/aws/aws-sdk-go-v2/service/route53"
+	r53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+
+	"cfgate.io/cfgate/internal/dns"
+)
+
+// API is the narrow set of Route 53 operations Provider needs, kept
+// separate from the full SDK client so Provider can be unit-tested against
+// a fake, mirroring internal/cloudflare.CloudflareAPI.
+type API interface {
+	ListHostedZonesByName(ctx context.Context, params *awsroute53.ListHostedZonesByNameInput, optFns ...func(*awsroute53.Options)) (*awsroute53.ListHostedZonesByNameOutput, error)
+	ListResourceRecordSets(ctx context.Context, params *awsroute53.ListResourceRecordSetsInput, optFns ...func(*awsroute53.Options)) (*awsroute53.ListResourceRecordSetsOutput, error)
+	ChangeResourceRecordSets(ctx context.Context, params *awsroute53.ChangeResourceRecordSetsInput, optFns ...func(*awsroute53.Options)) (*awsroute53.ChangeResourceRecordSetsOutput, error)
+}
+
+// Provider implements dns.Provider against Route 53. Route 53 has no
+// concept of proxying (Cloudflare's edge feature) or per-record comments,
+// so Capabilities reports both false; ownership is tracked solely via TXT
+// records.
+type Provider struct {
+	client API
+}
+
+// New creates a Provider backed by client.
+func New(client API) *Provider {
+	return &Provider{client: client}
+}
+
+// Capabilities reports that Route 53 honors TTL but has no proxying or
+// comment support.
+func (p *Provider) Capabilities() dns.Capabilities {
+	return dns.Capabilities{Proxying: false, TTL: true, Comments: false}
+}
+
+// ResolveZone resolves zoneName to its Route 53 hosted zone ID.
+func (p *Provider) ResolveZone(ctx context.Context, zoneName string) (string, error) {
+	dnsName := ensureTrailingDot(zoneName)
+	out, err := p.client.ListHostedZonesByName(ctx, &awsroute53.ListHostedZonesByNameInput{
+		DNSName:  &dnsName,
+		MaxItems: awsInt32(1),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to list hosted zones: %w", err)
+	}
+	if len(out.HostedZones) == 0 || *out.HostedZones[0].Name != dnsName {
+		return "", nil
+	}
+	return strings.TrimPrefix(*out.HostedZones[0].Id, "/hostedzone/"), nil
+}
+
+// SyncRecord upserts desired into zoneID via a Route 53 change batch.
+func (p *Provider) SyncRecord(ctx context.Context, zoneID string, desired dns.Record) (bool, error) {
+	ttl := int64(desired.TTL)
+	if ttl == 0 {
+		ttl = 300
+	}
+
+	name := ensureTrailingDot(desired.Name)
+	_, err := p.client.ChangeResourceRecordSets(ctx, &awsroute53.ChangeResourceRecordSetsInput{
+		HostedZoneId: &zoneID,
+		ChangeBatch: &r53types.ChangeBatch{
+			Changes: []r53types.Change{
+				{
+					Action: r53types.ChangeActionUpsert,
+					ResourceRecordSet: &r53types.ResourceRecordSet{
+						Name: &name,
+						Type: r53types.RRType(desired.Type),
+						TTL:  &ttl,
+						ResourceRecords: []r53types.ResourceRecord{
+							{Value: &desired.Content},
+						},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to upsert Route 53 record: %w", err)
+	}
+
+	// Route 53's API has no "no-op" signal - an UPSERT with identical
+	// content still succeeds, so every successful call is reported as a
+	// modification. Unlike Cloudflare's find-then-compare flow, avoiding an
+	// unnecessary write would cost an extra ListResourceRecordSets call per
+	// hostname every reconcile, which isn't worth it for an idempotent API.
+	return true, nil
+}
+
+// DeleteRecord deletes the record named name of recordType in zoneID, if
+// one exists.
+func (p *Provider) DeleteRecord(ctx context.Context, zoneID, name, recordType string) error {
+	fqdn := ensureTrailingDot(name)
+	existing, err := p.findRecordSet(ctx, zoneID, fqdn, recordType)
+	if err != nil {
+		return fmt.Errorf("failed to find record to delete: %w", err)
+	}
+	if existing == nil {
+		return nil
+	}
+
+	_, err = p.client.ChangeResourceRecordSets(ctx, &awsroute53.ChangeResourceRecordSetsInput{
+		HostedZoneId: &zoneID,
+		ChangeBatch: &r53types.ChangeBatch{
+			Changes: []r53types.Change{
+				{Action: r53types.ChangeActionDelete, ResourceRecordSet: existing},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete Route 53 record: %w", err)
+	}
+	return nil
+}
+
+// ListManagedRecords lists every TXT record in zoneID whose name carries
+// ownershipPrefix, the only ownership marker Route 53 supports.
+func (p *Provider) ListManagedRecords(ctx context.Context, zoneID, ownershipPrefix string) ([]dns.Record, error) {
+	out, err := p.client.ListResourceRecordSets(ctx, &awsroute53.ListResourceRecordSetsInput{
+		HostedZoneId: &zoneID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Route 53 record sets: %w", err)
+	}
+
+	var managed []dns.Record
+	for _, rs := range out.ResourceRecordSets {
+		if rs.Type != r53types.RRTypeTxt || rs.Name == nil || !strings.HasPrefix(*rs.Name, ownershipPrefix+"-") {
+			continue
+		}
+		managed = append(managed, recordFromSet(rs))
+	}
+	return managed, nil
+}
+
+// findRecordSet looks up the record set named name of recordType in
+// zoneID, returning nil if none exists.
+func (p *Provider) findRecordSet(ctx context.Context, zoneID, name, recordType string) (*r53types.ResourceRecordSet, error) {
+	out, err := p.client.ListResourceRecordSets(ctx, &awsroute53.ListResourceRecordSetsInput{
+		HostedZoneId:    &zoneID,
+		StartRecordName: &name,
+		StartRecordType: r53types.RRType(recordType),
+		MaxItems:        awsInt32(1),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(out.ResourceRecordSets) == 0 {
+		return nil, nil
+	}
+	rs := out.ResourceRecordSets[0]
+	if rs.Name == nil || *rs.Name != name || string(rs.Type) != recordType {
+		return nil, nil
+	}
+	return &rs, nil
+}
+
+// recordFromSet converts a Route 53 resource record set into a dns.Record.
+func recordFromSet(rs r53types.ResourceRecordSet) dns.Record {
+	record := dns.Record{Type: string(rs.Type)}
+	if rs.Name != nil {
+		record.Name = strings.TrimSuffix(*rs.Name, ".")
+	}
+	if rs.TTL != nil {
+		record.TTL = int(*rs.TTL)
+	}
+	if len(rs.ResourceRecords) > 0 && rs.ResourceRecords[0].Value != nil {
+		record.Content = *rs.ResourceRecords[0].Value
+	}
+	return record
+}
+
+// ensureTrailingDot appends the trailing "." Route 53 names are stored
+// with, if not already present.
+func ensureTrailingDot(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
+
+// awsInt32 returns a pointer to v, for the SDK's *int32 params.
+func awsInt32(v int32) *int32 {
+	return &v
+}
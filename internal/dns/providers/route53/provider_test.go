@@ -0,0 +1,71 @@
+package route53_test
+
+import (
+	"context"
+	"testing"
+
+	awsroute53 "github.com/aws/aws-sdk-go-v2/service/route53"
+	r53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+
+	"cfgate.io/cfgate/internal/dns"
+	"cfgate.io/cfgate/internal/dns/providers/route53"
+)
+
+// fakeAPI is a minimal in-memory implementation of route53.API for tests.
+type fakeAPI struct {
+	zones       []r53types.HostedZone
+	recordSets  []r53types.ResourceRecordSet
+	lastChanges []r53types.Change
+}
+
+func (f *fakeAPI) ListHostedZonesByName(_ context.Context, params *awsroute53.ListHostedZonesByNameInput, _ ...func(*awsroute53.Options)) (*awsroute53.ListHostedZonesByNameOutput, error) {
+	return &awsroute53.ListHostedZonesByNameOutput{HostedZones: f.zones}, nil
+}
+
+func (f *fakeAPI) ListResourceRecordSets(_ context.Context, _ *awsroute53.ListResourceRecordSetsInput, _ ...func(*awsroute53.Options)) (*awsroute53.ListResourceRecordSetsOutput, error) {
+	return &awsroute53.ListResourceRecordSetsOutput{ResourceRecordSets: f.recordSets}, nil
+}
+
+func (f *fakeAPI) ChangeResourceRecordSets(_ context.Context, params *awsroute53.ChangeResourceRecordSetsInput, _ ...func(*awsroute53.Options)) (*awsroute53.ChangeResourceRecordSetsOutput, error) {
+	f.lastChanges = params.ChangeBatch.Changes
+	return &awsroute53.ChangeResourceRecordSetsOutput{}, nil
+}
+
+func TestProvider_Capabilities(t *testing.T) {
+	p := route53.New(&fakeAPI{})
+	caps := p.Capabilities()
+	if caps.Proxying || !caps.TTL || caps.Comments {
+		t.Fatalf("unexpected capabilities: %+v", caps)
+	}
+}
+
+func TestProvider_ResolveZone(t *testing.T) {
+	name := "example.com."
+	id := "/hostedzone/Z123"
+	api := &fakeAPI{zones: []r53types.HostedZone{{Name: &name, Id: &id}}}
+	p := route53.New(api)
+
+	zoneID, err := p.ResolveZone(context.Background(), "example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if zoneID != "Z123" {
+		t.Fatalf("ResolveZone() = %q, want %q", zoneID, "Z123")
+	}
+}
+
+func TestProvider_SyncRecord_UpsertsViaChangeBatch(t *testing.T) {
+	api := &fakeAPI{}
+	p := route53.New(api)
+
+	modified, err := p.SyncRecord(context.Background(), "Z123", dns.Record{Name: "app.example.com", Type: "CNAME", Content: "tunnel.cfargotunnel.com"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !modified {
+		t.Fatal("expected SyncRecord to report modified=true")
+	}
+	if len(api.lastChanges) != 1 || api.lastChanges[0].Action != r53types.ChangeActionUpsert {
+		t.Fatalf("expected a single UPSERT change, got %+v", api.lastChanges)
+	}
+}
@@ -0,0 +1,224 @@
+package nameserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	cfgatev1alpha1 "cfgate.io/cfgate/api/v1alpha1"
+)
+
+const (
+	// DefaultImage is the default nameserver container image.
+	DefaultImage = "cfgate.io/nameserver:latest"
+
+	// dnsPort is the UDP/TCP port the nameserver listens on.
+	dnsPort = 53
+
+	// recordsVolumeName is the Pod volume name for the mounted records ConfigMap.
+	recordsVolumeName = "records"
+
+	// RecordsMountPath is where the records ConfigMap is mounted in the
+	// nameserver container.
+	RecordsMountPath = "/etc/cfgate-nameserver"
+
+	// RecordsFileName is the records ConfigMap key / mounted file name.
+	RecordsFileName = "records.json"
+)
+
+// Builder creates the Kubernetes resources for a CloudflareDNSResolver's
+// nameserver workload.
+type Builder interface {
+	// BuildDeployment creates the nameserver Deployment, mounting the
+	// records ConfigMap built by BuildRecordsConfigMap.
+	BuildDeployment(resolver *cfgatev1alpha1.CloudflareDNSResolver) *appsv1.Deployment
+
+	// BuildService creates the Service fronting the nameserver Deployment
+	// on port 53/udp and 53/tcp.
+	BuildService(resolver *cfgatev1alpha1.CloudflareDNSResolver) *corev1.Service
+
+	// BuildRecordsConfigMap renders set as the JSON records file the
+	// nameserver binary loads and watches for changes.
+	BuildRecordsConfigMap(resolver *cfgatev1alpha1.CloudflareDNSResolver, set *RecordSet) (*corev1.ConfigMap, error)
+
+	// BuildCorefileConfigMap renders a sample CoreDNS Corefile snippet that
+	// stub-zones each of set's zones to this resolver's Service, for
+	// cluster admins to merge into their CoreDNS config.
+	BuildCorefileConfigMap(resolver *cfgatev1alpha1.CloudflareDNSResolver, set *RecordSet) *corev1.ConfigMap
+}
+
+// DefaultBuilder is the default implementation of Builder.
+type DefaultBuilder struct{}
+
+// NewBuilder creates a new DefaultBuilder.
+func NewBuilder() *DefaultBuilder {
+	return &DefaultBuilder{}
+}
+
+// BuildDeployment creates the nameserver Deployment.
+func (b *DefaultBuilder) BuildDeployment(resolver *cfgatev1alpha1.CloudflareDNSResolver) *appsv1.Deployment {
+	labels := Labels(resolver.Name)
+	selector := Selector(resolver.Name)
+
+	replicas := resolver.Spec.Replicas
+	if replicas == 0 {
+		replicas = 2
+	}
+
+	container := corev1.Container{
+		Name:  "nameserver",
+		Image: DefaultImage,
+		Args: []string{
+			fmt.Sprintf("--addr=0.0.0.0:%d", dnsPort),
+			"--records-file=" + RecordsMountPath + "/" + RecordsFileName,
+		},
+		Ports: []corev1.ContainerPort{
+			{Name: "dns-udp", ContainerPort: dnsPort, Protocol: corev1.ProtocolUDP},
+			{Name: "dns-tcp", ContainerPort: dnsPort, Protocol: corev1.ProtocolTCP},
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: recordsVolumeName, MountPath: RecordsMountPath, ReadOnly: true},
+		},
+	}
+
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      DeploymentName(resolver.Name),
+			Namespace: resolver.Namespace,
+			Labels:    labels,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: selector},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{container},
+					Volumes: []corev1.Volume{
+						{
+							Name: recordsVolumeName,
+							VolumeSource: corev1.VolumeSource{
+								ConfigMap: &corev1.ConfigMapVolumeSource{
+									LocalObjectReference: corev1.LocalObjectReference{Name: RecordsConfigMapName(resolver.Name)},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// BuildService creates the Service fronting the nameserver Deployment.
+func (b *DefaultBuilder) BuildService(resolver *cfgatev1alpha1.CloudflareDNSResolver) *corev1.Service {
+	svcType := corev1.ServiceType(resolver.Spec.Service.Type)
+	if svcType == "" {
+		svcType = corev1.ServiceTypeClusterIP
+	}
+
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ServiceName(resolver.Name),
+			Namespace: resolver.Namespace,
+			Labels:    Labels(resolver.Name),
+		},
+		Spec: corev1.ServiceSpec{
+			Type:      svcType,
+			ClusterIP: resolver.Spec.Service.ClusterIP,
+			Selector:  Selector(resolver.Name),
+			Ports: []corev1.ServicePort{
+				{Name: "dns-udp", Port: dnsPort, Protocol: corev1.ProtocolUDP, TargetPort: intstr.FromInt32(dnsPort)},
+				{Name: "dns-tcp", Port: dnsPort, Protocol: corev1.ProtocolTCP, TargetPort: intstr.FromInt32(dnsPort)},
+			},
+		},
+	}
+}
+
+// BuildRecordsConfigMap renders set as JSON for the nameserver to load.
+func (b *DefaultBuilder) BuildRecordsConfigMap(resolver *cfgatev1alpha1.CloudflareDNSResolver, set *RecordSet) (*corev1.ConfigMap, error) {
+	data, err := json.Marshal(set)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling record set: %w", err)
+	}
+
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      RecordsConfigMapName(resolver.Name),
+			Namespace: resolver.Namespace,
+			Labels:    Labels(resolver.Name),
+		},
+		Data: map[string]string{
+			RecordsFileName: string(data),
+		},
+	}, nil
+}
+
+// BuildCorefileConfigMap renders a Corefile snippet stub-zoning each of
+// set's zones to this resolver's Service, so cluster admins can `import` it
+// into CoreDNS's Corefile or merge it with the CoreDNS Custom ConfigMap
+// plugin's Ready-made stub-zone convention.
+func (b *DefaultBuilder) BuildCorefileConfigMap(resolver *cfgatev1alpha1.CloudflareDNSResolver, set *RecordSet) *corev1.ConfigMap {
+	svc := fmt.Sprintf("%s.%s.svc.cluster.local", ServiceName(resolver.Name), resolver.Namespace)
+
+	var b2 strings.Builder
+	for _, zone := range set.Zones {
+		fmt.Fprintf(&b2, "%s:53 {\n    forward . %s\n}\n", zone, svc)
+	}
+
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      CorefileConfigMapName(resolver.Name),
+			Namespace: resolver.Namespace,
+			Labels:    Labels(resolver.Name),
+		},
+		Data: map[string]string{
+			"Corefile": b2.String(),
+		},
+	}
+}
+
+// DeploymentName returns the name for the nameserver Deployment.
+func DeploymentName(resolverName string) string {
+	return resolverName + "-nameserver"
+}
+
+// ServiceName returns the name for the nameserver Service.
+func ServiceName(resolverName string) string {
+	return resolverName + "-nameserver"
+}
+
+// RecordsConfigMapName returns the name for the nameserver's records ConfigMap.
+func RecordsConfigMapName(resolverName string) string {
+	return resolverName + "-nameserver-records"
+}
+
+// CorefileConfigMapName returns the name for the sample Corefile ConfigMap.
+func CorefileConfigMapName(resolverName string) string {
+	return resolverName + "-nameserver-corefile"
+}
+
+// Labels returns the standard labels applied to a CloudflareDNSResolver's
+// owned resources.
+func Labels(resolverName string) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":       "cfgate-nameserver",
+		"app.kubernetes.io/instance":   resolverName,
+		"app.kubernetes.io/component":  "dns-resolver",
+		"app.kubernetes.io/managed-by": "cfgate",
+	}
+}
+
+// Selector returns the Pod selector labels for a CloudflareDNSResolver's
+// nameserver Deployment.
+func Selector(resolverName string) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":     "cfgate-nameserver",
+		"app.kubernetes.io/instance": resolverName,
+	}
+}
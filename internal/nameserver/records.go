@@ -0,0 +1,121 @@
+// Package nameserver implements the in-cluster authoritative DNS server that
+// answers A/AAAA/CNAME for hostnames a CloudflareDNSResolver publishes,
+// resolving them to backend Service addresses (or an ExternalName Service's
+// target, via CNAME) instead of the Cloudflare tunnel.
+package nameserver
+
+import (
+	"strings"
+	"sync"
+)
+
+// Record is one authoritative answer: a hostname and either the addresses it
+// resolves to in-cluster or a CNAME target to delegate to (e.g. an
+// ExternalName Service). Multiple addresses (e.g. a headless Service's
+// EndpointSlice members) are served round-robin.
+type Record struct {
+	// Name is the fully-qualified hostname, without a trailing dot.
+	Name string `json:"name"`
+
+	// A lists IPv4 addresses to answer with.
+	A []string `json:"a,omitempty"`
+
+	// AAAA lists IPv6 addresses to answer with.
+	AAAA []string `json:"aaaa,omitempty"`
+
+	// CNAME, if set, is the canonical name to answer with instead of A/AAAA
+	// addresses (e.g. an ExternalName Service's external DNS name). Mutually
+	// exclusive with A/AAAA in practice: a record is built as either an
+	// address record or an alias, never both.
+	CNAME string `json:"cname,omitempty"`
+}
+
+// RecordSet is the full set of zones a nameserver is authoritative for and
+// the hostname records within them. It's the shape persisted to the
+// nameserver's mounted records ConfigMap/file.
+type RecordSet struct {
+	// Zones are the DNS zones (e.g. "example.com") this nameserver is
+	// authoritative for. A query outside every zone gets REFUSED rather
+	// than NXDOMAIN, since this server isn't the right one to ask.
+	Zones []string `json:"zones"`
+
+	// Records maps hostname (without trailing dot) to its answer.
+	Records map[string]Record `json:"records"`
+
+	// countersMu guards counters, the next round-robin index per hostname.
+	// Neither is serialized; counters is built lazily on first access, so a
+	// reload doesn't need to preserve rotation state.
+	countersMu sync.Mutex
+	counters   map[string]uint64
+}
+
+// ManagesZone reports whether qname falls within one of rs's authoritative
+// zones (qname itself, or any subdomain of it).
+func (rs *RecordSet) ManagesZone(qname string) bool {
+	qname = normalizeName(qname)
+	for _, zone := range rs.Zones {
+		zone = normalizeName(zone)
+		if qname == zone || strings.HasSuffix(qname, "."+zone) {
+			return true
+		}
+	}
+	return false
+}
+
+// Lookup returns the record for qname, if one exists. Callers should check
+// ManagesZone first to distinguish REFUSED (wrong server) from NXDOMAIN (no
+// such record in a zone this server does manage).
+func (rs *RecordSet) Lookup(qname string) (Record, bool) {
+	rec, ok := rs.Records[normalizeName(qname)]
+	return rec, ok
+}
+
+// NextA returns the next IPv4 address for hostname in round-robin order, and
+// false if the record has no A addresses. Safe for concurrent use.
+func (rs *RecordSet) NextA(hostname string) (string, bool) {
+	rec, ok := rs.Lookup(hostname)
+	if !ok || len(rec.A) == 0 {
+		return "", false
+	}
+	return rec.A[rs.nextIndex(hostname, len(rec.A))], true
+}
+
+// NextAAAA returns the next IPv6 address for hostname in round-robin order,
+// and false if the record has no AAAA addresses. Safe for concurrent use.
+func (rs *RecordSet) NextAAAA(hostname string) (string, bool) {
+	rec, ok := rs.Lookup(hostname)
+	if !ok || len(rec.AAAA) == 0 {
+		return "", false
+	}
+	return rec.AAAA[rs.nextIndex(hostname, len(rec.AAAA))], true
+}
+
+// CNAMETarget returns hostname's CNAME target, if it has one.
+func (rs *RecordSet) CNAMETarget(hostname string) (string, bool) {
+	rec, ok := rs.Lookup(hostname)
+	if !ok || rec.CNAME == "" {
+		return "", false
+	}
+	return rec.CNAME, true
+}
+
+// nextIndex advances and returns hostname's rotation counter modulo n,
+// initializing the counter on first use.
+func (rs *RecordSet) nextIndex(hostname string, n int) int {
+	rs.countersMu.Lock()
+	defer rs.countersMu.Unlock()
+
+	if rs.counters == nil {
+		rs.counters = map[string]uint64{}
+	}
+	idx := rs.counters[hostname]
+	rs.counters[hostname] = idx + 1
+	return int(idx % uint64(n))
+}
+
+// normalizeName lowercases a hostname and strips any trailing dot, so zone
+// and record lookups don't depend on whether the query arrived in absolute
+// (FQDN) form.
+func normalizeName(name string) string {
+	return strings.ToLower(strings.TrimSuffix(name, "."))
+}
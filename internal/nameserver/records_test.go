@@ -0,0 +1,100 @@
+package nameserver_test
+
+import (
+	"testing"
+
+	"cfgate.io/cfgate/internal/nameserver"
+)
+
+func newTestSet() *nameserver.RecordSet {
+	return &nameserver.RecordSet{
+		Zones: []string{"example.com"},
+		Records: map[string]nameserver.Record{
+			"app.example.com": {A: []string{"10.0.0.1", "10.0.0.2"}},
+			"v6.example.com":  {AAAA: []string{"fd00::1"}},
+		},
+	}
+}
+
+func TestManagesZone(t *testing.T) {
+	set := newTestSet()
+
+	cases := []struct {
+		name string
+		want bool
+	}{
+		{"example.com", true},
+		{"example.com.", true},
+		{"app.example.com", true},
+		{"deep.sub.example.com", true},
+		{"other.com", false},
+		{"notexample.com", false},
+	}
+	for _, tc := range cases {
+		if got := set.ManagesZone(tc.name); got != tc.want {
+			t.Errorf("ManagesZone(%q) = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestLookup_UnknownNameInManagedZone(t *testing.T) {
+	set := newTestSet()
+
+	if !set.ManagesZone("missing.example.com") {
+		t.Fatal("expected zone to be managed")
+	}
+	if _, ok := set.Lookup("missing.example.com"); ok {
+		t.Fatal("expected no record for an unpublished hostname")
+	}
+}
+
+func TestNextA_RoundRobin(t *testing.T) {
+	set := newTestSet()
+
+	seen := map[string]int{}
+	for i := 0; i < 4; i++ {
+		ip, ok := set.NextA("app.example.com")
+		if !ok {
+			t.Fatalf("expected an A answer on iteration %d", i)
+		}
+		seen[ip]++
+	}
+
+	if seen["10.0.0.1"] != 2 || seen["10.0.0.2"] != 2 {
+		t.Fatalf("expected even round-robin distribution, got %v", seen)
+	}
+}
+
+func TestNextA_CaseAndTrailingDotInsensitive(t *testing.T) {
+	set := newTestSet()
+
+	if _, ok := set.NextA("APP.EXAMPLE.COM."); !ok {
+		t.Fatal("expected lookup to normalize case and trailing dot")
+	}
+}
+
+func TestNextAAAA_NoAddressesForARecord(t *testing.T) {
+	set := newTestSet()
+
+	if _, ok := set.NextAAAA("app.example.com"); ok {
+		t.Fatal("expected no AAAA answer for an A-only record")
+	}
+}
+
+func TestCNAMETarget(t *testing.T) {
+	set := &nameserver.RecordSet{
+		Zones: []string{"example.com"},
+		Records: map[string]nameserver.Record{
+			"alias.example.com": {CNAME: "legacy.example.net"},
+		},
+	}
+
+	target, ok := set.CNAMETarget("alias.example.com")
+	if !ok || target != "legacy.example.net" {
+		t.Fatalf("CNAMETarget() = %q, %v, want %q, true", target, ok, "legacy.example.net")
+	}
+
+	if _, ok := set.CNAMETarget("missing.example.com"); ok {
+		t.Fatal("expected no CNAME target for an unpublished hostname")
+	}
+}
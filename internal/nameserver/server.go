@@ -0,0 +1,138 @@
+package nameserver
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+// Server is an authoritative miekg/dns handler answering A/AAAA/CNAME
+// queries from a RecordSet. It refuses queries outside its managed zones,
+// returns NXDOMAIN for unknown names inside them, and round-robins
+// multi-address records (e.g. a headless Service's EndpointSlice members).
+type Server struct {
+	mu  sync.RWMutex
+	set *RecordSet
+	ttl uint32
+}
+
+// NewServer creates a Server with no records loaded; call SetRecordSet (or
+// Reload via a Watcher) before serving traffic.
+func NewServer(ttl time.Duration) *Server {
+	return &Server{
+		set: &RecordSet{},
+		ttl: uint32(ttl.Seconds()),
+	}
+}
+
+// SetRecordSet atomically replaces the record set the server answers from.
+// Safe to call while ServeDNS is handling concurrent queries.
+func (s *Server) SetRecordSet(set *RecordSet) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set = set
+}
+
+// recordSet returns the currently active RecordSet.
+func (s *Server) recordSet() *RecordSet {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set
+}
+
+// ServeDNS implements dns.Handler. Each query carries exactly one Question
+// in practice (the only shape miekg/dns's own clients send), so only the
+// first is answered; additional questions are ignored like most
+// authoritative servers do.
+func (s *Server) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
+	msg := new(dns.Msg)
+	msg.SetReply(req)
+	msg.Authoritative = true
+
+	if len(req.Question) == 0 {
+		msg.Rcode = dns.RcodeFormatError
+		_ = w.WriteMsg(msg)
+		return
+	}
+
+	question := req.Question[0]
+	set := s.recordSet()
+
+	if !set.ManagesZone(question.Name) {
+		msg.Rcode = dns.RcodeRefused
+		_ = w.WriteMsg(msg)
+		return
+	}
+
+	if _, ok := set.Lookup(question.Name); !ok {
+		msg.Rcode = dns.RcodeNameError // NXDOMAIN
+		_ = w.WriteMsg(msg)
+		return
+	}
+
+	if target, ok := set.CNAMETarget(question.Name); ok && question.Qtype != dns.TypeCNAME {
+		// The name is an alias: answer A/AAAA queries with the CNAME record
+		// itself (standard resolver behavior), rather than NXDOMAIN or an
+		// address we don't have. The client is expected to follow it.
+		msg.Answer = append(msg.Answer, &dns.CNAME{
+			Hdr:    s.header(question.Name, dns.TypeCNAME),
+			Target: dns.Fqdn(target),
+		})
+		_ = w.WriteMsg(msg)
+		return
+	}
+
+	switch question.Qtype {
+	case dns.TypeA:
+		if ip, ok := set.NextA(question.Name); ok {
+			msg.Answer = append(msg.Answer, &dns.A{
+				Hdr: s.header(question.Name, dns.TypeA),
+				A:   net.ParseIP(ip),
+			})
+		}
+	case dns.TypeAAAA:
+		if ip, ok := set.NextAAAA(question.Name); ok {
+			msg.Answer = append(msg.Answer, &dns.AAAA{
+				Hdr:  s.header(question.Name, dns.TypeAAAA),
+				AAAA: net.ParseIP(ip),
+			})
+		}
+	case dns.TypeCNAME:
+		if target, ok := set.CNAMETarget(question.Name); ok {
+			msg.Answer = append(msg.Answer, &dns.CNAME{
+				Hdr:    s.header(question.Name, dns.TypeCNAME),
+				Target: dns.Fqdn(target),
+			})
+		}
+	default:
+		// The name exists, just not for this type (e.g. AAAA queried for an
+		// A-only record). NOERROR with an empty answer section is the
+		// correct response, not NXDOMAIN.
+	}
+
+	_ = w.WriteMsg(msg)
+}
+
+func (s *Server) header(name string, qtype uint16) dns.RR_Header {
+	return dns.RR_Header{Name: dns.Fqdn(name), Rrtype: qtype, Class: dns.ClassINET, Ttl: s.ttl}
+}
+
+// ListenAndServe starts UDP and TCP listeners on addr and blocks until
+// either one stops, then shuts down the other. It returns the first error
+// from either transport.
+func (s *Server) ListenAndServe(addr string) error {
+	udp := &dns.Server{Addr: addr, Net: "udp", Handler: s}
+	tcp := &dns.Server{Addr: addr, Net: "tcp", Handler: s}
+
+	errCh := make(chan error, 2)
+	go func() { errCh <- udp.ListenAndServe() }()
+	go func() { errCh <- tcp.ListenAndServe() }()
+
+	err := <-errCh
+	_ = udp.Shutdown()
+	_ = tcp.Shutdown()
+	return fmt.Errorf("nameserver listener stopped: %w", err)
+}
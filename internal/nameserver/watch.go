@@ -0,0 +1,102 @@
+package nameserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// LoadRecordSet reads and parses a RecordSet from a JSON file on disk (the
+// format the CloudflareDNSResolver controller writes into the nameserver's
+// mounted records ConfigMap).
+func LoadRecordSet(path string) (*RecordSet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading records file %s: %w", path, err)
+	}
+
+	var set RecordSet
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("parsing records file %s: %w", path, err)
+	}
+	return &set, nil
+}
+
+// WatchRecordsFile reloads path into srv whenever it changes on disk
+// (inotify, via fsnotify) or the process receives SIGHUP, and blocks until
+// stop is closed. Errors from a failed reload are logged via onError rather
+// than aborting the watch, since a bad write to a mounted ConfigMap
+// shouldn't take an otherwise-healthy nameserver offline.
+func WatchRecordsFile(srv *Server, path string, stop <-chan struct{}, onError func(error)) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("creating file watcher: %w", err)
+	}
+	defer watcher.Close()
+
+	// ConfigMap mounts are symlink farms (..data -> ..timestamp); watch the
+	// containing directory so an atomic symlink swap is observed even
+	// though the file path itself never receives a direct write event.
+	dir, err := watchableDir(path)
+	if err != nil {
+		return err
+	}
+	if err := watcher.Add(dir); err != nil {
+		return fmt.Errorf("watching %s: %w", dir, err)
+	}
+
+	reload := func() {
+		set, err := LoadRecordSet(path)
+		if err != nil {
+			onError(err)
+			return
+		}
+		srv.SetRecordSet(set)
+	}
+
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	for {
+		select {
+		case <-stop:
+			return nil
+		case <-hup:
+			reload()
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if event.Op&(fsnotify.Create|fsnotify.Write|fsnotify.Rename) != 0 {
+				reload()
+			}
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			onError(fmt.Errorf("watching %s: %w", dir, err))
+		}
+	}
+}
+
+// watchableDir returns the directory containing path, which is what must be
+// watched to observe a ConfigMap volume's atomic symlink swap.
+func watchableDir(path string) (string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", fmt.Errorf("stat %s: %w", path, err)
+	}
+	if info.IsDir() {
+		return path, nil
+	}
+	dir := path[:len(path)-len(info.Name())]
+	if dir == "" {
+		dir = "."
+	}
+	return dir, nil
+}
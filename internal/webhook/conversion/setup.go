@@ -0,0 +1,23 @@
+// Package conversion wires the v1alpha1<->v1beta1 conversion webhook for
+// CloudflareAccessPolicy and AccessGroup into the controller-runtime manager.
+// The field-mapping logic itself lives on the v1beta1 types' ConvertTo/
+// ConvertFrom methods (controller-runtime requires those on the spoke type),
+// so this package only registers them with the manager's webhook server.
+package conversion
+
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	cfgatev1beta1 "cfgate.io/cfgate/api/v1beta1"
+)
+
+// SetupWebhooks registers the conversion webhook for every spoke API type with mgr.
+func SetupWebhooks(mgr ctrl.Manager) error {
+	if err := ctrl.NewWebhookManagedBy(mgr).For(&cfgatev1beta1.CloudflareAccessPolicy{}).Complete(); err != nil {
+		return err
+	}
+	if err := ctrl.NewWebhookManagedBy(mgr).For(&cfgatev1beta1.AccessGroup{}).Complete(); err != nil {
+		return err
+	}
+	return nil
+}
@@ -1,3 +1,5 @@
+//go:build e2e
+
 // Package e2e contains end-to-end tests for cfgate.
 package e2e_test
 
@@ -263,6 +265,9 @@ var _ = Describe("CloudflareDNSSync E2E", func() {
 			tunnel = waitForTunnelReady(ctx, k8sClient, tunnel.Name, tunnel.Namespace, DefaultTimeout)
 			tunnelDomain := tunnel.Status.TunnelDomain
 
+			By("Creating CloudflareManagedZone")
+			managedZone := createManagedZone(ctx, k8sClient, "explicit-zone", namespace.Name, testEnv.CloudflareZoneName)
+
 			By("Creating CloudflareDNSSync with explicit hostname")
 			explicitHostname := fmt.Sprintf("e2e-explicit-%s.%s", generateUniqueName("dns"), testEnv.CloudflareZoneName)
 			createdHostnames = append(createdHostnames, explicitHostname)
@@ -276,8 +281,8 @@ var _ = Describe("CloudflareDNSSync E2E", func() {
 					TunnelRef: cfgatev1alpha1.TunnelRef{
 						Name: tunnel.Name,
 					},
-					Zones: []cfgatev1alpha1.ZoneConfig{
-						{Name: testEnv.CloudflareZoneName},
+					Zones: []corev1.LocalObjectReference{
+						{Name: managedZone.Name},
 					},
 					Source: cfgatev1alpha1.HostnameSource{
 						Explicit: []cfgatev1alpha1.ExplicitHostname{
@@ -349,9 +354,10 @@ var _ = Describe("CloudflareDNSSync E2E", func() {
 
 	Context("zone resolution", func() {
 		It("should resolve zone by name", func() {
-			By("Creating tunnel and DNSSync with zone by name")
+			By("Creating tunnel, CloudflareManagedZone, and DNSSync with zone by name")
 			tunnel := createCloudflareTunnel(ctx, k8sClient, "zone-name-tunnel", namespace.Name, tunnelName)
 			tunnel = waitForTunnelReady(ctx, k8sClient, tunnel.Name, tunnel.Namespace, DefaultTimeout)
+			managedZone := createManagedZone(ctx, k8sClient, "zone-name-zone", namespace.Name, testEnv.CloudflareZoneName) // Zone by name, not ID.
 
 			dnsSync := &cfgatev1alpha1.CloudflareDNSSync{
 				ObjectMeta: metav1.ObjectMeta{
@@ -362,8 +368,8 @@ var _ = Describe("CloudflareDNSSync E2E", func() {
 					TunnelRef: cfgatev1alpha1.TunnelRef{
 						Name: tunnel.Name,
 					},
-					Zones: []cfgatev1alpha1.ZoneConfig{
-						{Name: testEnv.CloudflareZoneName}, // Zone by name, not ID.
+					Zones: []corev1.LocalObjectReference{
+						{Name: managedZone.Name},
 					},
 					Source: cfgatev1alpha1.HostnameSource{
 						GatewayRoutes: cfgatev1alpha1.GatewayRoutesSource{
@@ -1,3 +1,5 @@
+//go:build e2e
+
 // Package e2e contains end-to-end tests for cfgate.
 package e2e_test
 
@@ -218,6 +220,79 @@ var _ = Describe("CloudflareTunnel E2E", func() {
 		})
 	})
 
+	Context("IP route", func() {
+		It("should create IP route in Cloudflare when CR is created and remove it on delete", func() {
+			By("Creating CloudflareTunnel CR")
+			tunnel := createCloudflareTunnel(ctx, k8sClient, "iproute-tunnel", namespace.Name, tunnelName)
+			tunnel = waitForTunnelReady(ctx, k8sClient, tunnel.Name, tunnel.Namespace, DefaultTimeout)
+
+			By("Creating CloudflareIPRoute CR referencing the tunnel")
+			network := "10.42.0.0/24"
+			route := createCloudflareIPRoute(ctx, k8sClient, "test-iproute", namespace.Name, network, tunnel.Name)
+
+			By("Waiting for IP route to become ready")
+			route = waitForIPRouteReady(ctx, k8sClient, route.Name, route.Namespace, DefaultTimeout)
+			Expect(route.Status.RouteID).NotTo(BeEmpty(), "Route ID should be populated in status")
+			Expect(route.Status.TunnelID).To(Equal(tunnel.Status.TunnelID))
+
+			By("Verifying the route shows up under the tunnel in Cloudflare")
+			cfRoute, err := getIPRouteFromCloudflare(ctx, cfClient, testEnv.CloudflareAccountID, network)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfRoute).NotTo(BeNil(), "IP route should exist in Cloudflare")
+			Expect(cfRoute.ID).To(Equal(route.Status.RouteID), "Route IDs should match")
+			Expect(cfRoute.TunnelID).To(Equal(tunnel.Status.TunnelID), "Route should be scoped to the tunnel")
+
+			By("Deleting CloudflareIPRoute CR")
+			Expect(k8sClient.Delete(ctx, route)).To(Succeed())
+
+			By("Waiting for CR to be deleted from Kubernetes")
+			waitForIPRouteDeleted(ctx, k8sClient, route.Name, route.Namespace, DefaultTimeout)
+
+			By("Verifying route disappears from Cloudflare")
+			waitForIPRouteDeletedFromCloudflare(ctx, cfClient, testEnv.CloudflareAccountID, network, DefaultTimeout)
+		})
+
+		It("should handle IP route deletion policy: orphan", func() {
+			By("Creating CloudflareTunnel CR")
+			tunnel := createCloudflareTunnel(ctx, k8sClient, "iproute-orphan-tunnel", namespace.Name, tunnelName)
+			tunnel = waitForTunnelReady(ctx, k8sClient, tunnel.Name, tunnel.Namespace, DefaultTimeout)
+
+			By("Creating CloudflareIPRoute CR with orphan deletion policy")
+			network := "10.43.0.0/24"
+			route := &cfgatev1alpha1.CloudflareIPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "orphan-iproute",
+					Namespace: namespace.Name,
+					Annotations: map[string]string{
+						"cfgate.io/deletion-policy": "orphan",
+					},
+				},
+				Spec: cfgatev1alpha1.CloudflareIPRouteSpec{
+					Network: network,
+					TunnelRef: cfgatev1alpha1.TunnelRef{
+						Name: tunnel.Name,
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, route)).To(Succeed())
+
+			By("Waiting for IP route to be created in Cloudflare")
+			route = waitForIPRouteReady(ctx, k8sClient, route.Name, route.Namespace, DefaultTimeout)
+			routeID := route.Status.RouteID
+
+			By("Deleting CloudflareIPRoute CR")
+			Expect(k8sClient.Delete(ctx, route)).To(Succeed())
+
+			By("Waiting for CR to be deleted from Kubernetes")
+			waitForIPRouteDeleted(ctx, k8sClient, route.Name, route.Namespace, DefaultTimeout)
+
+			By("Verifying route still exists in Cloudflare (orphaned)")
+			cfRoute, err := getIPRouteByIDFromCloudflare(ctx, cfClient, testEnv.CloudflareAccountID, routeID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfRoute).NotTo(BeNil(), "IP route should still exist in Cloudflare with orphan policy")
+		})
+	})
+
 	Context("error handling", func() {
 		It("should set CredentialsValid=False when token is invalid", func() {
 			By("Creating CloudflareTunnel CR with invalid credentials")
@@ -0,0 +1,123 @@
+//go:build e2e
+
+// Package e2e contains end-to-end tests for cfgate.
+package e2e_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	cloudflare "github.com/cloudflare/cloudflare-go/v6"
+	corev1 "k8s.io/api/core/v1"
+
+	cfgatev1alpha1 "cfgate.io/cfgate/api/v1alpha1"
+)
+
+var _ = Describe("CloudflareVirtualNetwork E2E", func() {
+	var (
+		namespace *corev1.Namespace
+		vnetName  string
+		cfClient  *cloudflare.Client
+	)
+
+	BeforeEach(func() {
+		skipIfNoCredentials()
+
+		namespace = createTestNamespace("cfgate-vnet-e2e")
+		vnetName = generateUniqueName("e2e-vnet")
+
+		createCloudflareCredentialsSecret(namespace.Name)
+
+		cfClient = getCloudflareClient()
+	})
+
+	AfterEach(func() {
+		if testEnv.SkipCleanup {
+			return
+		}
+
+		if namespace != nil {
+			deleteTestNamespace(namespace)
+		}
+	})
+
+	Context("virtual network lifecycle", func() {
+		It("should create virtual network in Cloudflare when CR is created", func() {
+			By("Creating CloudflareVirtualNetwork CR")
+			vnet := createCloudflareVirtualNetwork(ctx, k8sClient, "test-vnet", namespace.Name, vnetName, false)
+
+			By("Waiting for virtual network to become ready")
+			vnet = waitForVirtualNetworkReady(ctx, k8sClient, vnet.Name, vnet.Namespace, DefaultTimeout)
+
+			By("Verifying vnet ID is populated in status")
+			Expect(vnet.Status.VnetID).NotTo(BeEmpty(), "Vnet ID should be populated in status")
+			Expect(vnet.Status.IsDefault).To(BeFalse())
+
+			By("Verifying virtual network exists in Cloudflare API")
+			cfVnet, err := getVirtualNetworkFromCloudflare(ctx, cfClient, testEnv.CloudflareAccountID, vnetName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfVnet).NotTo(BeNil(), "Virtual network should exist in Cloudflare")
+			Expect(cfVnet.ID).To(Equal(vnet.Status.VnetID), "Vnet IDs should match")
+		})
+
+		It("should adopt existing virtual network when name matches", func() {
+			By("Pre-creating a virtual network via Cloudflare API")
+			preVnet, err := createVirtualNetworkInCloudflare(ctx, cfClient, testEnv.CloudflareAccountID, vnetName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(preVnet).NotTo(BeNil())
+			preVnetID := preVnet.ID
+
+			By("Creating CloudflareVirtualNetwork CR with the same name")
+			vnet := createCloudflareVirtualNetwork(ctx, k8sClient, "adopt-vnet", namespace.Name, vnetName, false)
+
+			By("Waiting for virtual network to become ready")
+			vnet = waitForVirtualNetworkReady(ctx, k8sClient, vnet.Name, vnet.Namespace, DefaultTimeout)
+
+			By("Verifying it adopted the existing virtual network (same ID)")
+			Expect(vnet.Status.VnetID).To(Equal(preVnetID), "Should adopt existing virtual network ID")
+
+			By("Verifying no duplicate virtual network was created")
+			cfVnet, err := getVirtualNetworkFromCloudflare(ctx, cfClient, testEnv.CloudflareAccountID, vnetName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfVnet).NotTo(BeNil())
+			Expect(cfVnet.ID).To(Equal(preVnetID), "Should be the same virtual network, not a duplicate")
+		})
+
+		It("should atomically transfer the default flag between virtual networks", func() {
+			By("Creating a first CloudflareVirtualNetwork CR marked as default")
+			first := createCloudflareVirtualNetwork(ctx, k8sClient, "default-vnet-a", namespace.Name, vnetName, true)
+			first = waitForVirtualNetworkReady(ctx, k8sClient, first.Name, first.Namespace, DefaultTimeout)
+			Expect(first.Status.IsDefault).To(BeTrue())
+
+			By("Creating a second CloudflareVirtualNetwork CR and flipping it to default")
+			secondName := generateUniqueName("e2e-vnet-b")
+			second := createCloudflareVirtualNetwork(ctx, k8sClient, "default-vnet-b", namespace.Name, secondName, true)
+			second = waitForVirtualNetworkReady(ctx, k8sClient, second.Name, second.Namespace, DefaultTimeout)
+			Expect(second.Status.IsDefault).To(BeTrue())
+
+			By("Verifying the default flag moved off the first virtual network")
+			waitForVirtualNetworkCondition(ctx, k8sClient, first.Name, first.Namespace, func(vnet *cfgatev1alpha1.CloudflareVirtualNetwork) bool {
+				return !vnet.Status.IsDefault
+			}, DefaultTimeout)
+		})
+
+		It("should delete virtual network from Cloudflare when CR is deleted", func() {
+			By("Creating CloudflareVirtualNetwork CR")
+			vnet := createCloudflareVirtualNetwork(ctx, k8sClient, "delete-vnet", namespace.Name, vnetName, false)
+
+			By("Waiting for virtual network to be created in Cloudflare")
+			vnet = waitForVirtualNetworkReady(ctx, k8sClient, vnet.Name, vnet.Namespace, DefaultTimeout)
+			vnetID := vnet.Status.VnetID
+			Expect(vnetID).NotTo(BeEmpty())
+
+			By("Deleting CloudflareVirtualNetwork CR")
+			Expect(k8sClient.Delete(ctx, vnet)).To(Succeed())
+
+			By("Waiting for virtual network to be deleted from Kubernetes")
+			waitForVirtualNetworkDeleted(ctx, k8sClient, vnet.Name, vnet.Namespace, DefaultTimeout)
+
+			By("Verifying virtual network is deleted from Cloudflare")
+			waitForVirtualNetworkDeletedFromCloudflare(ctx, cfClient, testEnv.CloudflareAccountID, vnetName, DefaultTimeout)
+		})
+	})
+})